@@ -39,6 +39,7 @@ var (
 	paraSeqToHashKey      = []byte("ParaSeq:")
 	HashToParaSeqPrefix   = []byte("HashToParaSeq:")
 	LastParaSequence      = []byte("LastParaSequence")
+	pruneBlockHeight      = []byte("PruneBlockHeight")
 	storeLog              = chainlog.New("submodule", "store")
 )
 
@@ -51,6 +52,8 @@ func GetLocalDBKeyList() [][]byte {
 		hashPrefix, tdPrefix, heightToHashKeyPrefix, seqToHashKey, HashToSeqPrefix,
 		seqCBPrefix, seqCBLastNumPrefix, tempBlockKey, lastTempBlockKey, LastParaSequence,
 		chainParaTxPrefix, chainBodyPrefix, chainHeaderPrefix, chainReceiptPrefix,
+		pruneBlockHeight, reorgPrefix, reorgSeqPrefix, lastReorgIndexKey, checkpointPrefix,
+		lightHeaderPrefix, lightTdPrefix, lightLastHeight,
 	}
 }
 
@@ -135,6 +138,7 @@ type BlockStore struct {
 	lastheaderlock sync.Mutex
 	saveSequence   bool
 	isParaChain    bool
+	prunedHeight   int64 //已经裁剪掉区块数据的最大高度
 }
 
 //NewBlockStore new
@@ -155,6 +159,10 @@ func NewBlockStore(chain *BlockChain, db dbm.DB, client queue.Client) *BlockStor
 		blockStore.saveSequence = chain.isRecordBlockSequence
 		blockStore.isParaChain = chain.isParaChain
 	}
+	blockStore.prunedHeight, err = blockStore.loadFlag(pruneBlockHeight)
+	if err != nil {
+		panic(err)
+	}
 	cfg := chain.client.GetConfig()
 	if height == -1 {
 		chainlog.Info("load block height error, may be init database", "height", height)
@@ -473,6 +481,9 @@ func (bs *BlockStore) LoadBlockByHeight(height int64) (*types.BlockDetail, error
 
 	block, err := bs.loadBlockByIndex("", calcHeightHashKey(height, hash), nil)
 	if block == nil && err != nil {
+		if err == types.ErrBlockPruned {
+			return nil, err
+		}
 		return bs.loadBlockByHashOld(hash)
 	}
 	return block, nil
@@ -611,6 +622,10 @@ func (bs *BlockStore) GetTx(hash []byte) (*types.TxResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	//交易所在区块已经被裁剪，只保留了交易的高度和索引
+	if txResult.Tx == nil && bs.IsPruned(txResult.Height) {
+		return nil, types.ErrBlockPruned
+	}
 	return bs.getRealTxResult(&txResult), nil
 }
 
@@ -1333,6 +1348,10 @@ func (bs *BlockStore) loadBlockByIndex(indexName string, prefix []byte, primaryK
 		}
 		return nil, types.ErrHashNotExist
 	}
+	//区块body和receipt已经被裁剪
+	if bs.IsPruned(blockheader.Height) {
+		return nil, types.ErrBlockPruned
+	}
 
 	//获取body
 	blockbody, err := getBodyByIndex(bs.db, indexName, prefix, primaryKey)
@@ -1509,3 +1528,19 @@ func (bs *BlockStore) saveReduceLocaldbFlag() {
 		panic(err)
 	}
 }
+
+//IsPruned 指定高度的区块body和receipt是否已经被裁剪，创世区块不裁剪
+func (bs *BlockStore) IsPruned(height int64) bool {
+	return height > 0 && height <= atomic.LoadInt64(&bs.prunedHeight)
+}
+
+//GetPrunedHeight 获取已经裁剪掉区块数据的最大高度
+func (bs *BlockStore) GetPrunedHeight() int64 {
+	return atomic.LoadInt64(&bs.prunedHeight)
+}
+
+//setPrunedHeight 记录裁剪高度，需要在删除区块数据之前设置，保证查询时返回裁剪的错误信息
+func (bs *BlockStore) setPrunedHeight(batch dbm.Batch, height int64) {
+	atomic.StoreInt64(&bs.prunedHeight, height)
+	batch.Set(pruneBlockHeight, types.Encode(&types.Int64{Data: height}))
+}
@@ -152,8 +152,16 @@ func (chain *BlockChain) SynRoutine() {
 	checkBestChainTicker := time.NewTicker(120 * time.Second)
 
 	//节点启动后首先尝试开启快速下载模式,目前默认开启
-	if chain.GetDownloadSyncStatus() {
-		go chain.FastDownLoadBlocks()
+	//使能快照同步的新节点先同步检查点的快照，之后再快速下载检查点之后的区块
+	//轻节点只同步区块头，不需要下载区块
+	if chain.cfg.EnableLightNode {
+		chain.UpdateDownloadSyncStatus(false)
+	} else if chain.GetDownloadSyncStatus() {
+		if chain.needSnapshotSync() {
+			go chain.SnapshotSync()
+		} else {
+			go chain.FastDownLoadBlocks()
+		}
 	}
 	for {
 		select {
@@ -162,7 +170,9 @@ func (chain *BlockChain) SynRoutine() {
 			return
 		case <-blockSynTicker.C:
 			//synlog.Info("blockSynTicker")
-			if !chain.GetDownloadSyncStatus() {
+			if chain.cfg.EnableLightNode {
+				go chain.SynLightHeaders()
+			} else if !chain.GetDownloadSyncStatus() {
 				go chain.SynBlocksFromPeers()
 			}
 
@@ -173,11 +183,17 @@ func (chain *BlockChain) SynRoutine() {
 
 		case <-checkHeightNoIncreaseTicker.C:
 			//synlog.Info("CheckHeightNoIncrease")
+			if chain.cfg.EnableLightNode {
+				continue
+			}
 			chain.tickerwg.Add(1)
 			go chain.CheckHeightNoIncrease()
 
 		case <-checkBlockHashTicker.C:
 			//synlog.Info("checkBlockHashTicker")
+			if chain.cfg.EnableLightNode {
+				continue
+			}
 			chain.tickerwg.Add(1)
 			go chain.CheckTipBlockHash()
 
@@ -193,6 +209,9 @@ func (chain *BlockChain) SynRoutine() {
 
 			//定时检查peerlist中的节点是否在同一条链上，获取同一高度的blockhash来做对比
 		case <-checkBestChainTicker.C:
+			if chain.cfg.EnableLightNode {
+				continue
+			}
 			chain.tickerwg.Add(1)
 			go chain.CheckBestChain(false)
 		}
@@ -708,10 +727,8 @@ func (chain *BlockChain) FetchBlockHeaders(start int64, end int64, pid string) (
 	return resp.Err()
 }
 
-//ProcBlockHeader 一个block header消息的处理，分tiphash的校验，故障peer的故障block是否恢复的校验
-func (chain *BlockChain) ProcBlockHeader(headers *types.Headers, peerid string) error {
-
-	//判断是否是用于检测故障peer而请求的block header
+//procFaultPeerHeader 处理用于检测故障peer而请求的block header，返回true表示已经处理
+func (chain *BlockChain) procFaultPeerHeader(headers *types.Headers, peerid string) bool {
 	faultPeer := chain.GetFaultPeer(peerid)
 	if faultPeer != nil && faultPeer.ReqFlag && faultPeer.FaultHeight == headers.Items[0].Height {
 		//同一高度的block hash有更新，表示故障peer的故障已经恢复，将此peer从故障peerlist中移除
@@ -720,6 +737,16 @@ func (chain *BlockChain) ProcBlockHeader(headers *types.Headers, peerid string)
 		} else {
 			chain.UpdateFaultPeer(peerid, false)
 		}
+		return true
+	}
+	return false
+}
+
+//ProcBlockHeader 一个block header消息的处理，分tiphash的校验，故障peer的故障block是否恢复的校验
+func (chain *BlockChain) ProcBlockHeader(headers *types.Headers, peerid string) error {
+
+	//判断是否是用于检测故障peer而请求的block header
+	if chain.procFaultPeerHeader(headers, peerid) {
 		return nil
 	}
 
@@ -834,6 +861,13 @@ func (chain *BlockChain) ProcAddBlockHeadersMsg(headers *types.Headers, pid stri
 	}
 	count := len(headers.Items)
 	synlog.Debug("ProcAddBlockHeadersMsg", "count", count, "pid", pid)
+	//轻节点保存校验通过的区块头
+	if chain.cfg.EnableLightNode {
+		if count == 1 && chain.procFaultPeerHeader(headers, pid) {
+			return nil
+		}
+		return chain.ProcLightHeaders(headers, pid)
+	}
 	if count == 1 {
 		return chain.ProcBlockHeader(headers, pid)
 	}
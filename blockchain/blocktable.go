@@ -121,6 +121,23 @@ func getBodyByIndex(db dbm.DB, indexName string, prefix []byte, primaryKey []byt
 	return body, nil
 }
 
+//delBlockBodyTable 删除block body
+func delBlockBodyTable(db dbm.DB, height int64, hash []byte) ([]*types.KeyValue, error) {
+	kvdb := dbm.NewKVDB(db)
+	table := NewBodyTable(kvdb)
+
+	err := table.Del(calcHeightHashKey(height, hash))
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
+
 /*
 table  header
 data:  block header
@@ -195,6 +212,23 @@ func saveHeaderTable(db dbm.DB, header *types.Header) ([]*types.KeyValue, error)
 	return kvs, nil
 }
 
+//delHeaderTable 删除block header
+func delHeaderTable(db dbm.DB, height int64, hash []byte) ([]*types.KeyValue, error) {
+	kvdb := dbm.NewKVDB(db)
+	table := NewHeaderTable(kvdb)
+
+	err := table.Del(calcHeightHashKey(height, hash))
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
+
 //通过指定的index获取对应的blockheader
 //通过高度获取：height+hash；indexName="",prefix=nil,primaryKey=calcHeightHashKey
 //通过index获取：hash; indexName="hash",prefix=HeaderRow.Get(indexName),primaryKey=nil
@@ -120,6 +120,23 @@ type BlockChain struct {
 
 	blockOnChain   *BlockOnChain
 	onChainTimeout int64
+
+	//区块裁剪策略
+	prunePolicy PrunePolicy
+
+	//轻节点本地最新的区块头
+	lightTip  *types.Header
+	lightLock sync.Mutex
+
+	//最大回滚深度以及检查点
+	maxReorgDepth     int64
+	checkpoints       map[int64][]byte
+	checkpointSigners map[string]bool
+	checkpointLock    sync.RWMutex
+
+	//正在进行的重建索引任务
+	reindex     *reindexer
+	reindexLock sync.Mutex
 }
 
 //New new
@@ -187,6 +204,11 @@ func (chain *BlockChain) initConfig(cfg *types.Chain33Config) {
 	chain.isStrongConsistency = mcfg.IsStrongConsistency
 	chain.isRecordBlockSequence = mcfg.IsRecordBlockSequence
 	chain.isParaChain = mcfg.IsParaChain
+	chain.prunePolicy = newPrunePolicy(mcfg)
+	if mcfg.EnableReduceLocaldb && !chain.prunePolicy.AllowReduce() {
+		panic("prune mode " + mcfg.PruneMode + " can not enable reduceLocaldb")
+	}
+	chain.initCheckpoints(mcfg)
 	cfg.S("quickIndex", mcfg.EnableTxQuickIndex)
 	cfg.S("reduceLocaldb", mcfg.EnableReduceLocaldb)
 
@@ -234,11 +256,12 @@ func (chain *BlockChain) SetQueueClient(client queue.Client) {
 	blockStoreDB := dbm.NewDB("blockchain", chain.cfg.Driver, chain.cfg.DbPath, chain.cfg.DbCache)
 	blockStore := NewBlockStore(chain, blockStoreDB, client)
 	chain.blockStore = blockStore
+	chain.loadCheckpoints()
 	stateHash := chain.getStateHash()
 	chain.query = NewQuery(blockStoreDB, chain.client, stateHash)
 
 	chain.pushservice = newPushService(chain.blockStore, chain.blockStore)
-	chain.pushseq = newpushseq(chain.blockStore, chain.pushservice.pushStore)
+	chain.pushseq = newpushseq(chain.blockStore, chain.pushservice.pushStore, chain.setTxDetailProofs, chain.cfg)
 	//startTime
 	chain.startTime = types.Now()
 
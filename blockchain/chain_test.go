@@ -1410,3 +1410,46 @@ func testCmpBestBlock(t *testing.T, client queue.Client, block *types.Block, cfg
 	isbestBlock := util.CmpBestBlock(client, newblock, block.Hash(cfg))
 	assert.Equal(t, isbestBlock, false)
 }
+
+func signCheckpoint(priv crypto.PrivKey, height int64, hash []byte) *types.ChainCheckpoint {
+	point := &types.ChainCheckpoint{Height: height, Hash: hash}
+	sign := priv.Sign(types.Encode(point))
+	point.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sign.Bytes()}
+	return point
+}
+
+func TestCheckpoint(t *testing.T) {
+	signer := util.TestPrivkeyList[0]
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().BlockChain.CheckpointSigners = []string{common.ToHex(signer.PubKey().Bytes())}
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	chain := mock33.GetBlockChain()
+
+	for chain.GetBlockHeight() < 2 {
+		_, err := addMainTx(cfg, mock33.GetGenesisKey(), mock33.GetAPI())
+		require.NoError(t, err)
+		time.Sleep(sendTxWait)
+	}
+	height := chain.GetBlockHeight()
+	block, err := chain.GetBlock(height)
+	require.NoError(t, err)
+	hash := block.Block.Hash(cfg)
+
+	//检查点需要配置的公钥签名，并且与主链一致
+	assert.Equal(t, types.ErrCheckpointSign, chain.ProcAddCheckpoint(signCheckpoint(mock33.GetGenesisKey(), height, hash)))
+	bad := signCheckpoint(signer, height, hash)
+	bad.Height--
+	assert.Equal(t, types.ErrCheckpointSign, chain.ProcAddCheckpoint(bad))
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.ProcAddCheckpoint(signCheckpoint(signer, height-1, hash)))
+	require.NoError(t, chain.ProcAddCheckpoint(signCheckpoint(signer, height, hash)))
+	points := chain.ProcGetCheckpoints()
+	require.Equal(t, 1, len(points.Items))
+	assert.Equal(t, hash, points.Items[0].Hash)
+
+	//与检查点冲突的侧链区块被拒绝
+	newblock := types.Clone(block.Block).(*types.Block)
+	newblock.BlockTime++
+	_, err = chain.ProcAddBlockMsg(true, &types.BlockDetail{Block: newblock}, "peer")
+	assert.Equal(t, types.ErrCheckpointMismatch, err)
+}
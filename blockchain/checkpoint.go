@@ -0,0 +1,181 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+var checkpointPrefix = []byte("Checkpoint:")
+
+//height->Checkpoint
+func calcCheckpointKey(height int64) []byte {
+	return append(append([]byte{}, checkpointPrefix...), []byte(fmt.Sprintf("%012d", height))...)
+}
+
+//parseCheckpoint 解析配置中height:hash格式的检查点
+func parseCheckpoint(point string) (int64, []byte, error) {
+	items := strings.Split(point, ":")
+	if len(items) != 2 {
+		return 0, nil, types.ErrInvalidParam
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(items[0]), 10, 64)
+	if err != nil || height < 0 {
+		return 0, nil, types.ErrInvalidParam
+	}
+	hash, err := common.FromHex(strings.TrimSpace(items[1]))
+	if err != nil || len(hash) != sha256Len {
+		return 0, nil, types.ErrInvalidParam
+	}
+	return height, hash, nil
+}
+
+//initCheckpoints 加载配置中内置的检查点以及签名公钥，配置错误时panic
+func (chain *BlockChain) initCheckpoints(mcfg *types.BlockChain) {
+	chain.maxReorgDepth = mcfg.MaxReorgDepth
+	chain.checkpoints = make(map[int64][]byte)
+	chain.checkpointSigners = make(map[string]bool)
+	for _, point := range mcfg.Checkpoints {
+		height, hash, err := parseCheckpoint(point)
+		if err != nil {
+			panic("invalid checkpoint " + point)
+		}
+		chain.checkpoints[height] = hash
+	}
+	for _, signer := range mcfg.CheckpointSigners {
+		pub, err := common.FromHex(signer)
+		if err != nil || len(pub) == 0 {
+			panic("invalid checkpoint signer " + signer)
+		}
+		chain.checkpointSigners[string(pub)] = true
+	}
+}
+
+//loadCheckpoints 加载运行时添加并保存在数据库中的检查点
+func (chain *BlockChain) loadCheckpoints() {
+	values := dbm.NewListHelper(chain.blockStore.db).PrefixScan(checkpointPrefix)
+	chain.checkpointLock.Lock()
+	defer chain.checkpointLock.Unlock()
+	for _, value := range values {
+		var point types.ChainCheckpoint
+		err := types.Decode(value, &point)
+		if err != nil {
+			chainlog.Error("loadCheckpoints Decode", "err", err)
+			continue
+		}
+		chain.checkpoints[point.Height] = point.Hash
+	}
+}
+
+//getCheckpoint 获取指定高度的检查点
+func (chain *BlockChain) getCheckpoint(height int64) ([]byte, bool) {
+	chain.checkpointLock.RLock()
+	defer chain.checkpointLock.RUnlock()
+	hash, ok := chain.checkpoints[height]
+	return hash, ok
+}
+
+//checkReorg 检测区块是否会导致超过最大深度的回滚或者与检查点冲突
+//prevNode为区块的父节点，从父节点回溯到与主链的分叉点，分支上的区块都需要与检查点一致
+func (chain *BlockChain) checkReorg(prevNode *blockNode, height int64, hash []byte) error {
+	if point, ok := chain.getCheckpoint(height); ok && !bytes.Equal(point, hash) {
+		return types.ErrCheckpointMismatch
+	}
+	tip := chain.bestChain.Tip()
+	fork := chain.bestChain.FindFork(prevNode)
+	if chain.maxReorgDepth > 0 {
+		if fork == nil || tip.height-fork.height > chain.maxReorgDepth {
+			return types.ErrReorgTooDeep
+		}
+	}
+	for node := prevNode; node != nil && node != fork; node = node.parent {
+		if point, ok := chain.getCheckpoint(node.height); ok && !bytes.Equal(point, node.hash) {
+			return types.ErrCheckpointMismatch
+		}
+	}
+	return nil
+}
+
+//ProcAddCheckpoint 添加签名的检查点，检查点不能与已有的检查点和主链冲突
+func (chain *BlockChain) ProcAddCheckpoint(point *types.ChainCheckpoint) error {
+	if point == nil || point.Height < 0 || len(point.Hash) != sha256Len || point.Signature == nil {
+		return types.ErrInvalidParam
+	}
+	if !chain.checkpointSigners[string(point.Signature.Pubkey)] {
+		return types.ErrCheckpointSign
+	}
+	data := types.Encode(&types.ChainCheckpoint{Height: point.Height, Hash: point.Hash})
+	if !types.CheckSign(data, "", point.Signature) {
+		return types.ErrCheckpointSign
+	}
+	if hash, ok := chain.getCheckpoint(point.Height); ok {
+		if !bytes.Equal(hash, point.Hash) {
+			return types.ErrCheckpointMismatch
+		}
+		return nil
+	}
+	//轻节点和本地保存的区块头比较
+	if chain.cfg.EnableLightNode {
+		header, err := chain.getLightHeader(point.Height)
+		if err == nil && !bytes.Equal(header.Hash, point.Hash) {
+			return types.ErrCheckpointMismatch
+		}
+	} else if point.Height <= chain.GetBlockHeight() {
+		hash, err := chain.blockStore.GetBlockHashByHeight(point.Height)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, point.Hash) {
+			return types.ErrCheckpointMismatch
+		}
+	}
+	err := chain.blockStore.db.SetSync(calcCheckpointKey(point.Height), types.Encode(point))
+	if err != nil {
+		return err
+	}
+	chain.checkpointLock.Lock()
+	chain.checkpoints[point.Height] = point.Hash
+	chain.checkpointLock.Unlock()
+	chainlog.Info("ProcAddCheckpoint", "height", point.Height, "hash", common.ToHex(point.Hash))
+	return nil
+}
+
+//ProcGetCheckpoints 获取所有的检查点，按照高度从低到高排列
+func (chain *BlockChain) ProcGetCheckpoints() *types.ChainCheckpoints {
+	chain.checkpointLock.RLock()
+	defer chain.checkpointLock.RUnlock()
+	points := &types.ChainCheckpoints{}
+	for height, hash := range chain.checkpoints {
+		points.Items = append(points.Items, &types.ChainCheckpoint{Height: height, Hash: hash})
+	}
+	sort.Slice(points.Items, func(i, j int) bool {
+		return points.Items[i].Height < points.Items[j].Height
+	})
+	return points
+}
+
+func (chain *BlockChain) addCheckpoint(msg *queue.Message) {
+	point := msg.Data.(*types.ChainCheckpoint)
+	err := chain.ProcAddCheckpoint(point)
+	if err != nil {
+		chainlog.Error("ProcAddCheckpoint", "height", point.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventReply, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+}
+
+func (chain *BlockChain) getCheckpoints(msg *queue.Message) {
+	msg.Reply(chain.client.NewMessage("", types.EventGetCheckpoints, chain.ProcGetCheckpoints()))
+}
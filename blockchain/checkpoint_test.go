@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNode(parent *blockNode, name string) *blockNode {
+	hash := sha256.Sum256([]byte(name))
+	node := &blockNode{parent: parent, hash: hash[:]}
+	if parent != nil {
+		node.height = parent.height + 1
+	}
+	return node
+}
+
+func TestCheckReorg(t *testing.T) {
+	//主链0-5，侧链从高度2分叉
+	nodes := []*blockNode{newTestNode(nil, "main0")}
+	for i := 1; i <= 5; i++ {
+		nodes = append(nodes, newTestNode(nodes[i-1], fmt.Sprintf("main%d", i)))
+	}
+	side3 := newTestNode(nodes[2], "side3")
+	side4 := newTestNode(side3, "side4")
+	chain := &BlockChain{bestChain: newChainView(nodes[0])}
+	for _, node := range nodes[1:] {
+		chain.bestChain.SetTip(node)
+	}
+
+	chain.initCheckpoints(&types.BlockChain{MaxReorgDepth: 2})
+	assert.Equal(t, types.ErrReorgTooDeep, chain.checkReorg(side4, 5, newTestNode(side4, "side5").hash))
+	assert.Nil(t, chain.checkReorg(nodes[5], 6, newTestNode(nodes[5], "main6").hash))
+	assert.Nil(t, chain.checkReorg(nodes[3], 4, newTestNode(nodes[3], "side4").hash))
+
+	//侧链上的区块与检查点不一致
+	chain.initCheckpoints(&types.BlockChain{Checkpoints: []string{"3:" + common.ToHex(nodes[3].hash)}})
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.checkReorg(side4, 5, newTestNode(side4, "side5").hash))
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.checkReorg(nodes[2], 3, side3.hash))
+	assert.Nil(t, chain.checkReorg(nodes[5], 6, newTestNode(nodes[5], "main6").hash))
+
+	assert.Panics(t, func() { chain.initCheckpoints(&types.BlockChain{Checkpoints: []string{"3"}}) })
+	assert.Panics(t, func() { chain.initCheckpoints(&types.BlockChain{Checkpoints: []string{"3:0x12"}}) })
+}
@@ -0,0 +1,539 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+//流式归档格式:
+//magic(8字节) + 文件头数据块 + 区块数据块... + 长度为0的结束数据块
+//每个数据块为 长度(4字节) + crc32校验(4字节) + 压缩之后的数据
+//区块数据块解压之后为多个 长度(4字节) + 区块protobuf编码
+const (
+	archiveVersion      = 1
+	archiveChunkSize    = 4 * 1024 * 1024
+	archiveMaxFrameSize = 256 * 1024 * 1024
+)
+
+var (
+	archiveMagic       = []byte("CHAIN33A")
+	archiveCrcTable    = crc32.MakeTable(crc32.Castagnoli)
+	ErrInvalidArchive  = errors.New("ErrInvalidArchive")
+	ErrArchiveChecksum = errors.New("ErrArchiveChecksum")
+)
+
+//ArchiveCodec 归档数据块的压缩方式
+type ArchiveCodec interface {
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+var archiveCodecs = make(map[string]ArchiveCodec)
+
+//RegisterArchiveCodec 注册归档数据块的压缩方式
+func RegisterArchiveCodec(name string, codec ArchiveCodec) {
+	if codec == nil {
+		panic("RegisterArchiveCodec: codec is nil")
+	}
+	if _, ok := archiveCodecs[name]; ok {
+		panic("RegisterArchiveCodec: duplicate codec " + name)
+	}
+	archiveCodecs[name] = codec
+}
+
+func init() {
+	RegisterArchiveCodec("none", noneCodec{})
+	RegisterArchiveCodec("gzip", gzipCodec{})
+	RegisterArchiveCodec("zstd", zstdCodec{})
+}
+
+//getArchiveCodec 没有注册的压缩方式返回ErrNotSupport
+func getArchiveCodec(name string) (ArchiveCodec, error) {
+	if name == "" {
+		name = "none"
+	}
+	codec, ok := archiveCodecs[name]
+	if !ok {
+		return nil, types.ErrNotSupport
+	}
+	return codec, nil
+}
+
+type noneCodec struct{}
+
+func (noneCodec) Compress(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+func (noneCodec) Decompress(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+type gzipCodec struct{}
+
+func (gzipCodec) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	g := gzip.NewWriter(&buf)
+	if _, err := g.Write(data); err != nil {
+		return nil, err
+	}
+	if err := g.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+//Decompress 和zstd一样限制解压之后的大小，超过archiveMaxFrameSize返回错误
+func (gzipCodec) Decompress(data []byte) ([]byte, error) {
+	g, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer g.Close()
+	payload, err := ioutil.ReadAll(io.LimitReader(g, archiveMaxFrameSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > archiveMaxFrameSize {
+		return nil, ErrInvalidArchive
+	}
+	return payload, nil
+}
+
+type zstdCodec struct{}
+
+func (zstdCodec) Compress(data []byte) ([]byte, error) {
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer enc.Close()
+	return enc.EncodeAll(data, nil), nil
+}
+
+//Decompress 限制解压之后的大小，防止损坏的归档占用过多内存
+func (zstdCodec) Decompress(data []byte) ([]byte, error) {
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(archiveMaxFrameSize))
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+	return dec.DecodeAll(data, nil)
+}
+
+//encodeFrame 构造数据块，payload为空时表示归档结束
+func encodeFrame(payload []byte) []byte {
+	frame := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint32(frame, uint32(len(payload)))
+	binary.BigEndian.PutUint32(frame[4:], crc32.Checksum(payload, archiveCrcTable))
+	return append(frame, payload...)
+}
+
+//readFrame 读取并校验数据块，读到结束数据块时返回空的payload
+func readFrame(r io.Reader) ([]byte, error) {
+	var head [8]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > archiveMaxFrameSize {
+		return nil, ErrInvalidArchive
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if crc32.Checksum(payload, archiveCrcTable) != binary.BigEndian.Uint32(head[4:]) {
+		return nil, ErrArchiveChecksum
+	}
+	return payload, nil
+}
+
+//archiveWriter 将区块按数据块写入归档，每个数据块只调用一次Write，方便按数据块切分文件
+type archiveWriter struct {
+	w     io.Writer
+	codec ArchiveCodec
+	buf   bytes.Buffer
+}
+
+func newArchiveWriter(w io.Writer, header *types.ArchiveHeader) (*archiveWriter, error) {
+	codec, err := getArchiveCodec(header.Compress)
+	if err != nil {
+		return nil, err
+	}
+	data := append(append([]byte{}, archiveMagic...), encodeFrame(types.Encode(header))...)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	return &archiveWriter{w: w, codec: codec}, nil
+}
+
+func (a *archiveWriter) writeBlock(block *types.Block) error {
+	data := types.Encode(block)
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+	a.buf.Write(size[:])
+	a.buf.Write(data)
+	if a.buf.Len() >= archiveChunkSize {
+		return a.flush()
+	}
+	return nil
+}
+
+func (a *archiveWriter) flush() error {
+	if a.buf.Len() == 0 {
+		return nil
+	}
+	payload, err := a.codec.Compress(a.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	a.buf.Reset()
+	_, err = a.w.Write(encodeFrame(payload))
+	return err
+}
+
+//close 写入剩余的区块以及结束数据块
+func (a *archiveWriter) close() error {
+	if err := a.flush(); err != nil {
+		return err
+	}
+	_, err := a.w.Write(encodeFrame(nil))
+	return err
+}
+
+//splitFileWriter 文件超过maxSize之后写入新的文件path.1,path.2...，maxSize为0时不切分
+type splitFileWriter struct {
+	path    string
+	maxSize int64
+	index   int
+	file    *os.File
+	size    int64
+}
+
+func newSplitFileWriter(path string, maxSize int64) *splitFileWriter {
+	return &splitFileWriter{path: path, maxSize: maxSize}
+}
+
+func splitFileName(path string, index int) string {
+	if index == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+func (s *splitFileWriter) Write(p []byte) (int, error) {
+	if s.file != nil && s.maxSize > 0 && s.size > 0 && s.size+int64(len(p)) > s.maxSize {
+		if err := s.Close(); err != nil {
+			return 0, err
+		}
+		s.index++
+	}
+	if s.file == nil {
+		file, err := os.OpenFile(splitFileName(s.path, s.index), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return 0, err
+		}
+		s.file = file
+		s.size = 0
+	}
+	n, err := s.file.Write(p)
+	s.size += int64(n)
+	return n, err
+}
+
+func (s *splitFileWriter) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Sync()
+	if cerr := s.file.Close(); err == nil {
+		err = cerr
+	}
+	s.file = nil
+	return err
+}
+
+//splitFileReader 依次读取path,path.1,path.2...直到文件不存在
+type splitFileReader struct {
+	path  string
+	index int
+	file  *os.File
+}
+
+func newSplitFileReader(path string) (*splitFileReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &splitFileReader{path: path, file: file}, nil
+}
+
+func (s *splitFileReader) Read(p []byte) (int, error) {
+	for {
+		if s.file == nil {
+			file, err := os.Open(splitFileName(s.path, s.index))
+			if os.IsNotExist(err) {
+				return 0, io.EOF
+			}
+			if err != nil {
+				return 0, err
+			}
+			s.file = file
+		}
+		n, err := s.file.Read(p)
+		if err == io.EOF {
+			s.Close()
+			s.index++
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (s *splitFileReader) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+//ExportArchiveProc 将主链区块导出为流式归档，path为-时输出到stdout，splitSize(MB)大于0时切分文件
+//导出结束后退出整个系统，导出失败时退出码为1
+func (chain *BlockChain) ExportArchiveProc(path, compress string, startHeight, splitSize int64) {
+	err := chain.exportArchiveFile(path, compress, startHeight, splitSize)
+	if err != nil {
+		exportlog.Error("ExportArchiveProc", "path", path, "compress", compress, "err", err)
+		syscall.Exit(1)
+	}
+	exportlog.Info("ExportArchiveProc:complete", "path", path, "compress", compress)
+	syscall.Exit(0)
+}
+
+func (chain *BlockChain) exportArchiveFile(path, compress string, startHeight, splitSize int64) error {
+	//与ExportBlock一致，只导出当前高度减去blockCount之前的区块，避免导出侧链的数据
+	curheight, err := LoadBlockStoreHeight(chain.blockStore.db)
+	if err != nil {
+		return err
+	}
+	if path == "-" {
+		return chain.ExportArchive(os.Stdout, compress, startHeight, curheight-blockCount)
+	}
+	if splitSize < 0 {
+		return types.ErrInvalidParam
+	}
+	w := newSplitFileWriter(getDataDir(path), splitSize*1024*1024)
+	err = chain.ExportArchive(w, compress, startHeight, curheight-blockCount)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+//ImportArchiveProc 从流式归档导入区块，path为-时从stdin读取，导入结束后退出整个系统
+func (chain *BlockChain) ImportArchiveProc(path string) {
+	//从归档导入区块期间，执行区块设置成不刷磁盘，提高写入数据库的效率
+	if !chain.cfgBatchSync {
+		atomic.CompareAndSwapInt32(&chain.isbatchsync, 1, 0)
+	}
+	var r io.ReadCloser = os.Stdin
+	if path != "-" {
+		file, err := newSplitFileReader(getDataDir(path))
+		if err != nil {
+			exportlog.Error("ImportArchiveProc", "path", path, "err", err)
+			syscall.Exit(1)
+		}
+		r = file
+	}
+	err := chain.ImportArchive(r)
+	r.Close()
+	if err != nil {
+		exportlog.Error("ImportArchiveProc", "path", path, "height", chain.GetBlockHeight(), "err", err)
+		syscall.Exit(1)
+	}
+	exportlog.Info("ImportArchiveProc:complete", "path", path, "height", chain.GetBlockHeight())
+	syscall.Exit(0)
+}
+
+//ExportArchive 将[startHeight, endHeight]之间的主链区块以流式归档的格式写入w
+func (chain *BlockChain) ExportArchive(w io.Writer, compress string, startHeight, endHeight int64) error {
+	exportlog.Info("ExportArchive", "compress", compress, "startHeight", startHeight, "endHeight", endHeight)
+	if startHeight < 0 || endHeight < startHeight || endHeight > chain.GetBlockHeight() {
+		return types.ErrInvalidParam
+	}
+	cfg := chain.client.GetConfig()
+	header := &types.ArchiveHeader{
+		Version:     archiveVersion,
+		Title:       cfg.GetTitle(),
+		TestNet:     cfg.IsTestNet(),
+		Compress:    compress,
+		StartHeight: startHeight,
+	}
+	archive, err := newArchiveWriter(w, header)
+	if err != nil {
+		exportlog.Error("ExportArchive:newArchiveWriter", "compress", compress, "err", err)
+		return err
+	}
+	for height := startHeight; height <= endHeight; height++ {
+		block, err := chain.blockStore.LoadBlockByHeight(height)
+		if err != nil {
+			exportlog.Error("ExportArchive:LoadBlockByHeight", "height", height, "err", err)
+			return err
+		}
+		err = archive.writeBlock(block.Block)
+		if err != nil {
+			exportlog.Error("ExportArchive:writeBlock", "height", height, "err", err)
+			return err
+		}
+		if height%10000 == 0 {
+			exportlog.Info("ExportArchive", "height", height)
+		}
+	}
+	return archive.close()
+}
+
+//ImportArchive 从流式归档中导入区块，已经存在的区块会跳过
+//归档中断或者损坏时，已经导入的区块保留，之后可以从当前高度继续导入
+func (chain *BlockChain) ImportArchive(r io.Reader) error {
+	header, codec, err := readArchiveHeader(r)
+	if err != nil {
+		exportlog.Error("ImportArchive:readArchiveHeader", "err", err)
+		return err
+	}
+	cfg := chain.client.GetConfig()
+	if header.Title != cfg.GetTitle() || header.TestNet != cfg.IsTestNet() {
+		exportlog.Error("ImportArchive", "title", header.Title, "testNet", header.TestNet)
+		return types.ErrInValidFileHeader
+	}
+	curheight := chain.GetBlockHeight()
+	if header.StartHeight > curheight+1 {
+		exportlog.Error("ImportArchive", "curheight", curheight, "startHeight", header.StartHeight)
+		return ErrBlockHeightDiscontinuous
+	}
+	exportlog.Info("ImportArchive", "compress", header.Compress, "startHeight", header.StartHeight, "curheight", curheight)
+	for {
+		payload, err := readFrame(r)
+		if err != nil {
+			exportlog.Error("ImportArchive:readFrame", "height", curheight, "err", err)
+			return err
+		}
+		//结束数据块
+		if len(payload) == 0 {
+			return nil
+		}
+		data, err := codec.Decompress(payload)
+		if err != nil {
+			exportlog.Error("ImportArchive:Decompress", "height", curheight, "err", err)
+			return err
+		}
+		blocks := bytes.NewReader(data)
+		for blocks.Len() > 0 {
+			block, err := readArchiveBlock(blocks)
+			if err != nil {
+				exportlog.Error("ImportArchive:readArchiveBlock", "height", curheight, "err", err)
+				return err
+			}
+			if block.Height <= curheight {
+				err = chain.checkArchiveBlock(block)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if block.Height != curheight+1 {
+				exportlog.Error("ImportArchive", "curheight", curheight, "height", block.Height)
+				return ErrBlockHeightDiscontinuous
+			}
+			err = chain.mainChainImport(block)
+			if err != nil {
+				exportlog.Error("ImportArchive:mainChainImport", "height", block.Height, "err", err)
+				return err
+			}
+			curheight = block.Height
+		}
+	}
+}
+
+//checkArchiveBlock 跳过已经存在的区块时，校验本地的区块与归档中的一致
+func (chain *BlockChain) checkArchiveBlock(block *types.Block) error {
+	hash, err := chain.blockStore.GetBlockHashByHeight(block.Height)
+	if err != nil {
+		return err
+	}
+	cfg := chain.client.GetConfig()
+	if !bytes.Equal(hash, block.Hash(cfg)) {
+		exportlog.Error("ImportArchive:checkArchiveBlock", "height", block.Height, "hash", common.ToHex(hash), "archiveHash", common.ToHex(block.Hash(cfg)))
+		return types.ErrBlockHashNoMatch
+	}
+	return nil
+}
+
+func readArchiveHeader(r io.Reader) (*types.ArchiveHeader, ArchiveCodec, error) {
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, archiveMagic) {
+		return nil, nil, ErrInvalidArchive
+	}
+	payload, err := readFrame(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var header types.ArchiveHeader
+	err = types.Decode(payload, &header)
+	if err != nil || header.Version > archiveVersion || header.StartHeight < 0 {
+		return nil, nil, ErrInvalidArchive
+	}
+	codec, err := getArchiveCodec(header.Compress)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &header, codec, nil
+}
+
+func readArchiveBlock(r *bytes.Reader) (*types.Block, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, ErrInvalidArchive
+	}
+	length := int(binary.BigEndian.Uint32(size[:]))
+	if length > r.Len() {
+		return nil, ErrInvalidArchive
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, ErrInvalidArchive
+	}
+	var block types.Block
+	err := types.Decode(data, &block)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
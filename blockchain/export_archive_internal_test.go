@@ -0,0 +1,63 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveSplitFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chain.arch")
+
+	w := newSplitFileWriter(path, 64)
+	archive, err := newArchiveWriter(w, &types.ArchiveHeader{Version: archiveVersion, Title: "test", Compress: "gzip"})
+	require.NoError(t, err)
+	for height := int64(0); height < 3; height++ {
+		require.NoError(t, archive.writeBlock(&types.Block{Height: height, ParentHash: bytes.Repeat([]byte{1}, 32)}))
+		require.NoError(t, archive.flush())
+	}
+	require.NoError(t, archive.close())
+	require.NoError(t, w.Close())
+	//每个数据块都超过了文件大小，切分成多个文件
+	_, err = os.Stat(splitFileName(path, 3))
+	require.NoError(t, err)
+
+	r, err := newSplitFileReader(path)
+	require.NoError(t, err)
+	defer r.Close()
+	header, codec, err := readArchiveHeader(r)
+	require.NoError(t, err)
+	assert.Equal(t, "test", header.Title)
+	for height := int64(0); height < 3; height++ {
+		payload, err := readFrame(r)
+		require.NoError(t, err)
+		data, err := codec.Decompress(payload)
+		require.NoError(t, err)
+		block, err := readArchiveBlock(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, height, block.Height)
+	}
+	payload, err := readFrame(r)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(payload))
+	_, err = readFrame(r)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = newSplitFileReader(filepath.Join(dir, "none"))
+	assert.Error(t, err)
+	assert.Panics(t, func() { RegisterArchiveCodec("gzip", gzipCodec{}) })
+}
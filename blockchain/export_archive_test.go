@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/blockchain"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportArchive(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	chain := mock33.GetBlockChain()
+	cfg := mock33.GetClient().GetConfig()
+	for chain.GetBlockHeight() < 10 {
+		_, err := addMainTx(cfg, mock33.GetGenesisKey(), mock33.GetAPI())
+		require.NoError(t, err)
+		time.Sleep(sendTxWait)
+	}
+	endHeight := chain.GetBlockHeight()
+	endHeader, err := chain.ProcGetLastHeaderMsg()
+	require.NoError(t, err)
+
+	//异常测试
+	var buf bytes.Buffer
+	assert.Equal(t, types.ErrInvalidParam, chain.ExportArchive(&buf, "gzip", 5, 4))
+	assert.Equal(t, types.ErrInvalidParam, chain.ExportArchive(&buf, "gzip", 0, endHeight+1))
+	assert.Equal(t, types.ErrNotSupport, chain.ExportArchive(&buf, "unknown", 0, endHeight))
+
+	var gzipArchive, plainArchive, zstdArchive bytes.Buffer
+	require.NoError(t, chain.ExportArchive(&gzipArchive, "gzip", 0, endHeight))
+	require.NoError(t, chain.ExportArchive(&plainArchive, "none", 0, endHeight))
+	require.NoError(t, chain.ExportArchive(&zstdArchive, "zstd", 0, endHeight))
+	assert.True(t, gzipArchive.Len() < plainArchive.Len())
+	assert.True(t, zstdArchive.Len() < plainArchive.Len())
+	mock33.Close()
+
+	mock33 = testnode.New("", nil)
+	defer mock33.Close()
+	chain = mock33.GetBlockChain()
+
+	//校验和错误
+	data := append([]byte{}, plainArchive.Bytes()...)
+	data[len(data)-20] ^= 0xff
+	assert.Equal(t, blockchain.ErrArchiveChecksum, chain.ImportArchive(bytes.NewReader(data)))
+	assert.Equal(t, blockchain.ErrInvalidArchive, chain.ImportArchive(bytes.NewReader(data[8:])))
+
+	//归档不完整时导入已有的区块，之后可以继续导入
+	gzipData := gzipArchive.Bytes()
+	assert.Error(t, chain.ImportArchive(bytes.NewReader(gzipData[:len(gzipData)-8])))
+	require.NoError(t, chain.ImportArchive(bytes.NewReader(gzipData)))
+	header, err := chain.ProcGetLastHeaderMsg()
+	require.NoError(t, err)
+	assert.Equal(t, endHeader.Height, header.Height)
+	assert.Equal(t, endHeader.Hash, header.Hash)
+
+	//重复导入时跳过已经存在的区块
+	require.NoError(t, chain.ImportArchive(bytes.NewReader(plainArchive.Bytes())))
+	require.NoError(t, chain.ImportArchive(bytes.NewReader(zstdArchive.Bytes())))
+	assert.Equal(t, endHeight, chain.GetBlockHeight())
+}
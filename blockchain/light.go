@@ -0,0 +1,480 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+//轻节点只同步和保存区块头，不下载和执行区块。
+//通过本地校验过的区块头中的TxHash和StateHash来校验全节点提供的交易merkle证明和状态mavl证明
+//
+//没有区块数据时无法做共识校验，区块头中的难度也无法确认，所以轻节点的区块头链通过检查点锚定:
+//区块头分支必须和检查点一致，回滚深度不能超过maxReorgDepth，也不能回滚已经通过检查点的区块头；
+//本地区块头链通过检查点之后，从检查点同步到最新高度的区块头都通过hash链接到检查点，都可以用来校验证明，
+//还没有通过任何检查点时只有创世区块可以使用。
+
+const lightHeaderCount int64 = 1000 //轻节点一次请求的区块头个数
+
+var (
+	lightHeaderPrefix = []byte("LightHeader:")
+	lightTdPrefix     = []byte("LightTd:")
+	lightLastHeight   = []byte("LightLastHeight")
+)
+
+//存储轻节点height对应的区块头
+func calcLightHeaderKey(height int64) []byte {
+	return append(lightHeaderPrefix, []byte(fmt.Sprintf("%012d", height))...)
+}
+
+//存储轻节点区块头hash对应的总难度
+func calcLightTdKey(hash []byte) []byte {
+	return append(lightTdPrefix, hash...)
+}
+
+//getLightHeader 获取轻节点本地指定高度的区块头，创世区块使用blockstore中保存的区块头
+func (chain *BlockChain) getLightHeader(height int64) (*types.Header, error) {
+	if height == 0 {
+		return chain.blockStore.GetBlockHeaderByHeight(0)
+	}
+	data, err := chain.blockStore.db.Get(calcLightHeaderKey(height))
+	if data == nil || err != nil {
+		return nil, types.ErrHeightNotExist
+	}
+	var header types.Header
+	err = types.Decode(data, &header)
+	if err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+//getLightTd 获取轻节点区块头的总难度
+func (chain *BlockChain) getLightTd(hash []byte) (*big.Int, error) {
+	data, err := chain.blockStore.db.Get(calcLightTdKey(hash))
+	if data == nil || err != nil {
+		return chain.blockStore.GetTdByBlockHash(hash)
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+//getLightTip 获取轻节点本地最新的区块头，需要在lightLock中调用
+func (chain *BlockChain) getLightTip() (*types.Header, error) {
+	if chain.lightTip != nil {
+		return chain.lightTip, nil
+	}
+	var height int64
+	data, err := chain.blockStore.db.Get(lightLastHeight)
+	if data != nil && err == nil {
+		var lastHeight types.Int64
+		err = types.Decode(data, &lastHeight)
+		if err != nil {
+			return nil, err
+		}
+		height = lastHeight.Data
+	}
+	header, err := chain.getLightHeader(height)
+	if err != nil {
+		return nil, err
+	}
+	chain.lightTip = header
+	return header, nil
+}
+
+//GetLightTip 获取轻节点本地最新的区块头
+func (chain *BlockChain) GetLightTip() (*types.Header, error) {
+	chain.lightLock.Lock()
+	defer chain.lightLock.Unlock()
+	return chain.getLightTip()
+}
+
+//SynLightHeaders 轻节点定时从最高的peer请求本地最新区块头之后的区块头
+func (chain *BlockChain) SynLightHeaders() {
+	tip, err := chain.GetLightTip()
+	if err != nil {
+		synlog.Debug("SynLightHeaders GetLightTip", "err", err)
+		return
+	}
+	peer := chain.GetMaxPeerInfo()
+	if peer == nil || peer.Height <= tip.Height {
+		return
+	}
+	end := tip.Height + lightHeaderCount
+	if end > peer.Height {
+		end = peer.Height
+	}
+	err = chain.FetchBlockHeaders(tip.Height+1, end, peer.Name)
+	if err != nil {
+		synlog.Error("SynLightHeaders FetchBlockHeaders", "start", tip.Height+1, "end", end, "pid", peer.Name, "err", err)
+	}
+}
+
+//checkLightHeaders 校验区块头的hash、难度以及高度、hash和时间的连续性
+func checkLightHeaders(cfg *types.Chain33Config, headers []*types.Header) error {
+	for i, header := range headers {
+		if header == nil || !bytes.Equal(header.CalcHash(cfg), header.Hash) {
+			return types.ErrInvalidHeaders
+		}
+		//难度的目标值不能比配置的最低难度还要容易
+		target := difficulty.CompactToBig(header.Difficulty)
+		if target.Sign() <= 0 || target.Cmp(difficulty.CompactToBig(cfg.GetP(header.Height).PowLimitBits)) > 0 {
+			return types.ErrBlockHeaderDifficulty
+		}
+		if i == 0 {
+			continue
+		}
+		prev := headers[i-1]
+		if header.Height != prev.Height+1 || !bytes.Equal(header.ParentHash, prev.Hash) {
+			return types.ErrInvalidHeaders
+		}
+		if cfg.IsFork(header.Height, "ForkCheckBlockTime") && prev.BlockTime > header.BlockTime {
+			return types.ErrBlockTime
+		}
+	}
+	return nil
+}
+
+//checkLightBranch 新的区块头分支必须和检查点一致，回滚的深度不能超过maxReorgDepth，
+//本地区块头链上被删除的区块头不能是检查点
+func (chain *BlockChain) checkLightBranch(parent, tip *types.Header, branch []*types.Header) error {
+	if chain.maxReorgDepth > 0 && tip.Height-parent.Height > chain.maxReorgDepth {
+		return types.ErrReorgTooDeep
+	}
+	for _, header := range branch {
+		if point, ok := chain.getCheckpoint(header.Height); ok && !bytes.Equal(point, header.Hash) {
+			return types.ErrCheckpointMismatch
+		}
+	}
+	for height := branch[len(branch)-1].Height + 1; height <= tip.Height; height++ {
+		if _, ok := chain.getCheckpoint(height); ok {
+			return types.ErrCheckpointMismatch
+		}
+	}
+	return nil
+}
+
+//getLightAnchor 获取本地区块头链上已经通过的最高检查点高度，创世区块总是可信的
+func (chain *BlockChain) getLightAnchor() (int64, error) {
+	chain.lightLock.Lock()
+	tip, err := chain.getLightTip()
+	chain.lightLock.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	var anchor int64
+	for _, point := range chain.ProcGetCheckpoints().GetItems() {
+		if point.Height <= anchor || point.Height > tip.Height {
+			continue
+		}
+		header, err := chain.getLightHeader(point.Height)
+		if err == nil && bytes.Equal(header.Hash, point.Hash) {
+			anchor = point.Height
+		}
+	}
+	return anchor, nil
+}
+
+//ProcLightHeaders 轻节点处理从peer获取的区块头
+//找到和本地区块头链的连接点，新的分支总难度大于本地区块头链时替换本地的区块头
+func (chain *BlockChain) ProcLightHeaders(headers *types.Headers, pid string) error {
+	items := headers.GetItems()
+	if len(items) == 0 {
+		return types.ErrInvalidParam
+	}
+	cfg := chain.client.GetConfig()
+	err := checkLightHeaders(cfg, items)
+	if err != nil {
+		synlog.Error("ProcLightHeaders checkLightHeaders", "height", items[0].Height, "pid", pid, "err", err)
+		chain.RecordFaultPeer(pid, items[0].Height, items[0].Hash, err)
+		return err
+	}
+
+	chain.lightLock.Lock()
+	defer chain.lightLock.Unlock()
+	tip, err := chain.getLightTip()
+	if err != nil {
+		return err
+	}
+
+	//从后往前寻找和本地区块头相同的区块头，之后的区块头就是新的分支
+	var parent *types.Header
+	index := 0
+	for i := len(items) - 1; i >= 0; i-- {
+		local, err := chain.getLightHeader(items[i].Height)
+		if err == nil && bytes.Equal(local.Hash, items[i].Hash) {
+			parent = local
+			index = i + 1
+			break
+		}
+	}
+	if parent == nil {
+		first := items[0]
+		if first.Height == 0 {
+			chain.RecordFaultPeer(pid, first.Height, first.Hash, types.ErrInvalidHeaders)
+			return types.ErrInvalidHeaders
+		}
+		//和本地区块头不连续，等待下次同步
+		if first.Height-1 > tip.Height {
+			return nil
+		}
+		local, err := chain.getLightHeader(first.Height - 1)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(local.Hash, first.ParentHash) {
+			synlog.Info("ProcLightHeaders fork", "height", first.Height, "self parent", common.ToHex(local.Hash), "peer parent", common.ToHex(first.ParentHash), "pid", pid)
+			return chain.fetchLightForkHeaders(first.Height, tip.Height, pid)
+		}
+		parent = local
+	}
+	branch := items[index:]
+	if len(branch) == 0 {
+		return nil
+	}
+	if parent.Height > 0 && cfg.IsFork(branch[0].Height, "ForkCheckBlockTime") && parent.BlockTime > branch[0].BlockTime {
+		chain.RecordFaultPeer(pid, branch[0].Height, branch[0].Hash, types.ErrBlockTime)
+		return types.ErrBlockTime
+	}
+	err = chain.checkLightBranch(parent, tip, branch)
+	if err == types.ErrCheckpointMismatch {
+		chain.RecordFaultPeer(pid, branch[0].Height, branch[0].Hash, err)
+	}
+	if err != nil {
+		synlog.Error("ProcLightHeaders checkLightBranch", "height", branch[0].Height, "parent", parent.Height, "tipheight", tip.Height, "pid", pid, "err", err)
+		return err
+	}
+
+	td, err := chain.getLightTd(parent.Hash)
+	if err != nil {
+		return err
+	}
+	tds := make([]*big.Int, len(branch))
+	for i, header := range branch {
+		td = new(big.Int).Add(td, difficulty.CalcWork(header.Difficulty))
+		tds[i] = td
+	}
+	tiptd, err := chain.getLightTd(tip.Hash)
+	if err != nil {
+		return err
+	}
+	if td.Cmp(tiptd) <= 0 {
+		synlog.Debug("ProcLightHeaders branch td not bigger", "height", branch[len(branch)-1].Height, "tipheight", tip.Height, "pid", pid)
+		return nil
+	}
+	return chain.saveLightHeaders(branch, tds, tip)
+}
+
+//fetchLightForkHeaders 出现分叉时向后请求区块头寻找分叉点，请求的区块头需要超过本地最新高度才能比较总难度
+func (chain *BlockChain) fetchLightForkHeaders(height int64, tipheight int64, pid string) error {
+	start := height - BackBlockNum
+	if start < 0 {
+		start = 0
+	}
+	end := start + lightHeaderCount - 1
+	peerinfo := chain.GetPeerInfo(pid)
+	if peerinfo != nil && end > peerinfo.Height {
+		end = peerinfo.Height
+	}
+	if end <= tipheight {
+		synlog.Error("fetchLightForkHeaders Not Roll Back!", "selfheight", tipheight, "start", start, "end", end, "pid", pid)
+		return types.ErrNotRollBack
+	}
+	err := chain.FetchBlockHeaders(start, end, pid)
+	if err != nil {
+		synlog.Info("fetchLightForkHeaders FetchBlockHeaders", "err", err)
+	}
+	return types.ErrContinueBack
+}
+
+//saveLightHeaders 保存新的区块头分支并更新最新区块头，删除原来区块头链上多余的区块头
+func (chain *BlockChain) saveLightHeaders(branch []*types.Header, tds []*big.Int, tip *types.Header) error {
+	newbatch := chain.blockStore.NewBatch(true)
+	for i, header := range branch {
+		newbatch.Set(calcLightHeaderKey(header.Height), types.Encode(header))
+		newbatch.Set(calcLightTdKey(header.Hash), tds[i].Bytes())
+	}
+	last := branch[len(branch)-1]
+	for height := last.Height + 1; height <= tip.Height; height++ {
+		newbatch.Delete(calcLightHeaderKey(height))
+	}
+	newbatch.Set(lightLastHeight, types.Encode(&types.Int64{Data: last.Height}))
+	err := newbatch.Write()
+	if err != nil {
+		return err
+	}
+	chain.lightTip = last
+	synlog.Info("saveLightHeaders", "start", branch[0].Height, "end", last.Height, "hash", common.ToHex(last.Hash))
+	return nil
+}
+
+//getVerifyHeader 获取校验证明使用的区块头，轻节点只能使用已经被检查点锚定的区块头链上的区块头
+func (chain *BlockChain) getVerifyHeader(height int64) (*types.Header, error) {
+	if chain.cfg.EnableLightNode {
+		header, err := chain.getLightHeader(height)
+		if err != nil {
+			return nil, err
+		}
+		anchor, err := chain.getLightAnchor()
+		if err != nil {
+			return nil, err
+		}
+		if height > 0 && anchor == 0 {
+			return nil, types.ErrLightUnanchored
+		}
+		return header, nil
+	}
+	return chain.blockStore.GetBlockHeaderByHeight(height)
+}
+
+//ProcVerifyTxProof 通过区块头中的TxHash校验交易的merkle证明
+//ForkRootHash之前使用交易hash计算单层merkle树，之后使用交易fullhash逐层计算
+func (chain *BlockChain) ProcVerifyTxProof(detail *types.TransactionDetail) error {
+	if detail == nil || detail.Tx == nil {
+		return types.ErrInvalidParam
+	}
+	header, err := chain.getVerifyHeader(detail.Height)
+	if err != nil {
+		return err
+	}
+	cfg := chain.client.GetConfig()
+	if !cfg.IsFork(detail.Height, "ForkRootHash") {
+		root := merkle.GetMerkleRootFromBranch(detail.Proofs, detail.Tx.Hash(), uint32(detail.Index))
+		if !bytes.Equal(root, header.TxHash) {
+			return types.ErrTxProof
+		}
+		return nil
+	}
+	if len(detail.TxProofs) == 0 {
+		return types.ErrTxProof
+	}
+	root := detail.Tx.FullHash()
+	for _, proof := range detail.TxProofs {
+		root = merkle.GetMerkleRootFromBranch(proof.Proofs, root, proof.Index)
+		//子链的roothash需要和计算出来的一致
+		if proof.RootHash != nil && !bytes.Equal(root, proof.RootHash) {
+			return types.ErrTxProof
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return types.ErrTxProof
+	}
+	return nil
+}
+
+//ProcVerifyStateProof 通过区块头中的StateHash校验状态的mavl证明
+func (chain *BlockChain) ProcVerifyStateProof(proof *types.StateProof) error {
+	if proof == nil || len(proof.Key) == 0 {
+		return types.ErrInvalidParam
+	}
+	header, err := chain.getVerifyHeader(proof.Height)
+	if err != nil {
+		return err
+	}
+	//ForkBlockHash之前区块hash不包含StateHash，轻节点无法确认区块头中的StateHash
+	cfg := chain.client.GetConfig()
+	if chain.cfg.EnableLightNode && !cfg.IsFork(proof.Height, "ForkBlockHash") {
+		return types.ErrStateProof
+	}
+	if !bytes.Equal(header.StateHash, proof.StateHash) {
+		return types.ErrStateProof
+	}
+	kv := types.KeyValue{Key: proof.Key, Value: proof.Value}
+	if !mavl.VerifyKVPairProof(nil, header.StateHash, kv, proof.Proof) {
+		return types.ErrStateProof
+	}
+	return nil
+}
+
+//ProcGetStateProof 全节点获取指定高度状态树中key的value以及证明
+func (chain *BlockChain) ProcGetStateProof(req *types.ReqStateProof) (*types.StateProof, error) {
+	if req == nil || len(req.Key) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if chain.cfg.EnableLightNode {
+		return nil, types.ErrLightNode
+	}
+	header, err := chain.blockStore.GetBlockHeaderByHeight(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	msg := chain.client.NewMessage("store", types.EventStoreGetProof, &types.StoreGet{StateHash: header.StateHash, Keys: [][]byte{req.Key}})
+	err = chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	proof, ok := resp.GetData().(*types.StateProof)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	proof.Height = req.Height
+	return proof, nil
+}
+
+//ProcGetLightHeader 获取轻节点本地保存的区块头，height小于0时返回最新的区块头
+func (chain *BlockChain) ProcGetLightHeader(req *types.ReqInt) (*types.Header, error) {
+	if !chain.cfg.EnableLightNode {
+		return nil, types.ErrActionNotSupport
+	}
+	if req.Height < 0 {
+		return chain.GetLightTip()
+	}
+	return chain.getLightHeader(req.Height)
+}
+
+func (chain *BlockChain) verifyTxProof(msg *queue.Message) {
+	detail := msg.Data.(*types.TransactionDetail)
+	err := chain.ProcVerifyTxProof(detail)
+	if err != nil {
+		chainlog.Debug("ProcVerifyTxProof", "height", detail.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventReply, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+}
+
+func (chain *BlockChain) verifyStateProof(msg *queue.Message) {
+	proof := msg.Data.(*types.StateProof)
+	err := chain.ProcVerifyStateProof(proof)
+	if err != nil {
+		chainlog.Debug("ProcVerifyStateProof", "height", proof.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventReply, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+}
+
+func (chain *BlockChain) getStateProof(msg *queue.Message) {
+	req := msg.Data.(*types.ReqStateProof)
+	proof, err := chain.ProcGetStateProof(req)
+	if err != nil {
+		chainlog.Error("ProcGetStateProof", "height", req.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventGetStateProof, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetStateProof, proof))
+}
+
+func (chain *BlockChain) getLightHeaderMsg(msg *queue.Message) {
+	req := msg.Data.(*types.ReqInt)
+	header, err := chain.ProcGetLightHeader(req)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventGetLightHeader, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetLightHeader, header))
+}
@@ -0,0 +1,154 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightNode(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	fullchain := mock33.GetBlockChain()
+	cfg := mock33.GetClient().GetConfig()
+
+	var hash []byte
+	for i := 0; i < 5; i++ {
+		hash = sendMinedTx(t, mock33)
+	}
+	height := fullchain.GetBlockHeight()
+	headers, err := fullchain.ProcGetHeadersMsg(&types.ReqBlocks{Start: 1, End: height})
+	require.NoError(t, err)
+
+	lightcfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	lightcfg.GetModuleConfig().BlockChain.EnableLightNode = true
+	lightcfg.GetModuleConfig().BlockChain.Checkpoints = []string{fmt.Sprintf("%d:%s", height, common.ToHex(headers.Items[height-1].Hash))}
+	light33 := testnode.NewWithConfig(lightcfg, nil)
+	defer light33.Close()
+	lightchain := light33.GetBlockChain()
+
+	//区块头被篡改
+	bad := types.Clone(headers).(*types.Headers)
+	bad.Items[1].StateHash = []byte("bad")
+	assert.Equal(t, types.ErrInvalidHeaders, lightchain.ProcLightHeaders(bad, "pid"))
+	//难度比配置的最低难度还要容易
+	bad = types.Clone(headers).(*types.Headers)
+	bad.Items[0].Difficulty = 0
+	bad.Items[0].Hash = bad.Items[0].CalcHash(cfg)
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, lightchain.ProcLightHeaders(&types.Headers{Items: bad.Items[:1]}, "pid"))
+
+	//还没有通过检查点的区块头不能用来校验证明
+	key := account.NewCoinsAccount(cfg).AccountKey(mock33.GetGenesisAddress())
+	require.NoError(t, lightchain.ProcLightHeaders(&types.Headers{Items: headers.Items[:height-1]}, "pid"))
+	proof, err := fullchain.ProcGetStateProof(&types.ReqStateProof{Height: height - 1, Key: key})
+	require.NoError(t, err)
+	assert.Equal(t, types.ErrLightUnanchored, lightchain.ProcVerifyStateProof(proof))
+
+	//保存区块头之后重复的区块头不做处理
+	require.NoError(t, lightchain.ProcLightHeaders(headers, "pid"))
+	require.NoError(t, lightchain.ProcLightHeaders(&types.Headers{Items: headers.Items[2:]}, "pid"))
+	tip, err := lightchain.ProcGetLightHeader(&types.ReqInt{Height: -1})
+	require.NoError(t, err)
+	assert.Equal(t, height, tip.Height)
+	assert.Equal(t, headers.Items[height-1].Hash, tip.Hash)
+	_, err = lightchain.ProcGetLightHeader(&types.ReqInt{Height: height + 1})
+	assert.Equal(t, types.ErrHeightNotExist, err)
+	_, err = fullchain.ProcGetLightHeader(&types.ReqInt{Height: -1})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+
+	//和检查点不一致的分支，以及会删除检查点的分支都不能替换本地区块头
+	forged := forgeHeaders(cfg, headers.Items[height-3:])
+	assert.Equal(t, types.ErrCheckpointMismatch, lightchain.ProcLightHeaders(&types.Headers{Items: forged}, "pid"))
+	assert.Equal(t, types.ErrCheckpointMismatch, lightchain.ProcLightHeaders(&types.Headers{Items: forged[:2]}, "pid"))
+	tip, err = lightchain.ProcGetLightHeader(&types.ReqInt{Height: -1})
+	require.NoError(t, err)
+	assert.Equal(t, headers.Items[height-1].Hash, tip.Hash)
+
+	//轻节点不处理区块
+	_, err = lightchain.ProcAddBlockMsg(false, &types.BlockDetail{Block: mock33.GetLastBlock()}, "pid")
+	assert.Equal(t, types.ErrLightNode, err)
+
+	//交易的merkle证明
+	detail, err := fullchain.ProcQueryTxMsg(hash)
+	require.NoError(t, err)
+	require.NoError(t, fullchain.ProcVerifyTxProof(detail))
+	require.NoError(t, lightchain.ProcVerifyTxProof(detail))
+	detail.Tx.Fee++
+	assert.Equal(t, types.ErrTxProof, lightchain.ProcVerifyTxProof(detail))
+
+	//状态的mavl证明，检查点之前的区块头也可以使用
+	proof, err = fullchain.ProcGetStateProof(&types.ReqStateProof{Height: height - 1, Key: key})
+	require.NoError(t, err)
+	require.NoError(t, lightchain.ProcVerifyStateProof(proof))
+	proof, err = fullchain.ProcGetStateProof(&types.ReqStateProof{Height: height, Key: key})
+	require.NoError(t, err)
+	require.NoError(t, lightchain.ProcVerifyStateProof(proof))
+	var acc types.Account
+	require.NoError(t, types.Decode(proof.Value, &acc))
+	assert.Equal(t, mock33.GetGenesisAddress(), acc.Addr)
+	_, err = lightchain.ProcGetStateProof(&types.ReqStateProof{Height: height, Key: key})
+	assert.Equal(t, types.ErrLightNode, err)
+
+	acc.Balance++
+	proof.Value = types.Encode(&acc)
+	assert.Equal(t, types.ErrStateProof, lightchain.ProcVerifyStateProof(proof))
+	proof.Height = height + 1
+	assert.Equal(t, types.ErrHeightNotExist, lightchain.ProcVerifyStateProof(proof))
+
+	//从检查点同步的之后的区块头链接到检查点，可以用来校验最新的证明
+	hash = sendMinedTx(t, mock33)
+	headers, err = fullchain.ProcGetHeadersMsg(&types.ReqBlocks{Start: height + 1, End: fullchain.GetBlockHeight()})
+	require.NoError(t, err)
+	require.NoError(t, lightchain.ProcLightHeaders(headers, "pid"))
+	detail, err = fullchain.ProcQueryTxMsg(hash)
+	require.NoError(t, err)
+	require.NoError(t, lightchain.ProcVerifyTxProof(detail))
+	proof, err = fullchain.ProcGetStateProof(&types.ReqStateProof{Height: detail.Height, Key: key})
+	require.NoError(t, err)
+	require.NoError(t, lightchain.ProcVerifyStateProof(proof))
+}
+
+//sendMinedTx 发送交易并等待交易被打包，不依赖固定的等待时间
+func sendMinedTx(t *testing.T, mock33 *testnode.Chain33Mock) []byte {
+	cfg := mock33.GetClient().GetConfig()
+	txs := util.GenCoinsTxs(cfg, mock33.GetGenesisKey(), 1)
+	_, err := mock33.GetAPI().SendTx(txs[0])
+	require.NoError(t, err)
+	hash := txs[0].Hash()
+	for start := time.Now(); time.Since(start) < time.Minute; time.Sleep(sendTxWait) {
+		if _, err = mock33.GetBlockChain().ProcQueryTxMsg(hash); err == nil {
+			break
+		}
+	}
+	require.NoError(t, err)
+	return hash
+}
+
+//forgeHeaders 修改第一个区块头的时间，重新计算之后区块头的hash和链接
+func forgeHeaders(cfg *types.Chain33Config, headers []*types.Header) []*types.Header {
+	var forged []*types.Header
+	for i, header := range headers {
+		header = types.Clone(header).(*types.Header)
+		if i == 0 {
+			header.BlockTime++
+		} else {
+			header.ParentHash = forged[i-1].Hash
+		}
+		header.Hash = header.CalcHash(cfg)
+		forged = append(forged, header)
+	}
+	return forged
+}
@@ -58,6 +58,29 @@ func (_m *SequenceStore) GetBlockSequence(seq int64) (*types.BlockSequence, erro
 	return r0, r1
 }
 
+// GetReorgEventBySequence provides a mock function with given fields: seq
+func (_m *SequenceStore) GetReorgEventBySequence(seq int64) (*types.ReorgEvent, error) {
+	ret := _m.Called(seq)
+
+	var r0 *types.ReorgEvent
+	if rf, ok := ret.Get(0).(func(int64) *types.ReorgEvent); ok {
+		r0 = rf(seq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReorgEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(seq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSequenceByHash provides a mock function with given fields: hash
 func (_m *SequenceStore) GetSequenceByHash(hash []byte) (int64, error) {
 	ret := _m.Called(hash)
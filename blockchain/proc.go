@@ -83,6 +83,14 @@ func (chain *BlockChain) ProcRecvMsg() {
 			go chain.processMsg(msg, reqnum, chain.listBlockSeqCB)
 		case types.EventGetSeqCBLastNum:
 			go chain.processMsg(msg, reqnum, chain.getSeqCBLastNum)
+		case types.EventGetPushSeqData:
+			go chain.processMsg(msg, reqnum, chain.getPushSeqData)
+		case types.EventAckPushSeq:
+			go chain.processMsg(msg, reqnum, chain.ackPushSeq)
+		case types.EventGetReindexProgress:
+			go chain.processMsg(msg, reqnum, chain.getReindexProgress)
+		case types.EventGetBlockStateDiff:
+			go chain.processMsg(msg, reqnum, chain.getBlockStateDiff)
 		case types.EventGetLastBlockMainSequence:
 			go chain.processMsg(msg, reqnum, chain.GetLastBlockMainSequence)
 		case types.EventGetMainSeqByHash:
@@ -104,6 +112,34 @@ func (chain *BlockChain) ProcRecvMsg() {
 		case types.EventGetParaTxByTitleAndHeight:
 			go chain.processMsg(msg, reqnum, chain.getParaTxByTitleAndHeight)
 
+			//其他节点快照同步时获取快照分片
+		case types.EventGetSnapshotChunk:
+			go chain.processMsg(msg, reqnum, chain.getSnapshotChunk)
+
+			//通过本地区块头校验交易和状态证明
+		case types.EventVerifyTxProof:
+			go chain.processMsg(msg, reqnum, chain.verifyTxProof)
+
+		case types.EventVerifyStateProof:
+			go chain.processMsg(msg, reqnum, chain.verifyStateProof)
+
+			//获取状态证明
+		case types.EventGetStateProof:
+			go chain.processMsg(msg, reqnum, chain.getStateProof)
+
+			//获取轻节点本地保存的区块头
+		case types.EventGetLightHeader:
+			go chain.processMsg(msg, reqnum, chain.getLightHeaderMsg)
+
+		case types.EventGetReorgEvents:
+			go chain.processMsg(msg, reqnum, chain.getReorgEvents)
+
+		case types.EventAddCheckpoint:
+			go chain.processMsg(msg, reqnum, chain.addCheckpoint)
+
+		case types.EventGetCheckpoints:
+			go chain.processMsg(msg, reqnum, chain.getCheckpoints)
+
 		default:
 			go chain.processMsg(msg, reqnum, chain.unknowMsg)
 		}
@@ -148,6 +184,26 @@ func (chain *BlockChain) getSeqCBLastNum(msg *queue.Message) {
 	msg.Reply(chain.client.NewMessage("rpc", types.EventGetSeqCBLastNum, lastNum))
 }
 
+func (chain *BlockChain) getPushSeqData(msg *queue.Message) {
+	data := (msg.Data).(*types.ReqString)
+	reply, err := chain.ProcGetPushSeqData(data.Data)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventGetPushSeqData, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventGetPushSeqData, reply))
+}
+
+func (chain *BlockChain) ackPushSeq(msg *queue.Message) {
+	ack := (msg.Data).(*types.PushSeqAck)
+	err := chain.ProcAckPushSeq(ack)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventReply, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true}))
+}
+
 func (chain *BlockChain) queryTx(msg *queue.Message) {
 	txhash := (msg.Data).(*types.ReqHash)
 	txDetail, err := chain.ProcQueryTxMsg(txhash.Hash)
@@ -161,13 +161,23 @@ func (b *BlockChain) maybeAcceptBlock(broadcast bool, block *types.BlockDetail,
 		return nil, false, types.ErrBlockHeightNoMatch
 	}
 
+	//拒绝超过最大回滚深度或者与检查点冲突的区块，并记录对端节点
+	cfg := b.client.GetConfig()
+	blockHash := block.Block.Hash(cfg)
+	err := b.checkReorg(prevNode, blockHeight, blockHash)
+	if err != nil {
+		chainlog.Error("maybeAcceptBlock checkReorg", "height", blockHeight, "hash", common.ToHex(blockHash), "pid", pid, "err", err)
+		b.RecordFaultPeer(pid, blockHeight, blockHash, err)
+		return nil, false, err
+	}
+
 	//将此block存储到db中，方便后面blockchain重组时使用，加入到主链saveblock时通过hash重新覆盖即可
 	sync := true
 	if atomic.LoadInt32(&b.isbatchsync) == 0 {
 		sync = false
 	}
 
-	err := b.blockStore.dbMaybeStoreBlock(block, sync)
+	err = b.blockStore.dbMaybeStoreBlock(block, sync)
 	if err != nil {
 		if err == types.ErrDataBaseDamage {
 			chainlog.Error("dbMaybeStoreBlock newbatch.Write", "err", err)
@@ -176,7 +186,6 @@ func (b *BlockChain) maybeAcceptBlock(broadcast bool, block *types.BlockDetail,
 		return nil, false, err
 	}
 	// 创建一个node并添加到内存中index
-	cfg := b.client.GetConfig()
 	newNode := newBlockNode(cfg, broadcast, block.Block, pid, sequence)
 	if prevNode != nil {
 		newNode.parent = prevNode
@@ -520,6 +529,9 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		}
 	}
 
+	//记录链重组事件，重组失败时只保留已经处理的区块
+	reorg := b.recordReorgEvent(detachNodes, attachNodes)
+
 	// Disconnect blocks from the main chain.
 	for i, e := 0, detachNodes.Front(); e != nil; i, e = i+1, e.Next() {
 		n := e.Value.(*blockNode)
@@ -528,6 +540,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		// Update the database and chain state.
 		err := b.disconnectBlock(n, block, n.sequence)
 		if err != nil {
+			b.updateReorgEvent(reorg, i, 0)
 			return err
 		}
 	}
@@ -540,6 +553,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		// Update the database and chain state.
 		_, err := b.connectBlock(n, block)
 		if err != nil {
+			b.updateReorgEvent(reorg, detachNodes.Len(), i)
 			return err
 		}
 	}
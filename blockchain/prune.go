@@ -0,0 +1,177 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync/atomic"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//区块裁剪模式
+const (
+	PruneModeArchive = "archive" //保存所有的区块数据，不能开启精简localdb
+	PruneModeFull    = "full"    //默认模式，保存所有区块，可以开启精简localdb
+	PruneModePruned  = "pruned"  //只保存最近的区块body和receipt
+)
+
+//PrunePolicy 区块裁剪策略
+type PrunePolicy interface {
+	//Pruning 是否需要裁剪区块数据
+	Pruning() bool
+	//PruneHeight 返回当前高度下可以删除区块body和receipt的最大高度
+	PruneHeight(height int64) int64
+	//AllowReduce 是否允许开启精简localdb
+	AllowReduce() bool
+}
+
+//PruneCreator 通过blockchain配置创建裁剪策略
+type PruneCreator func(cfg *types.BlockChain) PrunePolicy
+
+var prunePolicies = make(map[string]PruneCreator)
+
+//RegisterPrunePolicy 注册区块裁剪策略
+func RegisterPrunePolicy(mode string, create PruneCreator) {
+	if create == nil {
+		panic("RegisterPrunePolicy: create is nil")
+	}
+	if _, ok := prunePolicies[mode]; ok {
+		panic("RegisterPrunePolicy: duplicate mode " + mode)
+	}
+	prunePolicies[mode] = create
+}
+
+func init() {
+	RegisterPrunePolicy(PruneModeArchive, func(cfg *types.BlockChain) PrunePolicy {
+		return &archivePolicy{}
+	})
+	RegisterPrunePolicy(PruneModeFull, func(cfg *types.BlockChain) PrunePolicy {
+		return &fullPolicy{}
+	})
+	RegisterPrunePolicy(PruneModePruned, func(cfg *types.BlockChain) PrunePolicy {
+		return &prunedPolicy{retain: cfg.PruneRetainBlocks}
+	})
+}
+
+//newPrunePolicy 没有配置裁剪模式时使用full模式
+func newPrunePolicy(cfg *types.BlockChain) PrunePolicy {
+	mode := cfg.PruneMode
+	if mode == "" {
+		mode = PruneModeFull
+	}
+	create, ok := prunePolicies[mode]
+	if !ok {
+		panic("unknown prune mode " + mode)
+	}
+	return create(cfg)
+}
+
+type archivePolicy struct{}
+
+func (p *archivePolicy) Pruning() bool                  { return false }
+func (p *archivePolicy) PruneHeight(height int64) int64 { return 0 }
+func (p *archivePolicy) AllowReduce() bool              { return false }
+
+type fullPolicy struct{}
+
+func (p *fullPolicy) Pruning() bool                  { return false }
+func (p *fullPolicy) PruneHeight(height int64) int64 { return 0 }
+func (p *fullPolicy) AllowReduce() bool              { return true }
+
+//prunedPolicy 保留最近retain个区块，保留的个数不能小于可回滚的高度
+type prunedPolicy struct {
+	retain int64
+}
+
+func (p *prunedPolicy) Pruning() bool { return true }
+
+func (p *prunedPolicy) PruneHeight(height int64) int64 {
+	retain := p.retain
+	if retain < ReduceHeight {
+		retain = ReduceHeight
+	}
+	return height - retain
+}
+
+func (p *prunedPolicy) AllowReduce() bool { return true }
+
+//PruneChain 按照裁剪策略启动区块裁剪，已经裁剪过的节点不能切换到不裁剪的模式
+func (chain *BlockChain) PruneChain() {
+	if !chain.prunePolicy.Pruning() {
+		if chain.blockStore.GetPrunedHeight() != 0 {
+			panic("toml config disable prune blocks, but database has pruned blocks")
+		}
+		return
+	}
+	chain.reducewg.Add(1)
+	go chain.PruneBlocks()
+}
+
+//PruneBlocks 定时裁剪过期的区块数据
+func (chain *BlockChain) PruneBlocks() {
+	defer chain.reducewg.Done()
+
+	// 10s检测一次是否需要裁剪区块
+	checkTicker := time.NewTicker(10 * time.Second)
+	defer checkTicker.Stop()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		case <-checkTicker.C:
+			chain.TryPruneBlocks(100)
+		}
+	}
+}
+
+//TryPruneBlocks 可裁剪的区块超过rangeHeight个时删除区块body，receipt以及交易索引中的交易内容，返回最新的裁剪高度
+func (chain *BlockChain) TryPruneBlocks(rangeHeight int64) int64 {
+	if rangeHeight <= 0 {
+		rangeHeight = 100
+	}
+	prunedHeight := chain.blockStore.GetPrunedHeight()
+	pruneHeight := chain.prunePolicy.PruneHeight(chain.GetBlockHeight())
+	if pruneHeight-prunedHeight < rangeHeight {
+		return prunedHeight
+	}
+	sync := true
+	if atomic.LoadInt32(&chain.isbatchsync) == 0 {
+		sync = false
+	}
+	chain.walkOver(prunedHeight+1, pruneHeight, sync, chain.pruneBody,
+		func(batch dbm.Batch, height int64) {
+			chain.blockStore.setPrunedHeight(batch, height)
+		})
+	chainlog.Debug("TryPruneBlocks", "pruned height", pruneHeight)
+	return pruneHeight
+}
+
+//pruneBody 删除区块的body和receipt，交易索引只保留交易所在的高度和索引
+func (chain *BlockChain) pruneBody(batch dbm.Batch, height int64) {
+	blockDetail, err := chain.blockStore.LoadBlockByHeight(height)
+	if err != nil {
+		chainlog.Debug("pruneBody LoadBlockByHeight", "height", height, "error", err)
+		return
+	}
+	hash := blockDetail.Block.Hash(chain.client.GetConfig())
+	bodykvs, err := delBlockBodyTable(chain.blockStore.db, height, hash)
+	if err != nil {
+		chainlog.Debug("pruneBody delBlockBodyTable", "height", height, "error", err)
+		return
+	}
+	receiptkvs, err := delBlockReceiptTable(chain.blockStore.db, height, hash)
+	if err != nil {
+		chainlog.Debug("pruneBody delBlockReceiptTable", "height", height, "error", err)
+		return
+	}
+	for _, kv := range append(bodykvs, receiptkvs...) {
+		if kv.GetValue() == nil {
+			batch.Delete(kv.GetKey())
+		}
+	}
+	chain.reduceIndexTx(batch, blockDetail.GetBlock())
+}
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrunePolicyConfig(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().BlockChain.PruneMode = "unknown"
+	assert.Panics(t, func() { blockchain.New(cfg) })
+
+	cfg = testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().BlockChain.PruneMode = blockchain.PruneModeArchive
+	cfg.GetModuleConfig().BlockChain.EnableReduceLocaldb = true
+	assert.Panics(t, func() { blockchain.New(cfg) })
+}
+
+func TestTryPruneBlocks(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().BlockChain.PruneMode = blockchain.PruneModePruned
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	chain := mock33.GetBlockChain()
+
+	blockchain.ReduceHeight = 5
+	defer func() {
+		blockchain.ReduceHeight = 10000
+	}()
+
+	txs := util.GenCoinsTxs(cfg, mock33.GetGenesisKey(), 20)
+	for i := 0; i < len(txs); i++ {
+		reply, err := mock33.GetAPI().SendTx(txs[i])
+		require.NoError(t, err)
+		assert.True(t, reply.IsOk)
+		require.NoError(t, mock33.WaitHeight(int64(i+1)))
+	}
+	height := chain.GetBlockHeight()
+	assert.Equal(t, int64(0), chain.TryPruneBlocks(height))
+	pruneHeight := chain.TryPruneBlocks(10)
+	assert.Equal(t, height-5, pruneHeight)
+	assert.Equal(t, pruneHeight, chain.TryPruneBlocks(10))
+
+	//区块body和receipt被裁剪，区块头仍然保留
+	store := chain.GetStore()
+	_, err := store.LoadBlockByHeight(1)
+	assert.Equal(t, types.ErrBlockPruned, err)
+	_, err = store.LoadBlockByHeight(pruneHeight)
+	assert.Equal(t, types.ErrBlockPruned, err)
+	block, err := store.LoadBlockByHeight(pruneHeight + 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(block.Receipts))
+	_, err = store.LoadBlockByHeight(0)
+	assert.NoError(t, err)
+	headers, err := chain.ProcGetHeadersMsg(&types.ReqBlocks{Start: 1, End: height})
+	require.NoError(t, err)
+	assert.Equal(t, int(height), len(headers.Items))
+
+	//交易所在区块被裁剪
+	_, err = chain.ProcQueryTxMsg(txs[0].Hash())
+	assert.Equal(t, types.ErrBlockPruned, err)
+	_, err = chain.ProcQueryTxMsg(txs[len(txs)-1].Hash())
+	assert.NoError(t, err)
+	has, err := chain.HasTx(txs[0].Hash(), false)
+	require.NoError(t, err)
+	assert.True(t, has)
+}
@@ -0,0 +1,159 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+)
+
+//hasSeqFilter callback是否设置了交易的过滤条件
+func hasSeqFilter(cb *types.BlockSeqCB) bool {
+	return len(cb.Execers) > 0 || len(cb.Addrs) > 0 || len(cb.LogTypes) > 0
+}
+
+//checkSeqFilter 检查callback的过滤条件，过滤推送的是交易详情，不能和只推送header同时设置
+func checkSeqFilter(cb *types.BlockSeqCB) error {
+	if !hasSeqFilter(cb) {
+		return nil
+	}
+	if cb.IsHeader {
+		return types.ErrInvalidParam
+	}
+	for _, execer := range cb.Execers {
+		if execer == "" {
+			return types.ErrInvalidParam
+		}
+	}
+	for _, addr := range cb.Addrs {
+		if err := address.CheckAddress(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//seqFilter 交易过滤条件，不同条件之间是与的关系，同一条件的多个值之间是或的关系
+type seqFilter struct {
+	execers  map[string]bool
+	addrs    map[string]bool
+	logTypes map[int32]bool
+}
+
+func newSeqFilter(cb *types.BlockSeqCB) *seqFilter {
+	filter := &seqFilter{
+		execers:  make(map[string]bool),
+		addrs:    make(map[string]bool),
+		logTypes: make(map[int32]bool),
+	}
+	for _, execer := range cb.Execers {
+		filter.execers[execer] = true
+	}
+	for _, addr := range cb.Addrs {
+		filter.addrs[addr] = true
+	}
+	for _, ty := range cb.LogTypes {
+		filter.logTypes[ty] = true
+	}
+	return filter
+}
+
+//match 平行链交易的执行器既可以匹配完整的名字，也可以匹配去掉前缀之后的名字
+func (f *seqFilter) match(tx *types.Transaction, receipt *types.ReceiptData) bool {
+	if len(f.execers) > 0 && !f.execers[string(tx.Execer)] && !f.execers[string(types.GetRealExecName(tx.Execer))] {
+		return false
+	}
+	if len(f.addrs) > 0 && !f.addrs[tx.From()] && !f.addrs[tx.GetRealToAddr()] {
+		return false
+	}
+	if len(f.logTypes) > 0 {
+		if receipt == nil {
+			return false
+		}
+		for _, log := range receipt.Logs {
+			if f.logTypes[log.Ty] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+//getFilterDataBySeq 获取seq对应区块中符合过滤条件的交易，新增区块的交易附带merkle证明
+func (p *pushseq) getFilterDataBySeq(filter *seqFilter, seq int64) (*types.FilterSeq, int, error) {
+	seqdata, err := p.store.GetBlockSequence(seq)
+	if err != nil {
+		return nil, 0, err
+	}
+	detail, _, err := p.store.LoadBlockBySequence(seq)
+	if err != nil {
+		return nil, 0, err
+	}
+	reorg, err := p.getReorgEvent(seq, seqdata)
+	if err != nil {
+		return nil, 0, err
+	}
+	header, err := p.store.GetBlockHeaderByHash(seqdata.Hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	block := detail.Block
+	filterSeq := &types.FilterSeq{Num: seq, Seq: seqdata, Header: header, Reorg: reorg}
+	for i, tx := range block.Txs {
+		var receipt *types.ReceiptData
+		if i < len(detail.Receipts) {
+			receipt = detail.Receipts[i]
+		}
+		if !filter.match(tx, receipt) {
+			continue
+		}
+		txDetail := &types.TransactionDetail{
+			Tx:        tx,
+			Receipt:   receipt,
+			Height:    block.Height,
+			Index:     int64(i),
+			Blocktime: block.BlockTime,
+			Fromaddr:  tx.From(),
+		}
+		if seqdata.Type == types.AddBlock && p.setProofs != nil {
+			p.setProofs(txDetail, block, int32(i))
+		}
+		filterSeq.Txs = append(filterSeq.Txs, txDetail)
+	}
+	return filterSeq, types.Size(filterSeq), nil
+}
+
+//getFilterSeqs 按照过滤条件推送交易，区块中没有符合条件的交易时只推送header，保证seq连续
+func (p *pushseq) getFilterSeqs(cb *types.BlockSeqCB, seq int64, seqCount, maxSize int) ([]byte, int64, error) {
+	filter := newSeqFilter(cb)
+	seqs := &types.FilterSeqs{}
+	totalSize := 0
+	for i := 0; i < seqCount; i++ {
+		seq, size, err := p.getFilterDataBySeq(filter, seq+int64(i))
+		if err != nil {
+			return nil, -1, err
+		}
+		if totalSize == 0 || totalSize+size < maxSize {
+			seqs.Seqs = append(seqs.Seqs, seq)
+			totalSize += size
+		} else {
+			break
+		}
+	}
+	updateSeq := seqs.Seqs[0].Num + int64(len(seqs.Seqs)) - 1
+
+	var postdata []byte
+	var err error
+	if cb.Encode == "json" {
+		postdata, err = types.PBToJSON(seqs)
+		if err != nil {
+			return nil, -1, err
+		}
+	} else {
+		postdata = types.Encode(seqs)
+	}
+	return postdata, updateSeq, nil
+}
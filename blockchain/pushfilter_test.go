@@ -0,0 +1,88 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	bmocks "github.com/33cn/chain33/blockchain/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSeqFilter(t *testing.T) {
+	addr, _ := util.Genaddress()
+	assert.NoError(t, checkSeqFilter(&types.BlockSeqCB{Name: "test"}))
+	assert.NoError(t, checkSeqFilter(&types.BlockSeqCB{Execers: []string{"coins"}, Addrs: []string{addr}, LogTypes: []int32{2}}))
+	assert.Equal(t, types.ErrInvalidParam, checkSeqFilter(&types.BlockSeqCB{IsHeader: true, Execers: []string{"coins"}}))
+	assert.Equal(t, types.ErrInvalidParam, checkSeqFilter(&types.BlockSeqCB{Execers: []string{""}}))
+	assert.NotNil(t, checkSeqFilter(&types.BlockSeqCB{Addrs: []string{"addr"}}))
+}
+
+func TestGetFilterSeqs(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	from, priv := util.Genaddress()
+	to, _ := util.Genaddress()
+	coinsTx := util.CreateCoinsTx(cfg, priv, to, 1)
+	noneTx := util.CreateNoneTx(cfg, priv)
+	block := &types.Block{Height: 1, Txs: []*types.Transaction{coinsTx, noneTx}}
+	detail := &types.BlockDetail{
+		Block: block,
+		Receipts: []*types.ReceiptData{
+			{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogTransfer}}},
+			{Ty: types.ExecOk},
+		},
+	}
+	header := &types.Header{Height: 1, Hash: []byte("hash1"), TxCount: 2}
+
+	store := new(bmocks.SequenceStore)
+	store.On("GetBlockSequence", int64(1)).Return(&types.BlockSequence{Hash: header.Hash, Type: types.AddBlock}, nil)
+	store.On("LoadBlockBySequence", int64(1)).Return(detail, 0, nil)
+	store.On("GetBlockHeaderByHash", header.Hash).Return(header, nil)
+
+	var proofs int
+	push := newpushseq(store, nil, func(txDetail *types.TransactionDetail, block *types.Block, index int32) {
+		txDetail.Proofs = [][]byte{block.Txs[index].Hash()}
+		proofs++
+	}, nil)
+
+	decode := func(cb *types.BlockSeqCB) *types.FilterSeq {
+		data, updateSeq, err := push.getSeqs(cb, 1, 1, pushMaxSize)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), updateSeq)
+		var seqs types.FilterSeqs
+		require.NoError(t, types.Decode(data, &seqs))
+		require.Equal(t, 1, len(seqs.Seqs))
+		assert.Equal(t, header.Hash, seqs.Seqs[0].Header.Hash)
+		return seqs.Seqs[0]
+	}
+
+	//按照执行器过滤
+	seq := decode(&types.BlockSeqCB{Name: "test", Execers: []string{"none"}})
+	require.Equal(t, 1, len(seq.Txs))
+	assert.Equal(t, int64(1), seq.Txs[0].Index)
+	assert.Equal(t, [][]byte{noneTx.Hash()}, seq.Txs[0].Proofs)
+
+	//按照接收地址以及日志类型过滤
+	seq = decode(&types.BlockSeqCB{Name: "test", Addrs: []string{to}, LogTypes: []int32{types.TyLogTransfer}})
+	require.Equal(t, 1, len(seq.Txs))
+	assert.Equal(t, int64(0), seq.Txs[0].Index)
+	assert.Equal(t, from, seq.Txs[0].Fromaddr)
+	assert.Equal(t, int32(types.TyLogTransfer), seq.Txs[0].Receipt.Logs[0].Ty)
+
+	//发送地址匹配两笔交易，不同条件之间是与的关系
+	seq = decode(&types.BlockSeqCB{Name: "test", Addrs: []string{from}})
+	assert.Equal(t, 2, len(seq.Txs))
+	seq = decode(&types.BlockSeqCB{Name: "test", Execers: []string{"none"}, LogTypes: []int32{types.TyLogTransfer}})
+	assert.Equal(t, 0, len(seq.Txs))
+
+	//没有符合条件的交易时只推送header
+	seq = decode(&types.BlockSeqCB{Name: "test", Execers: []string{"ticket"}})
+	assert.Equal(t, 0, len(seq.Txs))
+	assert.Equal(t, int64(1), seq.Num)
+	assert.Equal(t, 4, proofs)
+}
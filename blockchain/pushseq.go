@@ -1,10 +1,6 @@
 package blockchain
 
 import (
-	"bytes"
-	"compress/gzip"
-	"io/ioutil"
-	"net/http"
 	"sync"
 	"time"
 
@@ -27,13 +23,19 @@ type pushseq struct {
 	store        SequenceStore
 	cmds         map[string]pushNotify
 	mu           sync.Mutex
-	client       *http.Client
 	pushseqStore *PushSeqStore1
+	//按照过滤条件推送交易时，用于填充交易的merkle证明
+	setProofs txProofsSetter
+	//创建推送传输方式时使用，如文件推送的目录
+	cfg *types.BlockChain
 }
 
-func newpushseq(store SequenceStore, pushseqStore *PushSeqStore1) *pushseq {
+//txProofsSetter 设置区块中指定index交易的merkle证明
+type txProofsSetter func(txDetail *types.TransactionDetail, block *types.Block, index int32)
+
+func newpushseq(store SequenceStore, pushseqStore *PushSeqStore1, setProofs txProofsSetter, cfg *types.BlockChain) *pushseq {
 	cmds := make(map[string]pushNotify)
-	return &pushseq{store: store, cmds: cmds, client: &http.Client{}, pushseqStore: pushseqStore}
+	return &pushseq{store: store, cmds: cmds, pushseqStore: pushseqStore, setProofs: setProofs, cfg: cfg}
 }
 
 //初始化: 从数据库读出seq的数目
@@ -117,18 +119,28 @@ func (p *pushseq) runTask(input pushNotify) {
 		var lastseq int64 = -1
 		var maxseq int64 = -1
 		var cb *types.BlockSeqCB
+		var transport PushTransport
 		var run = make(chan struct{}, 10)
 		for {
 			select {
 			case cb = <-in.cb:
+				if transport != nil {
+					transport.Close()
+					transport = nil
+				}
 				if cb.URL == "" {
 					return
 				}
+				var err error
+				transport, err = newPushTransport(cb, p.cfg)
+				if err != nil {
+					chainlog.Error("newPushTransport", "err", err, "cbName", cb.Name, "url", cb.URL)
+				}
 				p.trigeRun(run, 0)
 			case maxseq = <-in.seq:
 				p.trigeRun(run, 0)
 			case <-run:
-				if cb == nil {
+				if cb == nil || transport == nil {
 					p.trigeRun(run, time.Second)
 					continue
 				}
@@ -149,7 +161,7 @@ func (p *pushseq) runTask(input pushNotify) {
 					p.trigeRun(run, 1000*time.Millisecond)
 					continue
 				}
-				err = p.postData(cb, data, updateSeq)
+				err = p.postData(transport, cb, data, updateSeq)
 				if err != nil {
 					chainlog.Error("postdata", "err", err, "lastseq", lastseq, "cbName", cb.Name)
 					//sleep 60s
@@ -164,38 +176,13 @@ func (p *pushseq) runTask(input pushNotify) {
 	}(input)
 }
 
+//postData 通过传输方式推送数据，对端确认之后才更新推送的seq
 //seq= data.Seqs[0].Num+int64(len(data.Seqs))-1
-func (p *pushseq) postData(cb *types.BlockSeqCB, postdata []byte, seq int64) (err error) {
-	//post data in body
-	var buf bytes.Buffer
-	g := gzip.NewWriter(&buf)
-	if _, err = g.Write(postdata); err != nil {
-		return err
-	}
-	if err = g.Close(); err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest("POST", cb.URL, &buf)
+func (p *pushseq) postData(transport PushTransport, cb *types.BlockSeqCB, postdata []byte, seq int64) error {
+	err := transport.Push(cb, postdata, seq)
 	if err != nil {
 		return err
 	}
-
-	req.Header.Set("Content-Type", "text/plain")
-	req.Header.Set("Content-Encoding", "gzip")
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-	if string(body) != "ok" && string(body) != "OK" {
-		chainlog.Error("postData fail", "cb.name", cb.Name, "body", string(body))
-		return types.ErrPushSeqPostData
-	}
 	chainlog.Debug("postData success", "cb.name", cb.Name, "updateSeq", seq)
 	return p.pushseqStore.SetLastPushSeq([]byte(cb.Name), seq)
 }
@@ -209,9 +196,29 @@ func (p *pushseq) getBlockDataBySeq(seq int64) (*types.BlockSeq, int, error) {
 	if err != nil {
 		return nil, 0, err
 	}
-	return &types.BlockSeq{Num: seq, Seq: seqdata, Detail: detail}, blockSize, nil
+	reorg, err := p.getReorgEvent(seq, seqdata)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &types.BlockSeq{Num: seq, Seq: seqdata, Detail: detail, Reorg: reorg}, blockSize, nil
+}
+
+//getReorgEvent 链重组时第一个回滚的区块附带重组信息，订阅方可以一次完成回滚
+func (p *pushseq) getReorgEvent(seq int64, seqdata *types.BlockSequence) (*types.ReorgEvent, error) {
+	if seqdata.Type != types.DelBlock {
+		return nil, nil
+	}
+	reorg, err := p.store.GetReorgEventBySequence(seq)
+	if err == types.ErrNotFound {
+		return nil, nil
+	}
+	return reorg, err
 }
+
 func (p *pushseq) getSeqs(cb *types.BlockSeqCB, seq int64, seqCount, maxSize int) ([]byte, int64, error) {
+	if hasSeqFilter(cb) {
+		return p.getFilterSeqs(cb, seq, seqCount, maxSize)
+	}
 	if cb.IsHeader {
 		return p.getHeaderSeqs(cb.Encode, seq, seqCount, maxSize)
 	}
@@ -288,5 +295,9 @@ func (p *pushseq) getHeaderDataBySeq(seq int64) (*types.HeaderSeq, int, error) {
 	if err != nil {
 		return nil, 0, err
 	}
-	return &types.HeaderSeq{Num: seq, Seq: seqdata, Header: header}, header.Size(), nil
+	reorg, err := p.getReorgEvent(seq, seqdata)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &types.HeaderSeq{Num: seq, Seq: seqdata, Header: header, Reorg: reorg}, header.Size(), nil
 }
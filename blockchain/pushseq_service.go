@@ -5,6 +5,8 @@
 package blockchain
 
 import (
+	"time"
+
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
@@ -31,9 +33,40 @@ func (chain *BlockChain) ProcAddBlockSeqCB(cb *types.BlockSeqCB) ([]*types.Seque
 		chainlog.Error("ProcAddBlockSeqCB not support sequence")
 		return nil, types.ErrRecordBlockSequence
 	}
+	if cb.URL != "" {
+		if err := checkPushURL(cb.URL, chain.cfg); err != nil {
+			chainlog.Error("ProcAddBlockSeqCB", "url", cb.URL, "err", err)
+			return nil, err
+		}
+	}
 	return chain.pushservice.AddCallback(chain.pushseq, cb)
 }
 
+//grpc订阅时等待推送数据的时间，超时之后由rpc模块重新获取
+const pushSeqFetchTimeout = 2 * time.Second
+
+//ProcGetPushSeqData 获取grpc方式推送的callback需要推送的数据
+func (chain *BlockChain) ProcGetPushSeqData(name string) (*types.PushSeqData, error) {
+	transport := getGrpcTransport(name)
+	if transport == nil {
+		return nil, types.ErrPushNotSubscribed
+	}
+	return transport.fetch(pushSeqFetchTimeout)
+}
+
+//ProcAckPushSeq 确认grpc方式推送的数据已经处理完成
+func (chain *BlockChain) ProcAckPushSeq(ack *types.PushSeqAck) error {
+	if ack == nil {
+		return types.ErrInvalidParam
+	}
+	transport := getGrpcTransport(ack.Name)
+	if transport == nil {
+		return types.ErrPushNotSubscribed
+	}
+	transport.confirm(ack.Seq)
+	return nil
+}
+
 // 推送服务
 // 1. 需要一个store， 读取seq 相关信息: 包括 seq -> block/height/hash
 // 1. 需要一个store， 读写推送相关信息： 包含 注册信息和推送进度
@@ -54,6 +87,8 @@ type SequenceStore interface {
 	LastHeader() *types.Header
 	// hash -> seq
 	GetSequenceByHash(hash []byte) (int64, error)
+	// seq -> reorg event
+	GetReorgEventBySequence(seq int64) (*types.ReorgEvent, error)
 }
 
 // PushWorkNotify 两类notify
@@ -111,6 +146,11 @@ func (push *PushService1) AddCallback(pushseq PushWorkNotify, cb *types.BlockSeq
 		return nil, types.ErrInvalidParam
 	}
 
+	if err := checkSeqFilter(cb); err != nil {
+		chainlog.Error("AddCallback checkSeqFilter", "name", cb.Name, "err", err)
+		return nil, err
+	}
+
 	if cb.LastBlockHash != "" || cb.LastSequence != 0 || cb.LastHeight != 0 {
 		if cb.LastBlockHash == "" || cb.LastSequence == 0 || cb.LastHeight == 0 {
 			chainlog.Error("AddCallback ErrInvalidParam", "seq", cb.LastSequence, "height", cb.LastHeight, "hash", cb.LastBlockHash)
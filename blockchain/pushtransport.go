@@ -0,0 +1,451 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	//等待对端确认的超时时间
+	pushAckTimeout = 30 * time.Second
+	//grpc推送的数据被取走之后没有确认时，重新投递的间隔
+	pushRedeliverInterval = 3 * time.Second
+	//文件推送默认的单个文件大小，单位MB
+	pushFileMaxSize = 100
+)
+
+//PushTransport seq推送的传输方式，Push返回nil表示对端已经确认收到数据，之后才会更新推送进度
+type PushTransport interface {
+	Push(cb *types.BlockSeqCB, postdata []byte, seq int64) error
+	Close()
+}
+
+//PushTransportCreator 通过callback配置以及本节点的blockchain配置创建推送传输方式
+type PushTransportCreator func(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error)
+
+var pushTransports = make(map[string]PushTransportCreator)
+
+//RegisterPushTransport 通过URL的scheme注册推送传输方式
+func RegisterPushTransport(scheme string, create PushTransportCreator) {
+	if create == nil {
+		panic("RegisterPushTransport: create is nil")
+	}
+	if _, ok := pushTransports[scheme]; ok {
+		panic("RegisterPushTransport: duplicate scheme " + scheme)
+	}
+	pushTransports[scheme] = create
+}
+
+func init() {
+	RegisterPushTransport("http", newHTTPTransport)
+	RegisterPushTransport("https", newHTTPTransport)
+	RegisterPushTransport("ws", newWSTransport)
+	RegisterPushTransport("wss", newWSTransport)
+	RegisterPushTransport("file", newFileTransport)
+	RegisterPushTransport("grpc", newGrpcTransport)
+}
+
+//getPushTransportCreator 根据URL的scheme获取注册的传输方式，没有注册的scheme返回错误
+func getPushTransportCreator(rawurl string) (PushTransportCreator, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := pushTransports[u.Scheme]
+	if !ok {
+		return nil, types.ErrPushSeqScheme
+	}
+	return create, nil
+}
+
+//checkPushURL 添加callback时检查URL，文件推送还需要检查路径是否在配置的目录中
+func checkPushURL(rawurl string, cfg *types.BlockChain) error {
+	_, err := getPushTransportCreator(rawurl)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(rawurl, "file:") {
+		_, _, err = parseFileURL(rawurl, cfg)
+	}
+	return err
+}
+
+//newPushTransport 根据URL的scheme选择传输方式
+func newPushTransport(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error) {
+	create, err := getPushTransportCreator(cb.URL)
+	if err != nil {
+		return nil, err
+	}
+	return create(cb, cfg)
+}
+
+//httpTransport 通过http post推送gzip压缩的数据，对端返回ok表示确认
+type httpTransport struct {
+	client *http.Client
+}
+
+func newHTTPTransport(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error) {
+	return &httpTransport{client: &http.Client{}}, nil
+}
+
+func (t *httpTransport) Push(cb *types.BlockSeqCB, postdata []byte, seq int64) (err error) {
+	//post data in body
+	var buf bytes.Buffer
+	g := gzip.NewWriter(&buf)
+	if _, err = g.Write(postdata); err != nil {
+		return err
+	}
+	if err = g.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", cb.URL, &buf)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if string(body) != "ok" && string(body) != "OK" {
+		chainlog.Error("postData fail", "cb.name", cb.Name, "body", string(body))
+		return types.ErrPushSeqPostData
+	}
+	return nil
+}
+
+func (t *httpTransport) Close() {}
+
+//wsTransport 通过websocket连接推送二进制消息，对端回复ok表示确认，出错之后重新建立连接
+type wsTransport struct {
+	conn *websocket.Conn
+}
+
+func newWSTransport(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error) {
+	return &wsTransport{}, nil
+}
+
+func (t *wsTransport) Push(cb *types.BlockSeqCB, postdata []byte, seq int64) error {
+	if t.conn == nil {
+		conn, err := websocket.Dial(cb.URL, "", "http://localhost/")
+		if err != nil {
+			return err
+		}
+		t.conn = conn
+	}
+	err := t.push(postdata)
+	if err != nil {
+		chainlog.Error("wsTransport push", "cb.name", cb.Name, "seq", seq, "err", err)
+		t.Close()
+	}
+	return err
+}
+
+func (t *wsTransport) push(postdata []byte) error {
+	err := t.conn.SetDeadline(time.Now().Add(pushAckTimeout))
+	if err != nil {
+		return err
+	}
+	err = websocket.Message.Send(t.conn, postdata)
+	if err != nil {
+		return err
+	}
+	var reply string
+	err = websocket.Message.Receive(t.conn, &reply)
+	if err != nil {
+		return err
+	}
+	if reply != "ok" && reply != "OK" {
+		return types.ErrPushSeqPostData
+	}
+	return nil
+}
+
+func (t *wsTransport) Close() {
+	if t.conn != nil {
+		t.conn.Close()
+		t.conn = nil
+	}
+}
+
+//fileTransport 将推送的数据写入本地文件，写入并落盘之后即确认
+//json格式每条数据一行，其他格式每条数据前面加上4字节的长度
+//文件超过maxsize(MB)之后以最后一个seq为后缀重命名，再写入新的文件
+//URL中只能使用相对于配置项pushFileDir的路径，如file:push/push.dat?maxsize=100，没有配置pushFileDir时不能使用文件推送
+type fileTransport struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+	lastSeq int64
+}
+
+func newFileTransport(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error) {
+	path, maxSize, err := parseFileURL(cb.URL, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &fileTransport{path: path, maxSize: maxSize * 1024 * 1024, lastSeq: -1}, nil
+}
+
+//parseFileURL 解析文件推送的URL，返回pushFileDir目录中的文件路径以及单个文件的大小
+func parseFileURL(rawurl string, cfg *types.BlockChain) (string, int64, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", 0, err
+	}
+	if cfg == nil || cfg.PushFileDir == "" {
+		return "", 0, types.ErrPushSeqFilePath
+	}
+	path, err := pushFilePath(cfg.PushFileDir, u)
+	if err != nil {
+		return "", 0, err
+	}
+	maxSize := int64(pushFileMaxSize)
+	if v := u.Query().Get("maxsize"); v != "" {
+		maxSize, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || maxSize <= 0 {
+			return "", 0, types.ErrInvalidParam
+		}
+	}
+	return path, maxSize, nil
+}
+
+//pushFilePath URL中的路径必须是不包含..的相对路径，如file:push.dat，file://host/push.dat和file:///data/push.dat都会被拒绝
+func pushFilePath(dir string, u *url.URL) (string, error) {
+	if u.Host != "" || u.Opaque == "" {
+		return "", types.ErrPushSeqFilePath
+	}
+	rel, err := url.PathUnescape(u.Opaque)
+	if err != nil {
+		return "", types.ErrPushSeqFilePath
+	}
+	rel = filepath.FromSlash(rel)
+	if filepath.IsAbs(rel) || filepath.VolumeName(rel) != "" {
+		return "", types.ErrPushSeqFilePath
+	}
+	for _, elem := range strings.FieldsFunc(rel, func(r rune) bool { return r == '/' || r == filepath.Separator }) {
+		if elem == ".." {
+			return "", types.ErrPushSeqFilePath
+		}
+	}
+	rel = filepath.Clean(rel)
+	if rel == "." {
+		return "", types.ErrPushSeqFilePath
+	}
+	return filepath.Join(dir, rel), nil
+}
+
+func (t *fileTransport) open() error {
+	err := os.MkdirAll(filepath.Dir(t.path), 0755)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(t.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	t.file = file
+	t.size = info.Size()
+	return nil
+}
+
+func (t *fileTransport) rotate() error {
+	t.Close()
+	err := os.Rename(t.path, fmt.Sprintf("%s.%d", t.path, t.lastSeq))
+	if err != nil {
+		return err
+	}
+	return t.open()
+}
+
+func (t *fileTransport) Push(cb *types.BlockSeqCB, postdata []byte, seq int64) error {
+	if t.file == nil {
+		if err := t.open(); err != nil {
+			return err
+		}
+	}
+	if t.size > 0 && t.size >= t.maxSize && t.lastSeq >= 0 {
+		if err := t.rotate(); err != nil {
+			return err
+		}
+	}
+	var record []byte
+	if cb.Encode == "json" {
+		record = append(append(record, postdata...), '\n')
+	} else {
+		record = make([]byte, 4, 4+len(postdata))
+		binary.BigEndian.PutUint32(record, uint32(len(postdata)))
+		record = append(record, postdata...)
+	}
+	n, err := t.file.Write(record)
+	t.size += int64(n)
+	if err == nil {
+		err = t.file.Sync()
+	}
+	if err != nil {
+		chainlog.Error("fileTransport push", "cb.name", cb.Name, "seq", seq, "err", err)
+		t.Close()
+		return err
+	}
+	t.lastSeq = seq
+	return nil
+}
+
+func (t *fileTransport) Close() {
+	if t.file != nil {
+		t.file.Close()
+		t.file = nil
+	}
+}
+
+//grpcTransport 通过rpc模块的grpc server-streaming推送数据
+//订阅方通过SubBlockSeq获取数据，处理完成之后调用AckBlockSeq确认
+//取走之后超过pushRedeliverInterval没有确认的数据会重新投递，订阅方断开重连之后不用等到确认超时
+type grpcTransport struct {
+	name    string
+	mu      sync.Mutex
+	pending *types.PushSeqData
+	sent    time.Time
+	notify  chan struct{}
+	ack     chan int64
+}
+
+var (
+	grpcStreams     = make(map[string]*grpcTransport)
+	grpcStreamsLock sync.Mutex
+)
+
+func newGrpcTransport(cb *types.BlockSeqCB, cfg *types.BlockChain) (PushTransport, error) {
+	t := &grpcTransport{
+		name:   cb.Name,
+		notify: make(chan struct{}, 1),
+		ack:    make(chan int64, 1),
+	}
+	grpcStreamsLock.Lock()
+	grpcStreams[cb.Name] = t
+	grpcStreamsLock.Unlock()
+	return t, nil
+}
+
+func getGrpcTransport(name string) *grpcTransport {
+	grpcStreamsLock.Lock()
+	defer grpcStreamsLock.Unlock()
+	return grpcStreams[name]
+}
+
+func (t *grpcTransport) Push(cb *types.BlockSeqCB, postdata []byte, seq int64) error {
+	//清除过期的确认，替换上一次没有确认的数据
+	select {
+	case <-t.ack:
+	default:
+	}
+	t.mu.Lock()
+	t.pending = &types.PushSeqData{Name: cb.Name, Seq: seq, Data: postdata}
+	t.sent = time.Time{}
+	t.mu.Unlock()
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+	timer := time.NewTimer(pushAckTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case ack := <-t.ack:
+			if ack == seq {
+				return nil
+			}
+		case <-timer.C:
+			return types.ErrPushSeqAckTimeout
+		}
+	}
+}
+
+//fetch 等待需要推送的数据，超时返回ErrNotFound
+//没有投递过的数据立即返回，已经投递过但是没有确认的数据超过pushRedeliverInterval之后重新返回
+func (t *grpcTransport) fetch(timeout time.Duration) (*types.PushSeqData, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		now := time.Now()
+		wait := deadline.Sub(now)
+		t.mu.Lock()
+		if t.pending != nil {
+			redeliver := t.sent.Add(pushRedeliverInterval)
+			if t.sent.IsZero() || !now.Before(redeliver) {
+				t.sent = now
+				data := t.pending
+				t.mu.Unlock()
+				return data, nil
+			}
+			if redeliver.Sub(now) < wait {
+				wait = redeliver.Sub(now)
+			}
+		}
+		t.mu.Unlock()
+		if wait <= 0 {
+			return nil, types.ErrNotFound
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-t.notify:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (t *grpcTransport) confirm(seq int64) {
+	t.mu.Lock()
+	if t.pending != nil && t.pending.Seq == seq {
+		t.pending = nil
+	}
+	t.mu.Unlock()
+	select {
+	case <-t.ack:
+	default:
+	}
+	select {
+	case t.ack <- seq:
+	default:
+	}
+}
+
+func (t *grpcTransport) Close() {
+	grpcStreamsLock.Lock()
+	if grpcStreams[t.name] == t {
+		delete(grpcStreams, t.name)
+	}
+	grpcStreamsLock.Unlock()
+}
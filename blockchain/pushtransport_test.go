@@ -0,0 +1,206 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestNewPushTransport(t *testing.T) {
+	cfg := &types.BlockChain{PushFileDir: "/tmp/push"}
+	cases := map[string]interface{}{
+		"http://127.0.0.1:8801":  &httpTransport{},
+		"https://127.0.0.1:8801": &httpTransport{},
+		"ws://127.0.0.1:8801":    &wsTransport{},
+		"file:push.dat":          &fileTransport{},
+		"grpc://":                &grpcTransport{},
+	}
+	for url, expect := range cases {
+		transport, err := newPushTransport(&types.BlockSeqCB{Name: "test", URL: url}, cfg)
+		require.NoError(t, err)
+		assert.IsType(t, expect, transport, url)
+		transport.Close()
+	}
+	_, err := newPushTransport(&types.BlockSeqCB{URL: "file:push.dat?maxsize=0"}, cfg)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	//没有注册的scheme不再默认使用http推送
+	_, err = newPushTransport(&types.BlockSeqCB{URL: "url1"}, cfg)
+	assert.Equal(t, types.ErrPushSeqScheme, err)
+	_, err = newPushTransport(&types.BlockSeqCB{URL: "tcp://127.0.0.1:8801"}, cfg)
+	assert.Equal(t, types.ErrPushSeqScheme, err)
+	assert.Panics(t, func() { RegisterPushTransport("http", newHTTPTransport) })
+}
+
+func TestPushFilePath(t *testing.T) {
+	cfg := &types.BlockChain{PushFileDir: "/tmp/push"}
+	path, _, err := parseFileURL("file:sub/push.dat?maxsize=1", cfg)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/tmp/push", "sub", "push.dat"), path)
+	path, _, err = parseFileURL("file:./sub//push.dat", cfg)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/tmp/push", "sub", "push.dat"), path)
+
+	//只能写入配置目录中的文件
+	bad := []string{
+		"file:///etc/push.dat",
+		"file:/etc/push.dat",
+		"file://host/push.dat",
+		"file:../push.dat",
+		"file:sub/../../push.dat",
+		"file:%2e%2e/push.dat",
+		"file:.",
+		"file:",
+	}
+	for _, rawurl := range bad {
+		_, _, err = parseFileURL(rawurl, cfg)
+		assert.Equal(t, types.ErrPushSeqFilePath, err, rawurl)
+		assert.Equal(t, types.ErrPushSeqFilePath, checkPushURL(rawurl, cfg), rawurl)
+	}
+	//没有配置目录时不能使用文件推送
+	_, err = newPushTransport(&types.BlockSeqCB{URL: "file:push.dat"}, &types.BlockChain{})
+	assert.Equal(t, types.ErrPushSeqFilePath, err)
+	_, err = newPushTransport(&types.BlockSeqCB{URL: "file:push.dat"}, nil)
+	assert.Equal(t, types.ErrPushSeqFilePath, err)
+	assert.NoError(t, checkPushURL("http://127.0.0.1:8801", nil))
+	assert.Equal(t, types.ErrPushSeqScheme, checkPushURL("tcp://127.0.0.1:8801", cfg))
+}
+
+func TestHTTPTransport(t *testing.T) {
+	reply := "ok"
+	var received []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		received, err = ioutil.ReadAll(g)
+		require.NoError(t, err)
+		w.Write([]byte(reply))
+	}))
+	defer ts.Close()
+
+	cb := &types.BlockSeqCB{Name: "test", URL: ts.URL}
+	transport, err := newPushTransport(cb, nil)
+	require.NoError(t, err)
+	require.NoError(t, transport.Push(cb, []byte("data"), 1))
+	assert.Equal(t, []byte("data"), received)
+
+	reply = "fail"
+	assert.Equal(t, types.ErrPushSeqPostData, transport.Push(cb, []byte("data"), 2))
+}
+
+func TestWSTransport(t *testing.T) {
+	var received [][]byte
+	ts := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				return
+			}
+			received = append(received, data)
+			reply := "ok"
+			if string(data) == "bad" {
+				reply = "fail"
+			}
+			if err := websocket.Message.Send(ws, reply); err != nil {
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	cb := &types.BlockSeqCB{Name: "test", URL: strings.Replace(ts.URL, "http://", "ws://", 1)}
+	transport, err := newPushTransport(cb, nil)
+	require.NoError(t, err)
+	defer transport.Close()
+	require.NoError(t, transport.Push(cb, []byte("data1"), 1))
+	require.NoError(t, transport.Push(cb, []byte("data2"), 2))
+	assert.Equal(t, types.ErrPushSeqPostData, transport.Push(cb, []byte("bad"), 3))
+	//出错之后重新建立连接
+	require.NoError(t, transport.Push(cb, []byte("data3"), 3))
+	assert.Equal(t, 4, len(received))
+}
+
+func TestFileTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pushfile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cfg := &types.BlockChain{PushFileDir: dir}
+	path := filepath.Join(dir, "push.dat")
+
+	cb := &types.BlockSeqCB{Name: "test", URL: "file:push.dat?maxsize=1", Encode: "json"}
+	transport, err := newPushTransport(cb, cfg)
+	require.NoError(t, err)
+	require.NoError(t, transport.Push(cb, []byte("{}"), 1))
+	require.NoError(t, transport.Push(cb, bytes.Repeat([]byte("a"), 1024*1024), 2))
+	//超过文件大小之后重命名
+	require.NoError(t, transport.Push(cb, []byte("{}"), 3))
+	transport.Close()
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("{}\n"), data)
+	_, err = os.Stat(path + ".2")
+	assert.NoError(t, err)
+
+	cb = &types.BlockSeqCB{Name: "test", URL: "file:push.dat.bin"}
+	transport, err = newPushTransport(cb, cfg)
+	require.NoError(t, err)
+	require.NoError(t, transport.Push(cb, []byte("data"), 1))
+	transport.Close()
+	data, err = ioutil.ReadFile(path + ".bin")
+	require.NoError(t, err)
+	require.Equal(t, 8, len(data))
+	assert.Equal(t, uint32(4), binary.BigEndian.Uint32(data))
+	assert.Equal(t, []byte("data"), data[4:])
+}
+
+func TestGrpcTransport(t *testing.T) {
+	chain := &BlockChain{}
+	_, err := chain.ProcGetPushSeqData("grpc-test")
+	assert.Equal(t, types.ErrPushNotSubscribed, err)
+
+	cb := &types.BlockSeqCB{Name: "grpc-test", URL: "grpc://"}
+	transport, err := newPushTransport(cb, nil)
+	require.NoError(t, err)
+	defer transport.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- transport.Push(cb, []byte("data"), 5)
+	}()
+	data, err := chain.ProcGetPushSeqData(cb.Name)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), data.Seq)
+	assert.Equal(t, []byte("data"), data.Data)
+
+	//没有确认的数据不会马上重复投递，超过重新投递的间隔之后再次投递
+	_, err = transport.(*grpcTransport).fetch(10 * time.Millisecond)
+	assert.Equal(t, types.ErrNotFound, err)
+	transport.(*grpcTransport).sent = time.Now().Add(-pushRedeliverInterval)
+	data, err = chain.ProcGetPushSeqData(cb.Name)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), data.Seq)
+
+	//确认的seq不一致时继续等待
+	require.NoError(t, chain.ProcAckPushSeq(&types.PushSeqAck{Name: cb.Name, Seq: 4}))
+	require.NoError(t, chain.ProcAckPushSeq(&types.PushSeqAck{Name: cb.Name, Seq: 5}))
+	assert.NoError(t, <-done)
+
+	transport.Close()
+	assert.Equal(t, types.ErrPushNotSubscribed, chain.ProcAckPushSeq(&types.PushSeqAck{Name: cb.Name, Seq: 5}))
+}
@@ -251,6 +251,10 @@ func (chain *BlockChain) ProcAddBlockMsg(broadcast bool, blockdetail *types.Bloc
 		chainlog.Error("ProcAddBlockMsg input block is null")
 		return nil, types.ErrInvalidParam
 	}
+	//轻节点只执行创世区块，其他区块只同步区块头
+	if chain.cfg.EnableLightNode && block.Height > 0 {
+		return nil, types.ErrLightNode
+	}
 	b, ismain, isorphan, err := chain.ProcessBlock(broadcast, blockdetail, pid, true, -1)
 	if b != nil {
 		blockdetail = b
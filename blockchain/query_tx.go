@@ -171,23 +171,24 @@ func (chain *BlockChain) ProcQueryTxMsg(txhash []byte) (proof *types.Transaction
 	}
 
 	var txDetail types.TransactionDetail
+	chain.setTxDetailProofs(&txDetail, block.Block, txresult.Index)
+	setTxDetailFromTxResult(&txDetail, txresult)
+	return &txDetail, nil
+}
+
+//setTxDetailProofs 获取指定tx在txlist中的proof,需要区分ForkRootHash前后proof证明数据
+func (chain *BlockChain) setTxDetailProofs(txDetail *types.TransactionDetail, block *types.Block, index int32) {
 	cfg := chain.client.GetConfig()
-	height := block.Block.GetHeight()
+	height := block.GetHeight()
 	if chain.isParaChain {
-		height = block.Block.GetMainHeight()
+		height = block.GetMainHeight()
 	}
-	//获取指定tx在txlist中的proof,需要区分ForkRootHash前后proof证明数据
 	if !cfg.IsFork(height, "ForkRootHash") {
-		proofs := getTxHashProofs(block.Block.Txs, txresult.Index)
-		txDetail.Proofs = proofs
+		txDetail.Proofs = getTxHashProofs(block.Txs, index)
 	} else {
-		txproofs := chain.getMultiLayerProofs(txresult.Height, block.Block.Hash(cfg), block.Block.Txs, txresult.Index)
-		txDetail.TxProofs = txproofs
-		txDetail.FullHash = block.Block.Txs[txresult.Index].FullHash()
+		txDetail.TxProofs = chain.getMultiLayerProofs(block.GetHeight(), block.Hash(cfg), block.Txs, index)
+		txDetail.FullHash = block.Txs[index].FullHash()
 	}
-
-	setTxDetailFromTxResult(&txDetail, txresult)
-	return &txDetail, nil
 }
 
 func setTxDetailFromTxResult(TransactionDetail *types.TransactionDetail, txresult *types.TxResult) {
@@ -5,9 +5,11 @@
 package blockchain
 
 import (
+	"runtime"
 	"strconv"
 	"strings"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/types"
 )
@@ -40,7 +42,7 @@ func (chain *BlockChain) UpgradeChain() {
 				chain.blockStore.delAllKeys()
 				chainlog.Info("end del all keys")
 			}
-			chain.reIndex(start, curheight)
+			chain.reIndex(meta, start, curheight)
 		} else if v1 == 2 {
 			//如果节点开启isRecordBlockSequence功能，需要按照seq来升级区块信息
 			var isSeq bool
@@ -51,7 +53,7 @@ func (chain *BlockChain) UpgradeChain() {
 				curheight = lastSequence
 				isSeq = true
 			}
-			chain.reIndexForTable(start, curheight, isSeq)
+			chain.reIndexForTable(meta, start, curheight, isSeq)
 		}
 		meta := &types.UpgradeMeta{
 			Starting: false,
@@ -65,13 +67,10 @@ func (chain *BlockChain) UpgradeChain() {
 	}
 }
 
-func (chain *BlockChain) reIndex(start, end int64) {
-	for i := start; i <= end; i++ {
-		err := chain.reIndexOne(i)
-		if err != nil {
-			panic(err)
-		}
-	}
+//reIndex 执行器的ExecLocal依赖之前高度写入的localdb，只能单个worker逐个高度提交
+func (chain *BlockChain) reIndex(meta *types.UpgradeMeta, start, end int64) {
+	r := newReindexer(chain, meta, start, end, 1, 1, chain.reIndexOne)
+	r.run()
 }
 
 func (chain *BlockChain) needReIndex(meta *types.UpgradeMeta) bool {
@@ -100,8 +99,7 @@ func (chain *BlockChain) needReIndex(meta *types.UpgradeMeta) bool {
 	return false
 }
 
-func (chain *BlockChain) reIndexOne(height int64) error {
-	newbatch := chain.blockStore.NewBatch(false)
+func (chain *BlockChain) reIndexOne(newbatch dbm.Batch, height int64) error {
 	blockdetail, err := chain.GetBlock(height)
 	if err != nil {
 		chainlog.Error("reindexone.GetBlock", "err", err)
@@ -116,30 +114,52 @@ func (chain *BlockChain) reIndexOne(height int64) error {
 		chainlog.Error("reIndexOne indexTxs:", "height", blockdetail.Block.Height, "err", err)
 		panic(err)
 	}
-	meta := &types.UpgradeMeta{
-		Starting: true,
-		Version:  version.GetLocalDBVersion(),
-		Height:   height + 1,
-	}
-	newbatch.Set(version.LocalDBMeta, types.Encode(meta))
-	return newbatch.Write()
+	return nil
 }
 
-func (chain *BlockChain) reIndexForTable(start, end int64, isSeq bool) {
-	for i := start; i <= end; i++ {
-		err := chain.reIndexForTableOne(i, end, isSeq)
-		if err != nil {
-			panic(err)
+//reIndexForTable 按照高度升级时每个高度的区块相互独立，可以分片并行处理
+//按照seq升级时需要保证回滚和添加区块的顺序，只能单个worker处理
+func (chain *BlockChain) reIndexForTable(meta *types.UpgradeMeta, start, end int64, isSeq bool) {
+	workers := int(chain.cfg.ReindexWorkers)
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if isSeq {
+		workers = 1
+	}
+	//按照seq升级时只有一个worker，fn和commit在同一个goroutine中调用
+	var lastHeight int64 = -1
+	r := newReindexer(chain, meta, start, end, workers, reindexBatchCount, func(newbatch dbm.Batch, index int64) error {
+		height, err := chain.reIndexForTableOne(newbatch, index, end, isSeq)
+		if err == nil && height > lastHeight {
+			lastHeight = height
+		}
+		return err
+	})
+	//并行的分片不能各自写入精简localdb的高度，提交时按照所有分片都已经完成的高度写入
+	if chain.client.GetConfig().IsEnable("reduceLocaldb") {
+		safeHeight := chain.GetBlockHeight() - SafetyReduceHeight - 1
+		r.onCommit = func(newbatch dbm.Batch, low int64) {
+			height := low - 1
+			if isSeq {
+				height = lastHeight
+			}
+			if height > safeHeight {
+				height = safeHeight
+			}
+			if height >= 0 {
+				newbatch.Set(types.ReduceLocaldbHeight, types.Encode(&types.Int64{Data: height}))
+			}
 		}
 	}
+	r.run()
 	chainlog.Info("reindex:reIndexForTable:complete")
 }
 
 //使用table的方式保存block的header和body以及paratx信息，
 //然后删除对应的bodyPrefix, headerPrefix, heightToHeaderPrefix,key值
-//需要区分是按照seq还是height来升级block
-func (chain *BlockChain) reIndexForTableOne(index int64, lastindex int64, isSeq bool) error {
-	newbatch := chain.blockStore.NewBatch(false)
+//需要区分是按照seq还是height来升级block，返回添加的区块高度，删除区块时返回-1
+func (chain *BlockChain) reIndexForTableOne(newbatch dbm.Batch, index int64, lastindex int64, isSeq bool) (int64, error) {
 	var blockdetail *types.BlockDetail
 	var err error
 	blockOptType := types.AddBlock
@@ -180,32 +200,23 @@ func (chain *BlockChain) reIndexForTableOne(index int64, lastindex int64, isSeq
 		newbatch.Delete(calcHashToBlockBodyKey(hash))
 		newbatch.Delete(calcHeightToBlockHeaderKey(height))
 
-		// 精简localdb
+		// 精简localdb，精简的高度由reindexer提交时统一写入
 		if chain.client.GetConfig().IsEnable("reduceLocaldb") && curHeight-SafetyReduceHeight > height {
 			chain.reduceIndexTx(newbatch, blockdetail.GetBlock())
-			newbatch.Set(types.ReduceLocaldbHeight, types.Encode(&types.Int64{Data: height}))
-		}
-	} else {
-		parakvs, _ := delParaTxTable(chain.blockStore.db, height)
-		for _, kv := range parakvs {
-			if len(kv.GetKey()) != 0 && kv.GetValue() == nil {
-				newbatch.Delete(kv.GetKey())
-			}
 		}
-		// 精简localdb，为了提升效率，所有索引tx均生成，而不从数据库中读取，因此需要删除侧链生成的tx
-		if chain.client.GetConfig().IsEnable("reduceLocaldb") && curHeight-SafetyReduceHeight > height {
-			chain.deleteTx(newbatch, blockdetail.GetBlock())
+		return height, nil
+	}
+	parakvs, _ := delParaTxTable(chain.blockStore.db, height)
+	for _, kv := range parakvs {
+		if len(kv.GetKey()) != 0 && kv.GetValue() == nil {
+			newbatch.Delete(kv.GetKey())
 		}
 	}
-
-	meta := &types.UpgradeMeta{
-		Starting: true,
-		Version:  version.GetLocalDBVersion(),
-		Height:   index + 1,
+	// 精简localdb，为了提升效率，所有索引tx均生成，而不从数据库中读取，因此需要删除侧链生成的tx
+	if chain.client.GetConfig().IsEnable("reduceLocaldb") && curHeight-SafetyReduceHeight > height {
+		chain.deleteTx(newbatch, blockdetail.GetBlock())
 	}
-	newbatch.Set(version.LocalDBMeta, types.Encode(meta))
-
-	return newbatch.Write()
+	return -1, nil
 }
 
 //返回当前版本的V1,V2,V3的值
@@ -0,0 +1,244 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//每个worker处理多少个高度提交一次batch
+	reindexBatchCount = 100
+	//batch中的数据超过此大小时提前提交
+	reindexBatchSize = 32 * 1024 * 1024
+	//输出重建索引进度日志的间隔
+	reindexLogInterval = 10 * time.Second
+)
+
+//reindexFunc 将index对应区块的索引写入batch
+type reindexFunc func(newbatch dbm.Batch, index int64) error
+
+//reindexer 将[start, end]切分成多个分片由worker并行处理
+//每次提交batch时同时将所有分片的进度保存在UpgradeMeta中，中断之后从各个分片的进度继续处理
+type reindexer struct {
+	chain      *BlockChain
+	meta       *types.UpgradeMeta
+	batchCount int
+	fn         reindexFunc
+	//提交batch时在锁中调用，low以下的高度都已经处理完成，用于写入依赖全部分片进度的数据
+	onCommit func(newbatch dbm.Batch, low int64)
+
+	lock      sync.Mutex
+	total     int64
+	done      int64
+	startTime time.Time
+}
+
+func newReindexer(chain *BlockChain, meta *types.UpgradeMeta, start, end int64, workers, batchCount int, fn reindexFunc) *reindexer {
+	newMeta := &types.UpgradeMeta{
+		Starting: true,
+		Version:  version.GetLocalDBVersion(),
+		Height:   start,
+	}
+	if meta != nil && meta.Starting && len(meta.Shards) > 0 {
+		newMeta.Shards = meta.Shards
+	} else {
+		newMeta.Shards = splitReindexShards(start, end, workers)
+	}
+	//上次中断之后又有新的区块时，新增一个分片处理
+	last := newMeta.Shards[len(newMeta.Shards)-1]
+	if last.End < end {
+		newMeta.Shards = append(newMeta.Shards, &types.ReindexShard{Start: last.End + 1, End: end, Height: last.End + 1})
+	}
+	r := &reindexer{chain: chain, meta: newMeta, batchCount: batchCount, fn: fn}
+	for _, shard := range newMeta.Shards {
+		r.total += shard.End - shard.Start + 1
+		r.done += shard.Height - shard.Start
+	}
+	newMeta.Height = r.lowHeight()
+	return r
+}
+
+//splitReindexShards 将[start, end]平均切分成workers个分片
+func splitReindexShards(start, end int64, workers int) []*types.ReindexShard {
+	count := end - start + 1
+	if count <= 0 {
+		return []*types.ReindexShard{{Start: start, End: end, Height: start}}
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	if int64(workers) > count {
+		workers = int(count)
+	}
+	size := count / int64(workers)
+	var shards []*types.ReindexShard
+	for i := 0; i < workers; i++ {
+		shard := &types.ReindexShard{Start: start + int64(i)*size}
+		shard.End = shard.Start + size - 1
+		if i == workers-1 {
+			shard.End = end
+		}
+		shard.Height = shard.Start
+		shards = append(shards, shard)
+	}
+	return shards
+}
+
+//lowHeight 所有分片中最小的未处理高度，低于此高度的区块都已经处理完成
+func (r *reindexer) lowHeight() int64 {
+	var low int64 = -1
+	for _, shard := range r.meta.Shards {
+		if shard.Height > shard.End {
+			continue
+		}
+		if low == -1 || shard.Height < low {
+			low = shard.Height
+		}
+	}
+	if low == -1 {
+		return r.meta.Shards[len(r.meta.Shards)-1].End + 1
+	}
+	return low
+}
+
+func (r *reindexer) run() {
+	r.startTime = types.Now()
+	r.chain.reindexLock.Lock()
+	r.chain.reindex = r
+	r.chain.reindexLock.Unlock()
+	defer func() {
+		r.chain.reindexLock.Lock()
+		r.chain.reindex = nil
+		r.chain.reindexLock.Unlock()
+	}()
+
+	chainlog.Info("reindex start", "total", r.total, "done", r.done, "shards", len(r.meta.Shards))
+	quit := make(chan struct{})
+	go r.logProgress(quit)
+	var wg sync.WaitGroup
+	for _, shard := range r.meta.Shards {
+		if shard.Height > shard.End {
+			continue
+		}
+		wg.Add(1)
+		go func(shard *types.ReindexShard) {
+			defer wg.Done()
+			r.runShard(shard)
+		}(shard)
+	}
+	wg.Wait()
+	close(quit)
+	chainlog.Info("reindex complete", "total", r.total, "cost", types.Since(r.startTime))
+}
+
+//runShard 逐个高度处理分片，每batchCount个高度提交一次，出错时panic，重启之后继续处理
+func (r *reindexer) runShard(shard *types.ReindexShard) {
+	newbatch := r.chain.blockStore.NewBatch(false)
+	r.lock.Lock()
+	start, end := shard.Height, shard.End
+	r.lock.Unlock()
+	count := 0
+	for index := start; index <= end; index++ {
+		err := r.fn(newbatch, index)
+		if err != nil {
+			chainlog.Error("reindex", "index", index, "err", err)
+			panic(err)
+		}
+		count++
+		if count >= r.batchCount || newbatch.ValueSize() > reindexBatchSize || index == end {
+			r.commit(newbatch, shard, index+1, count)
+			newbatch.Reset()
+			count = 0
+		}
+	}
+}
+
+//commit 提交batch时同时更新分片的进度，保证进度与已经写入的数据一致
+func (r *reindexer) commit(newbatch dbm.Batch, shard *types.ReindexShard, next int64, count int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	shard.Height = next
+	r.meta.Height = r.lowHeight()
+	if r.onCommit != nil {
+		r.onCommit(newbatch, r.meta.Height)
+	}
+	newbatch.Set(version.LocalDBMeta, types.Encode(r.meta))
+	err := newbatch.Write()
+	if err != nil {
+		chainlog.Error("reindex commit", "shard", shard.Start, "height", next, "err", err)
+		panic(err)
+	}
+	atomic.AddInt64(&r.done, int64(count))
+}
+
+func (r *reindexer) logProgress(quit chan struct{}) {
+	ticker := time.NewTicker(reindexLogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			progress := r.progress()
+			chainlog.Info("reindex progress", "done", progress.Done, "total", progress.Total, "elapsed", progress.Elapsed)
+		case <-quit:
+			return
+		}
+	}
+}
+
+func (r *reindexer) progress() *types.ReindexProgress {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	progress := &types.ReindexProgress{
+		Running: true,
+		Version: r.meta.Version,
+		Total:   r.total,
+		Done:    atomic.LoadInt64(&r.done),
+		Elapsed: int64(types.Since(r.startTime).Seconds()),
+	}
+	for _, shard := range r.meta.Shards {
+		if shard.Height <= shard.End {
+			progress.Workers++
+		}
+		progress.Shards = append(progress.Shards, &types.ReindexShard{Start: shard.Start, End: shard.End, Height: shard.Height})
+	}
+	return progress
+}
+
+//ProcGetReindexProgress 获取重建索引的进度，没有正在进行的任务时返回数据库中保存的进度
+func (chain *BlockChain) ProcGetReindexProgress() (*types.ReindexProgress, error) {
+	chain.reindexLock.Lock()
+	r := chain.reindex
+	chain.reindexLock.Unlock()
+	if r != nil {
+		return r.progress(), nil
+	}
+	meta, err := chain.blockStore.GetUpgradeMeta()
+	if err != nil {
+		return nil, err
+	}
+	progress := &types.ReindexProgress{Version: meta.Version, Shards: meta.Shards}
+	for _, shard := range meta.Shards {
+		progress.Total += shard.End - shard.Start + 1
+		progress.Done += shard.Height - shard.Start
+	}
+	return progress, nil
+}
+
+func (chain *BlockChain) getReindexProgress(msg *queue.Message) {
+	progress, err := chain.ProcGetReindexProgress()
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventGetReindexProgress, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetReindexProgress, progress))
+}
@@ -0,0 +1,102 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitReindexShards(t *testing.T) {
+	shards := splitReindexShards(0, 9, 3)
+	require.Equal(t, 3, len(shards))
+	assert.Equal(t, &types.ReindexShard{Start: 0, End: 2, Height: 0}, shards[0])
+	assert.Equal(t, &types.ReindexShard{Start: 3, End: 5, Height: 3}, shards[1])
+	assert.Equal(t, &types.ReindexShard{Start: 6, End: 9, Height: 6}, shards[2])
+
+	//worker数量大于高度数量
+	shards = splitReindexShards(5, 6, 8)
+	assert.Equal(t, 2, len(shards))
+	shards = splitReindexShards(5, 4, 8)
+	require.Equal(t, 1, len(shards))
+	assert.True(t, shards[0].Height > shards[0].End)
+}
+
+func TestReindexer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+
+	blockStoreDB := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	chain := InitEnv()
+	chain.blockStore = NewBlockStore(chain, blockStoreDB, chain.client)
+
+	var lock sync.Mutex
+	indexed := make(map[int64]int)
+	fn := func(newbatch dbm.Batch, index int64) error {
+		lock.Lock()
+		indexed[index]++
+		lock.Unlock()
+		newbatch.Set([]byte(fmt.Sprintf("reindex:%d", index)), []byte("ok"))
+		return nil
+	}
+
+	//模拟中断之后的进度，第一个分片处理到了高度5，第二个分片已经完成
+	meta := &types.UpgradeMeta{
+		Starting: true,
+		Shards: []*types.ReindexShard{
+			{Start: 0, End: 9, Height: 5},
+			{Start: 10, End: 19, Height: 20},
+		},
+	}
+	r := newReindexer(chain, meta, 5, 24, 4, 2, fn)
+	assert.Equal(t, int64(25), r.total)
+	assert.Equal(t, int64(15), r.done)
+	require.Equal(t, 3, len(r.meta.Shards))
+	//提交时low以下的高度都已经处理完成
+	var lows []int64
+	r.onCommit = func(newbatch dbm.Batch, low int64) {
+		lock.Lock()
+		defer lock.Unlock()
+		for index := int64(5); index < low; index++ {
+			if index < 10 || index >= 20 {
+				assert.Equal(t, 1, indexed[index], "low %d index %d", low, index)
+			}
+		}
+		lows = append(lows, low)
+	}
+	r.run()
+	require.True(t, len(lows) > 0)
+	assert.Equal(t, int64(25), lows[len(lows)-1])
+
+	assert.Equal(t, 10, len(indexed))
+	for index := int64(5); index < 10; index++ {
+		assert.Equal(t, 1, indexed[index])
+	}
+	for index := int64(20); index < 25; index++ {
+		assert.Equal(t, 1, indexed[index])
+	}
+	value, err := blockStoreDB.Get([]byte("reindex:24"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ok"), value)
+
+	progress, err := chain.ProcGetReindexProgress()
+	require.NoError(t, err)
+	assert.False(t, progress.Running)
+	assert.Equal(t, int64(25), progress.Total)
+	assert.Equal(t, int64(25), progress.Done)
+	saved, err := chain.blockStore.GetUpgradeMeta()
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), saved.Height)
+	assert.True(t, saved.Starting)
+}
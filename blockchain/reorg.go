@@ -0,0 +1,175 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//链重组事件最多返回的个数
+const maxReorgEventCount = 100
+
+var (
+	reorgPrefix       = []byte("Reorg:")
+	reorgSeqPrefix    = []byte("ReorgSeq:")
+	lastReorgIndexKey = []byte("LastReorgIndex")
+)
+
+//index->ReorgEvent
+func calcReorgKey(index int64) []byte {
+	return append(append([]byte{}, reorgPrefix...), []byte(fmt.Sprintf("%012d", index))...)
+}
+
+//第一个回滚区块的seq->index
+func calcReorgSeqKey(seq int64) []byte {
+	return append(append([]byte{}, reorgSeqPrefix...), []byte(fmt.Sprintf("%012d", seq))...)
+}
+
+//newReorgEvent 通过需要回滚和加入主链的节点构造链重组事件
+func newReorgEvent(detachNodes, attachNodes *list.List) *types.ReorgEvent {
+	event := &types.ReorgEvent{Sequence: -1, Depth: int64(detachNodes.Len()), Time: types.Now().Unix()}
+	for e := detachNodes.Front(); e != nil; e = e.Next() {
+		event.DetachedHashes = append(event.DetachedHashes, e.Value.(*blockNode).hash)
+	}
+	for e := attachNodes.Front(); e != nil; e = e.Next() {
+		event.AttachedHashes = append(event.AttachedHashes, e.Value.(*blockNode).hash)
+	}
+	if attachNodes.Front() != nil {
+		fork := attachNodes.Front().Value.(*blockNode).parent
+		event.ForkHeight = fork.height
+		event.ForkHash = fork.hash
+	}
+	return event
+}
+
+//LoadLastReorgIndex 获取最新的链重组事件序号
+func (bs *BlockStore) LoadLastReorgIndex() (int64, error) {
+	return bs.loadFlag(lastReorgIndexKey)
+}
+
+//SaveReorgEvent 保存链重组事件，index为0时分配新的序号
+func (bs *BlockStore) SaveReorgEvent(event *types.ReorgEvent) error {
+	batch := bs.NewBatch(true)
+	if event.Index == 0 {
+		last, err := bs.LoadLastReorgIndex()
+		if err != nil {
+			return err
+		}
+		event.Index = last + 1
+		batch.Set(lastReorgIndexKey, types.Encode(&types.Int64{Data: event.Index}))
+		if event.Sequence >= 0 {
+			batch.Set(calcReorgSeqKey(event.Sequence), types.Encode(&types.Int64{Data: event.Index}))
+		}
+	}
+	batch.Set(calcReorgKey(event.Index), types.Encode(event))
+	return batch.Write()
+}
+
+//GetReorgEvent 通过序号获取链重组事件
+func (bs *BlockStore) GetReorgEvent(index int64) (*types.ReorgEvent, error) {
+	value, err := bs.db.Get(calcReorgKey(index))
+	if err != nil {
+		if err == dbm.ErrNotFoundInDb {
+			return nil, types.ErrNotFound
+		}
+		return nil, err
+	}
+	var event types.ReorgEvent
+	err = types.Decode(value, &event)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+//GetReorgEventBySequence 通过第一个回滚区块的seq获取链重组事件
+func (bs *BlockStore) GetReorgEventBySequence(seq int64) (*types.ReorgEvent, error) {
+	value, err := bs.db.Get(calcReorgSeqKey(seq))
+	if err != nil {
+		if err == dbm.ErrNotFoundInDb {
+			return nil, types.ErrNotFound
+		}
+		return nil, err
+	}
+	index, err := decodeHeight(value)
+	if err != nil {
+		return nil, err
+	}
+	return bs.GetReorgEvent(index)
+}
+
+//recordReorgEvent 在回滚区块之前记录链重组事件，保证seq推送回滚区块时可以获取到重组信息
+func (b *BlockChain) recordReorgEvent(detachNodes, attachNodes *list.List) *types.ReorgEvent {
+	event := newReorgEvent(detachNodes, attachNodes)
+	if b.isRecordBlockSequence {
+		lastSeq, err := b.blockStore.LoadBlockLastSequence()
+		if err == nil {
+			event.Sequence = lastSeq + 1
+		}
+	}
+	err := b.blockStore.SaveReorgEvent(event)
+	if err != nil {
+		chainlog.Error("recordReorgEvent", "forkHeight", event.ForkHeight, "err", err)
+		return nil
+	}
+	chainlog.Info("recordReorgEvent", "index", event.Index, "forkHeight", event.ForkHeight,
+		"forkHash", common.ToHex(event.ForkHash), "depth", event.Depth, "attach", len(event.AttachedHashes))
+	return event
+}
+
+//updateReorgEvent 链重组中途失败时只保留实际回滚和加入主链的区块
+func (b *BlockChain) updateReorgEvent(event *types.ReorgEvent, detached, attached int) {
+	if event == nil {
+		return
+	}
+	event.DetachedHashes = event.DetachedHashes[:detached]
+	event.AttachedHashes = event.AttachedHashes[:attached]
+	event.Depth = int64(detached)
+	err := b.blockStore.SaveReorgEvent(event)
+	if err != nil {
+		chainlog.Error("updateReorgEvent", "index", event.Index, "err", err)
+	}
+}
+
+//ProcGetReorgEvents 获取最近的count个链重组事件，按照时间从新到旧排列
+func (chain *BlockChain) ProcGetReorgEvents(req *types.ReqReorgEvents) (*types.ReorgEvents, error) {
+	if req == nil || req.Count < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count == 0 || count > maxReorgEventCount {
+		count = maxReorgEventCount
+	}
+	last, err := chain.blockStore.LoadLastReorgIndex()
+	if err != nil {
+		return nil, err
+	}
+	events := &types.ReorgEvents{}
+	for index := last; index > 0 && index > last-count; index-- {
+		event, err := chain.blockStore.GetReorgEvent(index)
+		if err != nil {
+			chainlog.Error("ProcGetReorgEvents", "index", index, "err", err)
+			return nil, err
+		}
+		events.Items = append(events.Items, event)
+	}
+	return events, nil
+}
+
+func (chain *BlockChain) getReorgEvents(msg *queue.Message) {
+	req := msg.Data.(*types.ReqReorgEvents)
+	events, err := chain.ProcGetReorgEvents(req)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventGetReorgEvents, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetReorgEvents, events))
+}
@@ -0,0 +1,85 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"container/list"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorgEvent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+
+	blockStoreDB := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	chain := InitEnv()
+	blockStore := NewBlockStore(chain, blockStoreDB, chain.client)
+	chain.blockStore = blockStore
+	chain.isRecordBlockSequence = true
+	require.NoError(t, blockStoreDB.Set(calcLastSeqKey(false), types.Encode(&types.Int64{Data: 9})))
+
+	//分叉点为高度1，回滚主链上的高度2和3，加入分叉链上的高度2,3和4
+	fork := &blockNode{hash: []byte("fork"), height: 1}
+	detachNodes := list.New()
+	attachNodes := list.New()
+	detachNodes.PushBack(&blockNode{hash: []byte("main3"), height: 3})
+	detachNodes.PushBack(&blockNode{hash: []byte("main2"), height: 2, parent: fork})
+	parent := fork
+	for _, hash := range []string{"side2", "side3", "side4"} {
+		node := &blockNode{hash: []byte(hash), height: parent.height + 1, parent: parent}
+		attachNodes.PushBack(node)
+		parent = node
+	}
+
+	event := chain.recordReorgEvent(detachNodes, attachNodes)
+	require.NotNil(t, event)
+	assert.Equal(t, int64(1), event.Index)
+	assert.Equal(t, int64(1), event.ForkHeight)
+	assert.Equal(t, []byte("fork"), event.ForkHash)
+	assert.Equal(t, int64(2), event.Depth)
+	assert.Equal(t, int64(10), event.Sequence)
+	assert.Equal(t, [][]byte{[]byte("main3"), []byte("main2")}, event.DetachedHashes)
+	assert.Equal(t, [][]byte{[]byte("side2"), []byte("side3"), []byte("side4")}, event.AttachedHashes)
+
+	//重组失败时只记录实际处理的区块
+	chain.isRecordBlockSequence = false
+	second := chain.recordReorgEvent(detachNodes, attachNodes)
+	require.NotNil(t, second)
+	assert.Equal(t, int64(-1), second.Sequence)
+	chain.updateReorgEvent(second, 2, 1)
+
+	events, err := chain.ProcGetReorgEvents(&types.ReqReorgEvents{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(events.Items))
+	assert.Equal(t, int64(2), events.Items[0].Index)
+	assert.Equal(t, 1, len(events.Items[0].AttachedHashes))
+	assert.Equal(t, int64(1), events.Items[1].Index)
+	events, err = chain.ProcGetReorgEvents(&types.ReqReorgEvents{Count: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events.Items))
+	assert.Equal(t, int64(2), events.Items[0].Index)
+	_, err = chain.ProcGetReorgEvents(&types.ReqReorgEvents{Count: -1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//只有第一个回滚区块的seq附带重组信息
+	push := newpushseq(blockStore, nil, nil, nil)
+	reorg, err := push.getReorgEvent(10, &types.BlockSequence{Type: types.DelBlock})
+	require.NoError(t, err)
+	assert.Equal(t, event.Index, reorg.Index)
+	reorg, err = push.getReorgEvent(11, &types.BlockSequence{Type: types.DelBlock})
+	require.NoError(t, err)
+	assert.Nil(t, reorg)
+	reorg, err = push.getReorgEvent(10, &types.BlockSequence{Type: types.AddBlock})
+	require.NoError(t, err)
+	assert.Nil(t, reorg)
+}
@@ -24,6 +24,8 @@ func (chain *BlockChain) Upgrade() {
 	chain.UpgradePlugin()
 	chainlog.Info("chain reduce start")
 	chain.ReduceChain()
+	chainlog.Info("chain prune start")
+	chain.PruneChain()
 }
 
 // UpgradePlugin 升级插件
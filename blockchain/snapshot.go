@@ -0,0 +1,590 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//快照同步:
+//必须配置可信的检查点区块hash(SnapshotHash)，区块头不校验共识和难度，只能通过可信的hash保证区块头链的正确性。
+//新节点启动时从检查点开始倒序获取[1, SnapshotHeight]的区块头，每一段区块头都必须链接到已经校验过的后一个区块头，
+//校验通过之后才写入db，然后获取检查点区块，再从检查点区块的StateHash开始按hash逐层获取mavl树节点，
+//每个节点都在store模块中校验，全部获取之后将检查点区块设置为本节点的最新区块，之后只需要同步检查点之后的区块。
+//区块头、检查点区块和状态树节点都按分片从p2p获取，分片校验失败时将对应的peer记录为故障节点并切换到其他peer。
+//同步失败时删除已经写入的区块头和状态树节点，之后按照普通的方式从创世区块开始同步。
+//注意：检查点之前区块的localdb不会重建，这些区块记录为已经裁剪，查询时返回ErrBlockPruned，
+//mvcc和mavl裁剪模式下的辅助数据也不会同步。
+
+const (
+	//快照同步时一次获取的区块头个数，和p2p GetHeaders的最大个数一致
+	snapshotHeaderCount int64 = 2000
+	//快照同步时一次获取的mavl树节点个数
+	snapshotNodeCount = 1000
+	//等待peer的最长时间，超时之后切换到普通的同步模式
+	waitTimeSnapshot = 120
+	//请求快照分片的超时时间
+	snapshotChunkTimeout = time.Minute
+)
+
+//snapshotSyncer 快照同步过程中记录可用的peer
+type snapshotSyncer struct {
+	chain  *BlockChain
+	height int64
+	pids   []string
+	index  int
+	//已经写入db的最低区块头高度，同步失败时删除[low, height]中本次写入的区块头
+	low int64
+	//同步之前已经存在的区块头和总难度，不是本次写入的，清理时保留
+	oldHeaders map[int64]bool
+	oldTds     map[int64]bool
+	//已经写入总难度的最高高度
+	tdHeight int64
+	//本次新写入store的mavl树节点，同步失败时删除
+	nodes [][]byte
+}
+
+func newSnapshotSyncer(chain *BlockChain, height int64, pids []string) *snapshotSyncer {
+	return &snapshotSyncer{chain: chain, height: height, pids: pids, oldHeaders: make(map[int64]bool), oldTds: make(map[int64]bool)}
+}
+
+//needSnapshotSync 使能快照同步并且本节点还没有同步过区块时才启动快照同步
+func (chain *BlockChain) needSnapshotSync() bool {
+	return chain.cfg.EnableSnapshotSync && !chain.cfg.EnableLightNode && chain.cfg.SnapshotHeight > 0 && !chain.isParaChain && chain.GetBlockHeight() <= 0
+}
+
+//SnapshotSync 快照同步，完成或者失败之后都切换到快速下载模式继续同步后面的区块
+func (chain *BlockChain) SnapshotSync() {
+	err := chain.syncSnapshot()
+	if err != nil {
+		synlog.Error("SnapshotSync", "height", chain.cfg.SnapshotHeight, "err", err)
+	}
+	chain.FastDownLoadBlocks()
+}
+
+func (chain *BlockChain) syncSnapshot() error {
+	cfg := chain.client.GetConfig()
+	height := chain.cfg.SnapshotHeight
+	//ForkBlockHash之前的区块hash中不包含StateHash，无法校验状态快照
+	if !cfg.IsFork(height, "ForkBlockHash") {
+		return types.ErrSnapshotHeaders
+	}
+	//没有可信的检查点hash时不能确认peer提供的区块头链
+	pivotHash, err := common.FromHex(chain.cfg.SnapshotHash)
+	if err != nil || len(pivotHash) != len(zeroHash) {
+		return types.ErrSnapshotNoHash
+	}
+	syncer, err := chain.waitSnapshotPeers(height)
+	if err != nil {
+		return err
+	}
+	synlog.Info("syncSnapshot start", "height", height, "hash", chain.cfg.SnapshotHash, "pids", syncer.pids)
+	err = syncer.sync(pivotHash)
+	if err != nil {
+		syncer.clean()
+		return err
+	}
+	return nil
+}
+
+func (s *snapshotSyncer) sync(pivotHash []byte) error {
+	header, err := s.syncHeaders(pivotHash)
+	if err != nil {
+		return err
+	}
+	block, err := s.syncBlock(header)
+	if err != nil {
+		return err
+	}
+	err = s.syncState(block.StateHash)
+	if err != nil {
+		return err
+	}
+	err = s.chain.verifySnapshotState(block.StateHash)
+	if err != nil {
+		return err
+	}
+	return s.chain.connectSnapshotBlock(block)
+}
+
+//clean 删除同步失败时本次写入的区块头、总难度和状态树节点，同步之前已经存在的数据保留
+func (s *snapshotSyncer) clean() {
+	chain := s.chain
+	if s.low > 0 {
+		newbatch := chain.blockStore.NewBatch(true)
+		for height := s.low; height <= s.height; height++ {
+			hash, err := chain.blockStore.GetBlockHashByHeight(height)
+			if err != nil {
+				continue
+			}
+			if height <= s.tdHeight && !s.oldTds[height] {
+				newbatch.Delete(calcHashToTdKey(hash))
+			}
+			if s.oldHeaders[height] {
+				continue
+			}
+			kvs, err := delHeaderTable(chain.blockStore.db, height, hash)
+			if err != nil {
+				synlog.Error("snapshot clean delHeaderTable", "height", height, "err", err)
+				continue
+			}
+			for _, kv := range kvs {
+				if kv.GetValue() == nil {
+					newbatch.Delete(kv.GetKey())
+				} else {
+					newbatch.Set(kv.GetKey(), kv.GetValue())
+				}
+			}
+			newbatch.Delete(calcHashToHeightKey(hash))
+			newbatch.Delete(calcHeightToHashKey(height))
+		}
+		if err := newbatch.Write(); err != nil {
+			synlog.Error("snapshot clean headers", "err", err)
+		}
+	}
+	if len(s.nodes) > 0 {
+		msg := chain.client.NewMessage("store", types.EventStoreDelSnapshotNodes, &types.ReqHashes{Hashes: s.nodes})
+		err := chain.client.Send(msg, true)
+		if err == nil {
+			_, err = chain.client.Wait(msg)
+		}
+		if err != nil {
+			synlog.Error("snapshot clean nodes", "err", err)
+		}
+	}
+	synlog.Info("snapshot clean", "low", s.low, "height", s.height, "nodes", len(s.nodes))
+}
+
+//waitSnapshotPeers 等待本节点创建创世区块并且有高度不低于检查点的最优链peer
+func (chain *BlockChain) waitSnapshotPeers(height int64) (*snapshotSyncer, error) {
+	startTime := types.Now()
+	for {
+		if chain.GetBlockHeight() == 0 {
+			var pids []string
+			for _, pid := range chain.GetBestChainPids() {
+				peer := chain.GetPeerInfo(pid)
+				if peer != nil && peer.Height >= height {
+					pids = append(pids, pid)
+				}
+			}
+			if len(pids) > 0 {
+				return newSnapshotSyncer(chain, height, pids), nil
+			}
+		}
+		if types.Since(startTime) > waitTimeSnapshot*time.Second || chain.cfg.SingleMode {
+			return nil, types.ErrSnapshotNoPeer
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+//nextPeer 轮流使用可用的peer
+func (s *snapshotSyncer) nextPeer() (string, error) {
+	if len(s.pids) == 0 {
+		return "", types.ErrSnapshotNoPeer
+	}
+	s.index = (s.index + 1) % len(s.pids)
+	return s.pids[s.index], nil
+}
+
+//dropPeer peer不能提供快照数据，本次同步不再使用
+func (s *snapshotSyncer) dropPeer(pid string) {
+	for i, p := range s.pids {
+		if p == pid {
+			s.pids = append(s.pids[:i], s.pids[i+1:]...)
+			return
+		}
+	}
+}
+
+//faultPeer peer提供的快照数据校验失败，记录为故障节点
+func (s *snapshotSyncer) faultPeer(pid string, height int64, hash []byte, err error) {
+	synlog.Error("snapshot faultPeer", "pid", pid, "height", height, "hash", common.ToHex(hash), "err", err)
+	s.chain.RecordFaultPeer(pid, height, hash, err)
+	s.dropPeer(pid)
+}
+
+//fetch 从指定的peer获取快照分片
+func (s *snapshotSyncer) fetch(req *types.ReqSnapshotChunk) (*types.SnapshotChunk, error) {
+	client := s.chain.client
+	msg := client.NewMessage("p2p", types.EventFetchSnapshotChunk, req)
+	err := client.SendTimeout(msg, true, snapshotChunkTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, snapshotChunkTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if chunk, ok := resp.GetData().(*types.SnapshotChunk); ok {
+		return chunk, nil
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+	return nil, types.ErrTypeAsset
+}
+
+//syncHeaders 从可信的检查点hash开始倒序同步[1, height]的区块头，返回检查点的区块头
+//每一段区块头都必须链接到已经校验过的后一个区块头，所以写入db的区块头都已经和检查点hash校验过
+func (s *snapshotSyncer) syncHeaders(pivotHash []byte) (*types.Header, error) {
+	chain := s.chain
+	cfg := chain.client.GetConfig()
+	genesis, err := chain.blockStore.GetBlockHeaderByHeight(0)
+	if err != nil {
+		return nil, err
+	}
+	var pivot *types.Header
+	expect := pivotHash
+	for end := s.height; end >= 1; {
+		start := end - snapshotHeaderCount + 1
+		if start < 1 {
+			start = 1
+		}
+		pid, err := s.nextPeer()
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := s.fetch(&types.ReqSnapshotChunk{Height: s.height, StartHeight: start, EndHeight: end, Pid: pid})
+		if err != nil {
+			synlog.Error("syncHeaders fetch", "pid", pid, "start", start, "end", end, "err", err)
+			s.dropPeer(pid)
+			continue
+		}
+		err = checkSnapshotHeaders(cfg, chunk.Headers, start, end, expect)
+		if err != nil {
+			s.faultPeer(pid, end, expect, err)
+			continue
+		}
+		//可信的区块头链和本节点的创世区块不一致，配置错误
+		if start == 1 && !bytes.Equal(chunk.Headers[0].ParentHash, genesis.Hash) {
+			synlog.Error("syncHeaders genesis not match", "genesis", common.ToHex(genesis.Hash), "parent", common.ToHex(chunk.Headers[0].ParentHash))
+			return nil, types.ErrSnapshotHeaders
+		}
+		err = s.saveHeaders(chunk.Headers)
+		if err != nil {
+			return nil, err
+		}
+		if pivot == nil {
+			pivot = chunk.Headers[len(chunk.Headers)-1]
+		}
+		expect = chunk.Headers[0].ParentHash
+		end = start - 1
+		synlog.Info("syncHeaders", "pid", pid, "height", start, "target", s.height)
+	}
+	err = s.saveTd(genesis)
+	if err != nil {
+		return nil, err
+	}
+	return pivot, nil
+}
+
+//checkSnapshotHeaders 校验区块头的高度、hash以及链接关系，最后一个区块头的hash必须是已经校验过的expect
+func checkSnapshotHeaders(cfg *types.Chain33Config, headers []*types.Header, start, end int64, expect []byte) error {
+	if int64(len(headers)) != end-start+1 {
+		return types.ErrSnapshotHeaders
+	}
+	for i, header := range headers {
+		if header == nil || header.Height != start+int64(i) {
+			return types.ErrSnapshotHeaders
+		}
+		if !bytes.Equal(header.CalcHash(cfg), header.Hash) {
+			return types.ErrSnapshotHeaders
+		}
+		if i > 0 && !bytes.Equal(header.ParentHash, headers[i-1].Hash) {
+			return types.ErrSnapshotHeaders
+		}
+	}
+	if !bytes.Equal(headers[len(headers)-1].Hash, expect) {
+		return types.ErrSnapshotHeaders
+	}
+	return nil
+}
+
+//saveHeaders 保存已经校验过的区块头以及hash和高度的对应关系，已经存在的区块头不覆盖并且记录下来
+func (s *snapshotSyncer) saveHeaders(headers []*types.Header) error {
+	chain := s.chain
+	newbatch := chain.blockStore.NewBatch(true)
+	for _, header := range headers {
+		if _, err := chain.blockStore.GetBlockHashByHeight(header.Height); err == nil {
+			s.oldHeaders[header.Height] = true
+			continue
+		}
+		kvs, err := saveHeaderTable(chain.blockStore.db, header)
+		if err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			newbatch.Set(kv.GetKey(), kv.GetValue())
+		}
+		heightbytes := types.Encode(&types.Int64{Data: header.Height})
+		newbatch.Set(calcHashToHeightKey(header.Hash), heightbytes)
+		newbatch.Set(calcHeightToHashKey(header.Height), header.Hash)
+	}
+	err := newbatch.Write()
+	if err != nil {
+		return err
+	}
+	s.low = headers[0].Height
+	return nil
+}
+
+//saveTd 区块头全部校验之后从创世区块开始计算每个区块的总难度，已经存在的总难度不覆盖并且记录下来
+func (s *snapshotSyncer) saveTd(genesis *types.Header) error {
+	chain := s.chain
+	td, err := chain.blockStore.GetTdByBlockHash(genesis.Hash)
+	if err != nil {
+		return err
+	}
+	newbatch := chain.blockStore.NewBatch(true)
+	for h := int64(1); h <= s.height; h++ {
+		header, err := chain.blockStore.GetBlockHeaderByHeight(h)
+		if err != nil {
+			return err
+		}
+		td = new(big.Int).Add(td, difficulty.CalcWork(header.Difficulty))
+		if _, err := chain.blockStore.GetTdByBlockHash(header.Hash); err == nil {
+			s.oldTds[h] = true
+		} else {
+			err = chain.blockStore.SaveTdByBlockHash(newbatch, header.Hash, td)
+			if err != nil {
+				return err
+			}
+		}
+		if h%snapshotHeaderCount == 0 || h == s.height {
+			err = newbatch.Write()
+			if err != nil {
+				return err
+			}
+			newbatch.Reset()
+			s.tdHeight = h
+		}
+	}
+	return nil
+}
+
+//syncBlock 获取检查点区块，区块hash必须和已经校验过的区块头一致
+func (s *snapshotSyncer) syncBlock(header *types.Header) (*types.Block, error) {
+	cfg := s.chain.client.GetConfig()
+	for {
+		pid, err := s.nextPeer()
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := s.fetch(&types.ReqSnapshotChunk{Height: s.height, WithBlock: true, Pid: pid})
+		if err != nil {
+			synlog.Error("syncBlock fetch", "pid", pid, "height", s.height, "err", err)
+			s.dropPeer(pid)
+			continue
+		}
+		block := chunk.GetBlock()
+		if block == nil || block.Height != header.Height || !bytes.Equal(block.Hash(cfg), header.Hash) ||
+			!bytes.Equal(merkle.CalcMerkleRoot(cfg, block.Height, block.Txs), block.TxHash) {
+			s.faultPeer(pid, header.Height, header.Hash, types.ErrSnapshotBlock)
+			continue
+		}
+		return block, nil
+	}
+}
+
+//syncState 从stateHash开始逐层同步mavl树节点，节点的校验和保存在store模块中处理
+func (s *snapshotSyncer) syncState(stateHash []byte) error {
+	if len(stateHash) == 0 || bytes.Equal(stateHash, zeroHash[:]) {
+		return nil
+	}
+	//按深度优先的顺序获取节点，减少待获取节点列表占用的内存
+	pending := [][]byte{stateHash}
+	var count int64
+	for len(pending) > 0 {
+		n := len(pending)
+		if n > snapshotNodeCount {
+			n = snapshotNodeCount
+		}
+		hashes := pending[len(pending)-n:]
+		pid, err := s.nextPeer()
+		if err != nil {
+			return err
+		}
+		chunk, err := s.fetch(&types.ReqSnapshotChunk{Height: s.height, Hashes: hashes, Pid: pid})
+		if err != nil || len(chunk.GetNodes()) == 0 {
+			synlog.Error("syncState fetch", "pid", pid, "height", s.height, "err", err)
+			s.dropPeer(pid)
+			continue
+		}
+		requested := make(map[string]bool)
+		for _, hash := range hashes {
+			requested[string(hash)] = true
+		}
+		var bad bool
+		for _, node := range chunk.Nodes {
+			if !requested[string(node.Hash)] {
+				bad = true
+				break
+			}
+			delete(requested, string(node.Hash))
+		}
+		if bad {
+			s.faultPeer(pid, s.height, stateHash, types.ErrSnapshotNode)
+			continue
+		}
+		reply, err := s.chain.setSnapshotNodes(&types.SnapshotNodes{Nodes: chunk.Nodes})
+		if err != nil {
+			s.faultPeer(pid, s.height, stateHash, err)
+			continue
+		}
+		s.nodes = append(s.nodes, reply.Saved...)
+		missing := reply.Missing
+		//peer没有返回的节点保留在待获取列表中，从其他peer获取
+		pending = pending[:len(pending)-n]
+		for _, hash := range hashes {
+			if requested[string(hash)] {
+				pending = append(pending, hash)
+			}
+		}
+		pending = append(pending, missing...)
+		count += int64(len(chunk.Nodes))
+		synlog.Debug("syncState", "pid", pid, "nodes", count, "pending", len(pending))
+	}
+	synlog.Info("syncState complete", "height", s.height, "nodes", count)
+	return nil
+}
+
+//setSnapshotNodes 发送快照节点到store模块校验并保存，返回本地还不存在的子节点以及新写入的节点
+func (chain *BlockChain) setSnapshotNodes(nodes *types.SnapshotNodes) (*types.ReplySnapshotNodes, error) {
+	msg := chain.client.NewMessage("store", types.EventStoreSetSnapshotNodes, nodes)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := resp.GetData().(*types.ReplySnapshotNodes); ok {
+		return reply, nil
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+	return nil, types.ErrTypeAsset
+}
+
+//verifySnapshotState 校验同步完成的状态树的结构
+func (chain *BlockChain) verifySnapshotState(stateHash []byte) error {
+	msg := chain.client.NewMessage("store", types.EventStoreVerifySnapshot, &types.ReqHash{Hash: stateHash})
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := chain.client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	return resp.Err()
+}
+
+//connectSnapshotBlock 保存检查点区块并设置为最新区块
+func (chain *BlockChain) connectSnapshotBlock(block *types.Block) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	cfg := chain.client.GetConfig()
+	hash := block.Hash(cfg)
+	td, err := chain.blockStore.GetTdByBlockHash(hash)
+	if err != nil {
+		return err
+	}
+	blockdetail := &types.BlockDetail{Block: block}
+	newbatch := chain.blockStore.NewBatch(true)
+	lastSequence, err := chain.blockStore.SaveBlock(newbatch, blockdetail, -1)
+	if err != nil {
+		return err
+	}
+	err = chain.blockStore.SaveTdByBlockHash(newbatch, hash, td)
+	if err != nil {
+		return err
+	}
+	//检查点之前的区块没有body和receipt，localdb也没有重建，记录为已经裁剪
+	chain.blockStore.setPrunedHeight(newbatch, block.Height-1)
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	chain.blockStore.UpdateHeight2(block.Height)
+	chain.blockStore.UpdateLastBlock2(block)
+	chain.cache.cacheBlock(blockdetail)
+	chain.InitIndexAndBestView()
+	chain.query.updateStateHash(block.StateHash)
+	if chain.isRecordBlockSequence {
+		chain.pushseq.UpdateSeq(lastSequence)
+	}
+	synlog.Info("connectSnapshotBlock", "height", block.Height, "hash", common.ToHex(hash), "stateHash", common.ToHex(block.StateHash))
+	return nil
+}
+
+//getSnapshotChunk 其他节点快照同步时获取本节点的快照分片
+func (chain *BlockChain) getSnapshotChunk(msg *queue.Message) {
+	req := msg.Data.(*types.ReqSnapshotChunk)
+	chunk, err := chain.ProcGetSnapshotChunk(req)
+	if err != nil {
+		chainlog.Error("ProcGetSnapshotChunk", "height", req.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventGetSnapshotChunk, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetSnapshotChunk, chunk))
+}
+
+//ProcGetSnapshotChunk 获取指定检查点高度的快照分片
+func (chain *BlockChain) ProcGetSnapshotChunk(req *types.ReqSnapshotChunk) (*types.SnapshotChunk, error) {
+	if req.Height <= 0 || req.Height > chain.GetBlockHeight() {
+		return nil, types.ErrHeightNotExist
+	}
+	chunk := &types.SnapshotChunk{Height: req.Height}
+	if req.EndHeight > 0 {
+		if req.StartHeight <= 0 || req.EndHeight > req.Height || req.EndHeight-req.StartHeight >= snapshotHeaderCount {
+			return nil, types.ErrInvalidParam
+		}
+		headers, err := chain.ProcGetHeadersMsg(&types.ReqBlocks{Start: req.StartHeight, End: req.EndHeight})
+		if err != nil {
+			return nil, err
+		}
+		chunk.Headers = headers.Items
+	}
+	if req.WithBlock {
+		blockdetail, err := chain.GetBlock(req.Height)
+		if err != nil {
+			return nil, err
+		}
+		chunk.Block = blockdetail.Block
+	}
+	if len(req.Hashes) > 0 {
+		if len(req.Hashes) > snapshotNodeCount {
+			return nil, types.ErrInvalidParam
+		}
+		msg := chain.client.NewMessage("store", types.EventStoreGetSnapshotNodes, &types.ReqHashes{Hashes: req.Hashes})
+		err := chain.client.Send(msg, true)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := chain.client.Wait(msg)
+		if err != nil {
+			return nil, err
+		}
+		nodes, ok := resp.GetData().(*types.SnapshotNodes)
+		if !ok {
+			return nil, types.ErrTypeAsset
+		}
+		chunk.Nodes = nodes.Nodes
+	}
+	return chunk, nil
+}
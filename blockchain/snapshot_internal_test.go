@@ -0,0 +1,105 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSnapshotHeaders(cfg *types.Chain33Config, parent []byte, start, count int64) []*types.Header {
+	var headers []*types.Header
+	for i := int64(0); i < count; i++ {
+		header := &types.Header{Version: 1, ParentHash: parent, Height: start + i, BlockTime: 1, TxHash: []byte("tx"), StateHash: []byte("state")}
+		header.Hash = header.CalcHash(cfg)
+		headers = append(headers, header)
+		parent = header.Hash
+	}
+	return headers
+}
+
+func TestCheckSnapshotHeaders(t *testing.T) {
+	chain := InitEnv()
+	cfg := chain.client.GetConfig()
+	headers := newSnapshotHeaders(cfg, []byte("genesis"), 1, 5)
+	pivot := headers[4].Hash
+
+	assert.Nil(t, checkSnapshotHeaders(cfg, headers, 1, 5, pivot))
+	//最后一个区块头必须和已经校验过的hash一致
+	assert.Equal(t, types.ErrSnapshotHeaders, checkSnapshotHeaders(cfg, headers, 1, 5, headers[3].Hash))
+	assert.Equal(t, types.ErrSnapshotHeaders, checkSnapshotHeaders(cfg, headers[:4], 1, 5, pivot))
+	//区块头之间不链接
+	other := newSnapshotHeaders(cfg, []byte("other"), 3, 3)
+	forged := append(append([]*types.Header{}, headers[:2]...), other...)
+	assert.Equal(t, types.ErrSnapshotHeaders, checkSnapshotHeaders(cfg, forged, 1, 5, other[2].Hash))
+	//hash和区块头内容不一致
+	bad := *headers[4]
+	bad.Difficulty = 100
+	assert.Equal(t, types.ErrSnapshotHeaders, checkSnapshotHeaders(cfg, append(headers[:4:4], &bad), 1, 5, pivot))
+}
+
+func TestSnapshotClean(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+
+	blockStoreDB := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	chain := InitEnv()
+	chain.blockStore = NewBlockStore(chain, blockStoreDB, chain.client)
+	cfg := chain.client.GetConfig()
+
+	//没有配置可信的检查点hash时不启动快照同步
+	chain.cfg.SnapshotHeight = 5
+	chain.cfg.SnapshotHash = ""
+	assert.Equal(t, types.ErrSnapshotNoHash, chain.syncSnapshot())
+
+	headers := newSnapshotHeaders(cfg, []byte("genesis"), 1, 5)
+	//同步之前已经存在的区块头和总难度
+	require.NoError(t, newSnapshotSyncer(chain, 5, nil).saveHeaders(headers[3:4]))
+	newbatch := chain.blockStore.NewBatch(true)
+	require.NoError(t, chain.blockStore.SaveTdByBlockHash(newbatch, headers[3].Hash, big.NewInt(100)))
+	require.NoError(t, newbatch.Write())
+
+	syncer := newSnapshotSyncer(chain, 5, nil)
+	require.NoError(t, syncer.saveHeaders(headers[2:]))
+	assert.Equal(t, int64(3), syncer.low)
+	assert.True(t, syncer.oldHeaders[4])
+	header, err := chain.blockStore.GetBlockHeaderByHeight(5)
+	require.NoError(t, err)
+	assert.Equal(t, headers[4].Hash, header.Hash)
+	newbatch.Reset()
+	for _, header := range headers[2:] {
+		if header.Height != 4 {
+			require.NoError(t, chain.blockStore.SaveTdByBlockHash(newbatch, header.Hash, big.NewInt(header.Height)))
+		}
+	}
+	require.NoError(t, newbatch.Write())
+	syncer.tdHeight = 5
+	syncer.oldTds[4] = true
+
+	//只删除本次同步写入的数据
+	syncer.clean()
+	for _, header := range []*types.Header{headers[2], headers[4]} {
+		_, err = chain.blockStore.GetBlockHashByHeight(header.Height)
+		assert.Equal(t, types.ErrHeightNotExist, err)
+		_, err = chain.blockStore.GetBlockHeaderByHash(header.Hash)
+		assert.Equal(t, types.ErrHashNotExist, err)
+		_, err = chain.blockStore.GetTdByBlockHash(header.Hash)
+		assert.Equal(t, types.ErrHashNotExist, err)
+	}
+	hash, err := chain.blockStore.GetBlockHashByHeight(4)
+	require.NoError(t, err)
+	assert.Equal(t, headers[3].Hash, hash)
+	td, err := chain.blockStore.GetTdByBlockHash(headers[3].Hash)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), td.Int64())
+}
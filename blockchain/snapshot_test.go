@@ -0,0 +1,62 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcGetSnapshotChunk(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	blockchain := mock33.GetBlockChain()
+	cfg := mock33.GetClient().GetConfig()
+
+	addblockheight := blockchain.GetBlockHeight() + 10
+	for blockchain.GetBlockHeight() < addblockheight {
+		_, err := addMainTx(cfg, mock33.GetGenesisKey(), mock33.GetAPI())
+		require.NoError(t, err)
+		time.Sleep(sendTxWait)
+	}
+	height := blockchain.GetBlockHeight()
+
+	//参数检查
+	_, err := blockchain.ProcGetSnapshotChunk(&types.ReqSnapshotChunk{Height: height + 1})
+	assert.Equal(t, types.ErrHeightNotExist, err)
+	_, err = blockchain.ProcGetSnapshotChunk(&types.ReqSnapshotChunk{Height: height, StartHeight: 1, EndHeight: height + 1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//区块头，hash可以通过区块头重新计算并且是连续的
+	chunk, err := blockchain.ProcGetSnapshotChunk(&types.ReqSnapshotChunk{Height: height, StartHeight: 1, EndHeight: height, WithBlock: true})
+	require.NoError(t, err)
+	require.Equal(t, int(height), len(chunk.Headers))
+	genesis, err := blockchain.GetBlock(0)
+	require.NoError(t, err)
+	prev := genesis.Block.Hash(cfg)
+	for _, header := range chunk.Headers {
+		assert.Equal(t, prev, header.ParentHash)
+		assert.Equal(t, header.Hash, header.CalcHash(cfg))
+		prev = header.Hash
+	}
+	require.NotNil(t, chunk.Block)
+	assert.Equal(t, prev, chunk.Block.Hash(cfg))
+
+	//从StateHash开始获取状态树的节点
+	stateHash := chunk.Block.StateHash
+	chunk, err = blockchain.ProcGetSnapshotChunk(&types.ReqSnapshotChunk{Height: height, Hashes: [][]byte{stateHash, []byte("notexist")}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(chunk.Nodes))
+	assert.True(t, bytes.Equal(stateHash, chunk.Nodes[0].Hash))
+	assert.Nil(t, chunk.Block)
+	assert.Nil(t, chunk.Headers)
+}
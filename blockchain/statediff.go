@@ -0,0 +1,74 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//ProcGetBlockStateDiff 获取区块修改的状态，比较父区块和本区块的状态哈希对应的状态树
+//创世区块的父状态为空树
+func (chain *BlockChain) ProcGetBlockStateDiff(req *types.ReqBlockStateDiff) (*types.StateDiff, error) {
+	if req == nil || (len(req.Hash) == 0 && req.Height < 0) {
+		return nil, types.ErrInvalidParam
+	}
+	if chain.cfg.EnableLightNode {
+		return nil, types.ErrLightNode
+	}
+	var header *types.Header
+	var err error
+	if len(req.Hash) > 0 {
+		header, err = chain.blockStore.GetBlockHeaderByHash(req.Hash)
+	} else {
+		header, err = chain.blockStore.GetBlockHeaderByHeight(req.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	oldStateHash := zeroHash[:]
+	if header.Height > 0 {
+		parent, err := chain.blockStore.GetBlockHeaderByHash(header.ParentHash)
+		if err != nil {
+			return nil, err
+		}
+		oldStateHash = parent.StateHash
+	}
+	diff, err := chain.getStateDiff(&types.ReqStateDiff{OldStateHash: oldStateHash, NewStateHash: header.StateHash})
+	if err != nil {
+		return nil, err
+	}
+	diff.Height = header.Height
+	diff.BlockHash = header.Hash
+	return diff, nil
+}
+
+func (chain *BlockChain) getStateDiff(req *types.ReqStateDiff) (*types.StateDiff, error) {
+	msg := chain.client.NewMessage("store", types.EventStoreDiff, req)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	diff, ok := resp.GetData().(*types.StateDiff)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return diff, nil
+}
+
+func (chain *BlockChain) getBlockStateDiff(msg *queue.Message) {
+	req := msg.Data.(*types.ReqBlockStateDiff)
+	diff, err := chain.ProcGetBlockStateDiff(req)
+	if err != nil {
+		chainlog.Error("ProcGetBlockStateDiff", "height", req.Height, "err", err.Error())
+		msg.Reply(chain.client.NewMessage("", types.EventGetBlockStateDiff, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventGetBlockStateDiff, diff))
+}
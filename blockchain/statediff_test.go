@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlockStateDiff(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	chain := mock33.GetBlockChain()
+	cfg := mock33.GetClient().GetConfig()
+	_, err := addMainTx(cfg, mock33.GetGenesisKey(), mock33.GetAPI())
+	require.NoError(t, err)
+	for chain.GetBlockHeight() < 1 {
+		time.Sleep(sendTxWait)
+	}
+
+	_, err = chain.ProcGetBlockStateDiff(&types.ReqBlockStateDiff{Height: -1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//创世区块的状态都是新增的
+	diff, err := chain.ProcGetBlockStateDiff(&types.ReqBlockStateDiff{Height: 0})
+	require.NoError(t, err)
+	require.True(t, len(diff.Items) > 0)
+	for _, item := range diff.Items {
+		assert.Equal(t, int32(types.StateDiffAdd), item.Ty)
+	}
+
+	detail, err := chain.GetBlock(1)
+	require.NoError(t, err)
+	diff, err = chain.ProcGetBlockStateDiff(&types.ReqBlockStateDiff{Hash: detail.Block.Hash(cfg)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), diff.Height)
+	assert.Equal(t, detail.Block.StateHash, diff.NewStateHash)
+
+	//转账修改了创世地址的余额
+	key := []byte("mavl-coins-bty-" + mock33.GetGenesisAddress())
+	var found bool
+	for i, item := range diff.Items {
+		if i > 0 {
+			assert.True(t, bytes.Compare(diff.Items[i-1].Key, item.Key) < 0)
+		}
+		if !bytes.Equal(key, item.Key) {
+			continue
+		}
+		found = true
+		assert.Equal(t, int32(types.StateDiffModify), item.Ty)
+		var oldAcc, newAcc types.Account
+		require.NoError(t, types.Decode(item.OldValue, &oldAcc))
+		require.NoError(t, types.Decode(item.NewValue, &newAcc))
+		assert.True(t, newAcc.Balance < oldAcc.Balance)
+	}
+	assert.True(t, found)
+
+	//按照状态哈希查询结果一致，不存在的状态哈希返回错误
+	_, err = mock33.GetAPI().GetStateDiff(&types.ReqStateDiff{OldStateHash: detail.Block.ParentHash, NewStateHash: detail.Block.StateHash})
+	assert.Error(t, err)
+	parent, err := chain.GetBlock(0)
+	require.NoError(t, err)
+	stateDiff, err := mock33.GetAPI().GetStateDiff(&types.ReqStateDiff{OldStateHash: parent.Block.StateHash, NewStateHash: detail.Block.StateHash})
+	require.NoError(t, err)
+	assert.Equal(t, diff.Items, stateDiff.Items)
+}
@@ -14,6 +14,52 @@ type QueueProtocolAPI struct {
 	mock.Mock
 }
 
+// AckPushSeq provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) AckPushSeq(param *types.PushSeqAck) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.PushSeqAck) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.PushSeqAck) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddCheckpoint provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) AddCheckpoint(param *types.ChainCheckpoint) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ChainCheckpoint) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ChainCheckpoint) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddSeqCallBack provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) AddSeqCallBack(param *types.BlockSeqCB) (*types.ReplyAddSeqCallback, error) {
 	ret := _m.Called(param)
@@ -37,6 +83,29 @@ func (_m *QueueProtocolAPI) AddSeqCallBack(param *types.BlockSeqCB) (*types.Repl
 	return r0, r1
 }
 
+// CheckTransaction provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) CheckTransaction(param *types.Transaction) (*types.ReplyCheckTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyCheckTx
+	if rf, ok := ret.Get(0).(func(*types.Transaction) *types.ReplyCheckTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyCheckTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Transaction) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Close() {
 	_m.Called()
@@ -65,6 +134,29 @@ func (_m *QueueProtocolAPI) CloseQueue() (*types.Reply, error) {
 	return r0, r1
 }
 
+// EvictMempoolTxs provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) EvictMempoolTxs(param *types.ReqEvictMempoolTxs) (*types.TxHashList, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.TxHashList
+	if rf, ok := ret.Get(0).(func(*types.ReqEvictMempoolTxs) *types.TxHashList); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TxHashList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqEvictMempoolTxs) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecWallet provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) ExecWallet(param *types.ChainExecutor) (types.Message, error) {
 	ret := _m.Called(param)
@@ -249,6 +341,29 @@ func (_m *QueueProtocolAPI) GetBlockSequences(param *types.ReqBlocks) (*types.Bl
 	return r0, r1
 }
 
+// GetBlockStateDiff provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetBlockStateDiff(param *types.ReqBlockStateDiff) (*types.StateDiff, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateDiff
+	if rf, ok := ret.Get(0).(func(*types.ReqBlockStateDiff) *types.StateDiff); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBlockStateDiff) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlocks provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetBlocks(param *types.ReqBlocks) (*types.BlockDetails, error) {
 	ret := _m.Called(param)
@@ -272,6 +387,29 @@ func (_m *QueueProtocolAPI) GetBlocks(param *types.ReqBlocks) (*types.BlockDetai
 	return r0, r1
 }
 
+// GetCheckpoints provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetCheckpoints() (*types.ChainCheckpoints, error) {
+	ret := _m.Called()
+
+	var r0 *types.ChainCheckpoints
+	if rf, ok := ret.Get(0).(func() *types.ChainCheckpoints); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ChainCheckpoints)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *QueueProtocolAPI) GetConfig() *types.Chain33Config {
 	ret := _m.Called()
@@ -403,6 +541,29 @@ func (_m *QueueProtocolAPI) GetLastMempool() (*types.ReplyTxList, error) {
 	return r0, r1
 }
 
+// GetLightHeader provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetLightHeader(param *types.ReqInt) (*types.Header, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Header
+	if rf, ok := ret.Get(0).(func(*types.ReqInt) *types.Header); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqInt) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMainSequenceByHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetMainSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -449,6 +610,29 @@ func (_m *QueueProtocolAPI) GetMempool(req *types.ReqGetMempool) (*types.ReplyTx
 	return r0, r1
 }
 
+// GetMempoolStats provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetMempoolStats() (*types.MempoolStats, error) {
+	ret := _m.Called()
+
+	var r0 *types.MempoolStats
+	if rf, ok := ret.Get(0).(func() *types.MempoolStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MempoolStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNetInfo provides a mock function with given fields:
 func (_m *QueueProtocolAPI) GetNetInfo() (*types.NodeNetInfo, error) {
 	ret := _m.Called()
@@ -541,6 +725,98 @@ func (_m *QueueProtocolAPI) GetProperFee(req *types.ReqProperFee) (*types.ReplyP
 	return r0, r1
 }
 
+// GetPushSeqData provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetPushSeqData(param *types.ReqString) (*types.PushSeqData, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.PushSeqData
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.PushSeqData); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushSeqData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReindexProgress provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetReindexProgress() (*types.ReindexProgress, error) {
+	ret := _m.Called()
+
+	var r0 *types.ReindexProgress
+	if rf, ok := ret.Get(0).(func() *types.ReindexProgress); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReindexProgress)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReorgEvents provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetReorgEvents(param *types.ReqReorgEvents) (*types.ReorgEvents, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReorgEvents
+	if rf, ok := ret.Get(0).(func(*types.ReqReorgEvents) *types.ReorgEvents); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReorgEvents)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqReorgEvents) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScheduledTxs provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetScheduledTxs() (*types.ReplyTxList, error) {
+	ret := _m.Called()
+
+	var r0 *types.ReplyTxList
+	if rf, ok := ret.Get(0).(func() *types.ReplyTxList); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyTxList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSeqCallBackLastNum provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSeqCallBackLastNum(param *types.ReqString) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -587,6 +863,52 @@ func (_m *QueueProtocolAPI) GetSequenceByHash(param *types.ReqHash) (*types.Int6
 	return r0, r1
 }
 
+// GetStateDiff provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetStateDiff(param *types.ReqStateDiff) (*types.StateDiff, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateDiff
+	if rf, ok := ret.Get(0).(func(*types.ReqStateDiff) *types.StateDiff); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStateDiff) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetStateProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(*types.ReqStateProof) *types.StateProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStateProof) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionByAddr provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetTransactionByAddr(param *types.ReqAddr) (*types.ReplyTxInfos, error) {
 	ret := _m.Called(param)
@@ -633,6 +955,29 @@ func (_m *QueueProtocolAPI) GetTransactionByHash(param *types.ReqHashes) (*types
 	return r0, r1
 }
 
+// GetTxEvents provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetTxEvents(param *types.ReqString) (*types.TxLifecycleEvents, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.TxLifecycleEvents
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.TxLifecycleEvents); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TxLifecycleEvents)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxList provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetTxList(param *types.TxHashList) (*types.ReplyTxList, error) {
 	ret := _m.Called(param)
@@ -1041,6 +1386,29 @@ func (_m *QueueProtocolAPI) QueryConsensusFunc(driver string, funcname string, p
 	return r0, r1
 }
 
+// QueryMempoolTxs provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) QueryMempoolTxs(param *types.ReqMempoolTxs) (*types.ReplyMempoolTxs, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyMempoolTxs
+	if rf, ok := ret.Get(0).(func(*types.ReqMempoolTxs) *types.ReplyMempoolTxs); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyMempoolTxs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqMempoolTxs) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryTx provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) QueryTx(param *types.ReqHash) (*types.TransactionDetail, error) {
 	ret := _m.Called(param)
@@ -1087,6 +1455,29 @@ func (_m *QueueProtocolAPI) SendTx(param *types.Transaction) (*types.Reply, erro
 	return r0, r1
 }
 
+// SimulateTransaction provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) SimulateTransaction(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplySimulateTx
+	if rf, ok := ret.Get(0).(func(*types.ReqSimulateTx) *types.ReplySimulateTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqSimulateTx) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreCommit provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) StoreCommit(param *types.ReqHash) (*types.ReplyHash, error) {
 	ret := _m.Called(param)
@@ -1271,6 +1662,121 @@ func (_m *QueueProtocolAPI) StoreSet(param *types.StoreSetWithSync) (*types.Repl
 	return r0, r1
 }
 
+// SubTxEvents provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) SubTxEvents(param *types.ReqSubTxEvents) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqSubTxEvents) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqSubTxEvents) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TraceTransaction provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) TraceTransaction(param *types.ReqHash) (*types.ReplyTraceTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyTraceTx
+	if rf, ok := ret.Get(0).(func(*types.ReqHash) *types.ReplyTraceTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyTraceTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqHash) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnSubTxEvents provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) UnSubTxEvents(param *types.ReqString) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyStateProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) VerifyStateProof(param *types.StateProof) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.StateProof) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.StateProof) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyTxProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) VerifyTxProof(param *types.TransactionDetail) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.TransactionDetail) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.TransactionDetail) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Version provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Version() (*types.VersionInfo, error) {
 	ret := _m.Called()
@@ -6,6 +6,7 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -925,6 +926,41 @@ func (q *QueueProtocol) GetSeqCallBackLastNum(param *types.ReqString) (*types.In
 	return nil, types.ErrTypeAsset
 }
 
+// GetPushSeqData 获取grpc方式推送的数据，没有数据时返回ErrNotFound
+func (q *QueueProtocol) GetPushSeqData(param *types.ReqString) (*types.PushSeqData, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetPushSeqData", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventGetPushSeqData, param)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PushSeqData); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// AckPushSeq 确认grpc方式推送的数据已经处理完成
+func (q *QueueProtocol) AckPushSeq(param *types.PushSeqAck) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("AckPushSeq", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventAckPushSeq, param)
+	if err != nil {
+		log.Error("AckPushSeq", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetLastBlockMainSequence 获取最新的block执行序列号
 func (q *QueueProtocol) GetLastBlockMainSequence() (*types.Int64, error) {
 	msg, err := q.send(blockchainKey, types.EventGetLastBlockMainSequence, &types.ReqNil{})
@@ -1026,3 +1062,349 @@ func (q *QueueProtocol) GetConfig() *types.Chain33Config {
 	}
 	return cfg
 }
+
+// GetLightHeader 获取轻节点本地保存的区块头
+func (q *QueueProtocol) GetLightHeader(param *types.ReqInt) (*types.Header, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetLightHeader", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventGetLightHeader, param)
+	if err != nil {
+		log.Error("GetLightHeader", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Header); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// AddCheckpoint 添加签名的检查点
+func (q *QueueProtocol) AddCheckpoint(param *types.ChainCheckpoint) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("AddCheckpoint", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventAddCheckpoint, param)
+	if err != nil {
+		log.Error("AddCheckpoint", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetCheckpoints 获取所有的检查点
+func (q *QueueProtocol) GetCheckpoints() (*types.ChainCheckpoints, error) {
+	msg, err := q.send(blockchainKey, types.EventGetCheckpoints, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetCheckpoints", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ChainCheckpoints); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetReindexProgress 获取重建索引的进度
+func (q *QueueProtocol) GetReindexProgress() (*types.ReindexProgress, error) {
+	msg, err := q.send(blockchainKey, types.EventGetReindexProgress, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetReindexProgress", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReindexProgress); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetReorgEvents 获取最近的链重组事件
+func (q *QueueProtocol) GetReorgEvents(param *types.ReqReorgEvents) (*types.ReorgEvents, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetReorgEvents", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventGetReorgEvents, param)
+	if err != nil {
+		log.Error("GetReorgEvents", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReorgEvents); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetStateProof 获取指定高度状态树中key的证明
+func (q *QueueProtocol) GetStateProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetStateProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventGetStateProof, param)
+	if err != nil {
+		log.Error("GetStateProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateProof); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetBlockStateDiff 获取区块修改的状态key以及修改前后的value
+func (q *QueueProtocol) GetBlockStateDiff(param *types.ReqBlockStateDiff) (*types.StateDiff, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetBlockStateDiff", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventGetBlockStateDiff, param)
+	if err != nil {
+		log.Error("GetBlockStateDiff", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateDiff); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetStateDiff 获取两个状态哈希之间的差异
+func (q *QueueProtocol) GetStateDiff(param *types.ReqStateDiff) (*types.StateDiff, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetStateDiff", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(storeKey, types.EventStoreDiff, param)
+	if err != nil {
+		log.Error("GetStateDiff", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateDiff); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// SubTxEvents 订阅mempool中交易的状态变化事件
+func (q *QueueProtocol) SubTxEvents(param *types.ReqSubTxEvents) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("SubTxEvents", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventSubTxEvents, param)
+	if err != nil {
+		log.Error("SubTxEvents", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		if !reply.GetIsOk() {
+			return nil, errors.New(string(reply.GetMsg()))
+		}
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetTxEvents 获取订阅的交易状态变化事件，没有事件时等待一段时间后返回空的列表
+func (q *QueueProtocol) GetTxEvents(param *types.ReqString) (*types.TxLifecycleEvents, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetTxEvents", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventGetTxEvents, param)
+	if err != nil {
+		log.Error("GetTxEvents", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.TxLifecycleEvents); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// UnSubTxEvents 取消订阅交易状态变化事件
+func (q *QueueProtocol) UnSubTxEvents(param *types.ReqString) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("UnSubTxEvents", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventUnSubTxEvents, param)
+	if err != nil {
+		log.Error("UnSubTxEvents", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		if !reply.GetIsOk() {
+			return nil, errors.New(string(reply.GetMsg()))
+		}
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// CheckTransaction 检查交易能否进入mempool，返回各个检查阶段的结果，交易不会被加入mempool
+func (q *QueueProtocol) CheckTransaction(param *types.Transaction) (*types.ReplyCheckTx, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("CheckTransaction", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventCheckTxDryRun, param)
+	if err != nil {
+		log.Error("CheckTransaction", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyCheckTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// QueryMempoolTxs 按照条件查询mempool中的交易
+func (q *QueueProtocol) QueryMempoolTxs(param *types.ReqMempoolTxs) (*types.ReplyMempoolTxs, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("QueryMempoolTxs", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventQueryMempoolTxs, param)
+	if err != nil {
+		log.Error("QueryMempoolTxs", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyMempoolTxs); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// EvictMempoolTxs 从mempool中删除指定哈希的交易以及地址发送的所有交易
+func (q *QueueProtocol) EvictMempoolTxs(param *types.ReqEvictMempoolTxs) (*types.TxHashList, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("EvictMempoolTxs", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventEvictMempoolTxs, param)
+	if err != nil {
+		log.Error("EvictMempoolTxs", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.TxHashList); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetMempoolStats 获取mempool中交易的统计
+func (q *QueueProtocol) GetMempoolStats() (*types.MempoolStats, error) {
+	msg, err := q.send(mempoolKey, types.EventGetMempoolStats, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetMempoolStats", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.MempoolStats); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetScheduledTxs 获取mempool中还没有到达生效高度或者时间的交易
+func (q *QueueProtocol) GetScheduledTxs() (*types.ReplyTxList, error) {
+	msg, err := q.send(mempoolKey, types.EventGetScheduledTxs, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetScheduledTxs", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyTxList); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// SimulateTransaction 在当前或者指定的状态上模拟执行交易，不会修改任何数据
+func (q *QueueProtocol) SimulateTransaction(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	if param == nil || param.Tx == nil {
+		err := types.ErrInvalidParam
+		log.Error("SimulateTransaction", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(executorKey, types.EventSimulateTx, param)
+	if err != nil {
+		log.Error("SimulateTransaction", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplySimulateTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// TraceTransaction 在区块中重新执行交易，返回执行过程中的读写记录
+func (q *QueueProtocol) TraceTransaction(param *types.ReqHash) (*types.ReplyTraceTx, error) {
+	if param == nil || len(param.Hash) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("TraceTransaction", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(executorKey, types.EventTraceTx, param)
+	if err != nil {
+		log.Error("TraceTransaction", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyTraceTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// VerifyStateProof 通过本地区块头校验状态证明
+func (q *QueueProtocol) VerifyStateProof(param *types.StateProof) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("VerifyStateProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventVerifyStateProof, param)
+	if err != nil {
+		log.Error("VerifyStateProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// VerifyTxProof 通过本地区块头校验交易的merkle证明
+func (q *QueueProtocol) VerifyTxProof(param *types.TransactionDetail) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("VerifyTxProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(blockchainKey, types.EventVerifyTxProof, param)
+	if err != nil {
+		log.Error("VerifyTxProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
@@ -123,12 +123,56 @@ type QueueProtocolAPI interface {
 	ListSeqCallBack() (*types.BlockSeqCBs, error)
 	// types.EventGetSeqCBLastNum
 	GetSeqCallBackLastNum(param *types.ReqString) (*types.Int64, error)
+	// types.EventGetPushSeqData
+	GetPushSeqData(param *types.ReqString) (*types.PushSeqData, error)
+	// types.EventAckPushSeq
+	AckPushSeq(param *types.PushSeqAck) (*types.Reply, error)
 	// types.EventGetParaTxByTitle
 	GetParaTxByTitle(param *types.ReqParaTxByTitle) (*types.ParaTxDetails, error)
 	// types.EventGetHeightByTitle
 	LoadParaTxByTitle(param *types.ReqHeightByTitle) (*types.ReplyHeightByTitle, error)
 	// types.EventGetParaTxByTitleAndHeight
 	GetParaTxByHeight(param *types.ReqParaTxByHeight) (*types.ParaTxDetails, error)
+	// types.EventGetLightHeader
+	GetLightHeader(param *types.ReqInt) (*types.Header, error)
+	// types.EventAddCheckpoint
+	AddCheckpoint(param *types.ChainCheckpoint) (*types.Reply, error)
+	// types.EventGetCheckpoints
+	GetCheckpoints() (*types.ChainCheckpoints, error)
+	// types.EventGetReindexProgress
+	GetReindexProgress() (*types.ReindexProgress, error)
+	// types.EventGetReorgEvents
+	GetReorgEvents(param *types.ReqReorgEvents) (*types.ReorgEvents, error)
+	// types.EventGetStateProof
+	GetStateProof(param *types.ReqStateProof) (*types.StateProof, error)
+	// types.EventGetBlockStateDiff
+	GetBlockStateDiff(param *types.ReqBlockStateDiff) (*types.StateDiff, error)
+	// types.EventStoreDiff
+	GetStateDiff(param *types.ReqStateDiff) (*types.StateDiff, error)
+	// types.EventSubTxEvents
+	SubTxEvents(param *types.ReqSubTxEvents) (*types.Reply, error)
+	// types.EventGetTxEvents
+	GetTxEvents(param *types.ReqString) (*types.TxLifecycleEvents, error)
+	// types.EventUnSubTxEvents
+	UnSubTxEvents(param *types.ReqString) (*types.Reply, error)
+	// types.EventCheckTxDryRun
+	CheckTransaction(param *types.Transaction) (*types.ReplyCheckTx, error)
+	// types.EventQueryMempoolTxs
+	QueryMempoolTxs(param *types.ReqMempoolTxs) (*types.ReplyMempoolTxs, error)
+	// types.EventEvictMempoolTxs
+	EvictMempoolTxs(param *types.ReqEvictMempoolTxs) (*types.TxHashList, error)
+	// types.EventGetMempoolStats
+	GetMempoolStats() (*types.MempoolStats, error)
+	// types.EventGetScheduledTxs
+	GetScheduledTxs() (*types.ReplyTxList, error)
+	// types.EventSimulateTx
+	SimulateTransaction(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error)
+	// types.EventTraceTx
+	TraceTransaction(param *types.ReqHash) (*types.ReplyTraceTx, error)
+	// types.EventVerifyStateProof
+	VerifyStateProof(param *types.StateProof) (*types.Reply, error)
+	// types.EventVerifyTxProof
+	VerifyTxProof(param *types.TransactionDetail) (*types.Reply, error)
 
 	// get chain config
 	GetConfig() *types.Chain33Config
@@ -226,6 +226,9 @@ ForkChainParamV2= -1
 ForkBase58AddressCheck=1800000
 ForkTicketFundAddrV1=-1
 ForkRootHash=1
+ForkTxNotBefore=-1
+ForkTxGas=-1
+ForkTxReplace=-1
 [fork.sub.coins]
 Enable=0
 [fork.sub.ticket]
@@ -6,6 +6,7 @@
 package log
 
 import (
+	"io"
 	"os"
 
 	"github.com/33cn/chain33/common/log/log15"
@@ -17,12 +18,21 @@ var (
 	// 保存日志处理器的引用，方便后续调整日志信息，而不重新初始化
 	fileHandler    *log15.Handler
 	consoleHandler *log15.Handler
+	// 控制台日志的输出，默认为stdout
+	consoleWriter io.Writer = os.Stdout
 )
 
 func init() {
 	//resetWithLogLevel("error")
 }
 
+//SetConsoleWriter 设置控制台日志的输出，需要在SetFileLog之前调用，如stdout用来输出数据时改成stderr
+func SetConsoleWriter(w io.Writer) {
+	consoleWriter = w
+	consoleHandler = nil
+	log15.Root().SetHandler(log15.StreamHandler(w, log15.LogfmtFormat()))
+}
+
 //SetLogLevel 设置控制台日志输出级别
 func SetLogLevel(logLevel string) {
 	handler := getConsoleLogHandler(logLevel)
@@ -72,7 +82,7 @@ func getConsoleLogHandler(logLevel string) *log15.Handler {
 	}
 	stdouth := log15.LvlFilterHandler(
 		getLevel(logLevel),
-		log15.StreamHandler(os.Stdout, format),
+		log15.StreamHandler(consoleWriter, format),
 	)
 
 	consoleHandler = &stdouth
@@ -6,6 +6,7 @@ package executor
 
 import (
 	"bytes"
+	"strconv"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
@@ -34,6 +35,10 @@ type executor struct {
 	execapi    api.ExecutorAPI
 	receipts   []*types.ReceiptData
 	execCache  map[string]drivers.Driver
+	//当前执行的交易或者交易组的gas
+	gas *gasMeter
+	//跟踪交易时记录执行路径
+	trace *txTracer
 }
 
 type executorCtx struct {
@@ -142,6 +147,10 @@ func (e *executor) checkTx(tx *types.Transaction, index int) error {
 		//如果已经过期
 		return types.ErrTxExpire
 	}
+	//mempool检查时index为-1，未生效的交易由mempool按照下一个区块检查
+	if index >= 0 && e.height > 0 && !tx.IsMature(e.height, e.blocktime) {
+		return types.ErrTxNotMature
+	}
 	if err := tx.Check(e.api.GetConfig(), e.height, cfg.GetMinTxFeeRate(), cfg.GetMaxTxFee()); err != nil {
 		return err
 	}
@@ -173,6 +182,9 @@ func (e *executor) checkTxGroup(txgroup *types.Transactions, index int) error {
 		//如果已经过期
 		return types.ErrTxExpire
 	}
+	if e.height > 0 && !txgroup.IsMature(e.height, e.blocktime) {
+		return types.ErrTxNotMature
+	}
 	if err := txgroup.Check(cfg, e.height, cfg.GetMinTxFeeRate(), cfg.GetMaxTxFee()); err != nil {
 		return err
 	}
@@ -237,8 +249,12 @@ func (e *executor) Exec(tx *types.Transaction, index int) (*types.Receipt, error
 	}
 	//第一步先检查 CheckTx
 	if err := exec.CheckTx(tx, index); err != nil {
+		e.trace.add("checkTx", nil, nil, err.Error())
 		return nil, err
 	}
+	if e.trace != nil {
+		e.trace.add("exec", tx.Execer, nil, execFuncName(exec, tx))
+	}
 	r, err := exec.Exec(tx, index)
 	return r, err
 }
@@ -291,6 +307,8 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	if err != nil {
 		return nil, err
 	}
+	//交易组共用第一笔交易的手续费
+	e.gas = e.newGasMeter(txs[0], index)
 	types.AssertConfig(e.api)
 	cfg := e.api.GetConfig()
 	//开启内存事务处理，假设系统只有一个thread 执行
@@ -311,6 +329,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 		if cfg.IsFork(e.height, "ForkExecRollback") {
 			e.rollback()
 		}
+		e.refundGas(receipts[0], txs[0])
 		return receipts, nil
 	}
 	for i := 1; i < len(txs); i++ {
@@ -330,6 +349,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 			}
 			//撤销所有的数据库更新
 			e.rollback()
+			e.refundGas(receipts[0], txs[0])
 			return receipts, nil
 		}
 	}
@@ -337,6 +357,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	if err != nil {
 		return nil, err
 	}
+	e.refundGas(receipts[0], txs[0])
 	return receipts, nil
 }
 
@@ -374,8 +395,10 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 	if !cfg.IsPara() && cfg.GetMinTxFeeRate() > 0 && !ex.IsFree() {
 		feelog, err = e.processFee(tx)
 		if err != nil {
+			e.trace.add("fee", nil, nil, err.Error())
 			return nil, err
 		}
+		e.trace.add("fee", nil, nil, strconv.FormatInt(tx.Fee, 10))
 	}
 	return feelog, nil
 }
@@ -393,8 +416,20 @@ func copyReceipt(feelog *types.Receipt) *types.Receipt {
 func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index int) (*types.Receipt, error) {
 	//只有到pack级别的，才会增加index
 	e.startTx()
+	used := e.gas.getUsed()
+	e.setGasMeter(e.gas)
 	receipt, err := e.Exec(tx, index)
+	e.setGasMeter(nil)
+	if err == nil {
+		err = e.gas.consume(receiptGas(receipt))
+	}
+	//执行器忽略了读写数据库时gas不足的错误
+	if err == nil && e.gas.exceeded() {
+		err = types.ErrOutOfGas
+	}
+	feelog.GasUsed = e.gas.getUsed() - used
 	if err != nil {
+		e.trace.add("error", nil, nil, err.Error())
 		elog.Error("exec tx error = ", "err", err, "exec", string(tx.Execer), "action", tx.ActionName())
 		//add error log
 		errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
@@ -408,6 +443,7 @@ func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index
 	memkvset := e.stateDB.(*StateDB).GetSetKeys()
 	err = e.checkKV(memkvset, receipt.GetKV())
 	if err != nil {
+		e.trace.add("error", nil, nil, err.Error())
 		errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
 		feelog.Logs = append(feelog.Logs, errlog)
 		return feelog, err
@@ -418,6 +454,7 @@ func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index
 	}
 	err = e.execLocalSameTime(tx, receipt, index)
 	if err != nil {
+		e.trace.add("error", nil, nil, err.Error())
 		elog.Error("execLocalSameTime", "err", err)
 		errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
 		feelog.Logs = append(feelog.Logs, errlog)
@@ -459,7 +496,9 @@ func (e *executor) checkKV(memset []string, kvs []*types.KeyValue) error {
 func (e *executor) checkKeyAllow(feelog *types.Receipt, tx *types.Transaction, index int, kvs []*types.KeyValue) (*types.Receipt, error) {
 	for _, kv := range kvs {
 		k := kv.GetKey()
-		if !e.isAllowExec(k, tx, index) {
+		allow := e.isAllowExec(k, tx, index)
+		e.trace.add("allow", k, nil, strconv.FormatBool(allow))
+		if !allow {
 			elog.Error("err receipt key", "key", string(k), "tx.exec", string(tx.GetExecer()),
 				"tx.action", tx.ActionName())
 			//非法的receipt，交易执行失败
@@ -558,12 +597,15 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	if err != nil {
 		return nil, err
 	}
+	e.gas = e.newGasMeter(tx, index)
 	//ignore err
 	e.begin()
 	feelog, err = e.execTxOne(feelog, tx, index)
 	if err != nil {
+		e.trace.add("rollback", nil, nil, err.Error())
 		e.rollback()
 	} else {
+		e.trace.add("commit", nil, nil, "")
 		err := e.commit()
 		if err != nil {
 			return nil, err
@@ -573,6 +615,7 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	if api.IsAPIEnvError(err) {
 		return nil, err
 	}
+	e.refundGas(feelog, tx)
 	return feelog, nil
 }
 
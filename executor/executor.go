@@ -7,10 +7,10 @@ package executor
 
 //store package store the world - state data
 import (
+	"bytes"
 	"strings"
 	"sync"
 
-	"github.com/33cn/chain33/client/api"
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -45,6 +45,8 @@ type Executor struct {
 	grpccli      types.Chain33Client
 	pluginEnable map[string]bool
 	alias        map[string]string
+	//并行执行交易的线程数
+	parallelWorkers int
 }
 
 func execInit(cfg *typ.Chain33Config) {
@@ -67,6 +69,7 @@ func New(cfg *typ.Chain33Config) *Executor {
 	exec.pluginEnable["addrindex"] = !mcfg.DisableAddrIndex
 	exec.pluginEnable["txindex"] = true
 	exec.pluginEnable["fee"] = true
+	exec.parallelWorkers = int(mcfg.ParallelWorkers)
 
 	exec.alias = make(map[string]string)
 	for _, v := range mcfg.Alias {
@@ -120,6 +123,10 @@ func (exec *Executor) SetQueueClient(qcli queue.Client) {
 				go exec.procExecCheckTx(msg)
 			} else if msg.Ty == types.EventBlockChainQuery {
 				go exec.procExecQuery(msg)
+			} else if msg.Ty == types.EventSimulateTx {
+				go exec.procSimulateTx(msg)
+			} else if msg.Ty == types.EventTraceTx {
+				go exec.procTraceTx(msg)
 			} else if msg.Ty == types.EventUpgrade {
 				//执行升级过程中不允许执行其他的事件，这个事件直接不采用异步执行
 				exec.procUpgrade(msg)
@@ -186,17 +193,28 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 			return
 		}
 	}()
-	header, err := exec.qclient.GetLastHeader()
+	data := msg.GetData().(*types.ChainExecutor)
+	//高度和状态哈希只能指定一个
+	if data.Height > 0 && data.StateHash != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, types.ErrInvalidParam))
+		return
+	}
+	last, err := exec.qclient.GetLastHeader()
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
+		return
+	}
+	header, err := exec.stateHeader(last, data.Height, data.StateHash)
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
 		return
 	}
-	data := msg.GetData().(*types.ChainExecutor)
 	driver, err := drivers.LoadDriverWithClient(exec.qclient, data.Driver, header.GetHeight())
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
 		return
 	}
+	history := header != last
 	if data.StateHash == nil {
 		data.StateHash = header.StateHash
 	}
@@ -204,11 +222,26 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 	if !exec.disableLocal {
 		localdb = NewLocalDB(exec.client)
 		defer localdb.(*LocalDB).Close()
-		driver.SetLocalDB(localdb)
+		//本地数据库只有最新的数据，在历史状态上查询时禁止读取，依赖本地数据库的查询返回错误
+		if history {
+			histdb := NewLocalDB(exec.client)
+			defer histdb.(*LocalDB).Close()
+			histdb.(*LocalDB).DisableRead()
+			driver.SetLocalDB(histdb)
+		} else {
+			driver.SetLocalDB(localdb)
+		}
 	}
 	opt := &StateDBOption{EnableMVCC: exec.pluginEnable["mvcc"], Height: header.GetHeight()}
 
 	db := NewStateDB(exec.client, data.StateHash, localdb, opt)
+	//历史状态在mvcc中不存在时返回错误
+	if history && opt.EnableMVCC {
+		if _, err := db.(*StateDB).local.GetVersion(data.StateHash); err != nil {
+			msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
+			return
+		}
+	}
 	db.(*StateDB).enableMVCC(nil)
 	driver.SetStateDB(db)
 	driver.SetAPI(exec.qclient)
@@ -224,6 +257,61 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 	msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, ret))
 }
 
+//queryHeader 获取查询高度的区块头，高度为0时使用最新的区块
+func (exec *Executor) queryHeader(height int64) (*types.Header, error) {
+	header, err := exec.qclient.GetLastHeader()
+	if err != nil || height <= 0 {
+		return header, err
+	}
+	return exec.getHeader(header, height)
+}
+
+//getHeader 获取指定高度的区块头，last为最新的区块头
+func (exec *Executor) getHeader(last *types.Header, height int64) (*types.Header, error) {
+	if height > last.GetHeight() || height < 0 {
+		return nil, types.ErrHeightNotExist
+	}
+	if height == last.GetHeight() {
+		return last, nil
+	}
+	headers, err := exec.qclient.GetHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.GetItems()) != 1 {
+		return nil, types.ErrHeightNotExist
+	}
+	return headers.Items[0], nil
+}
+
+//stateHeader 获取状态哈希所在的区块头，同时指定高度时校验区块头的状态哈希
+//只指定历史状态哈希时需要开启mvcc才能找到状态对应的高度
+func (exec *Executor) stateHeader(last *types.Header, height int64, stateHash []byte) (*types.Header, error) {
+	if height <= 0 {
+		if stateHash == nil || bytes.Equal(stateHash, last.GetStateHash()) {
+			return last, nil
+		}
+		if !exec.pluginEnable["mvcc"] || exec.disableLocal {
+			return nil, types.ErrNotSupport
+		}
+		localdb := NewLocalDB(exec.client)
+		defer localdb.(*LocalDB).Close()
+		version, err := dbm.NewSimpleMVCC(localdb).GetVersion(stateHash)
+		if err != nil {
+			return nil, err
+		}
+		height = version
+	}
+	header, err := exec.getHeader(last, height)
+	if err != nil {
+		return nil, err
+	}
+	if stateHash != nil && !bytes.Equal(stateHash, header.GetStateHash()) {
+		return nil, types.ErrCheckStateHash
+	}
+	return header, nil
+}
+
 func (exec *Executor) procExecCheckTx(msg *queue.Message) {
 	//panic 处理
 	defer func() {
@@ -294,59 +382,16 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 	}
 	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
 	execute.enableMVCC(nil)
-	var receipts []*types.Receipt
-	index := 0
-	for i := 0; i < len(datas.Txs); i++ {
-		tx := datas.Txs[i]
-		//检查groupcount
-		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
-			continue
-		}
-		if tx.GroupCount == 0 {
-			receipt, err := execute.execTx(exec, tx, index)
-			if api.IsAPIEnvError(err) {
-				msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
-				return
-			}
-			if err != nil {
-				receipts = append(receipts, types.NewErrReceipt(err))
-				continue
-			}
-			//update local
-			receipts = append(receipts, receipt)
-			index++
-			continue
-		}
-		//所有tx.GroupCount > 0 的交易都是错误的交易
-		types.AssertConfig(exec.client)
-		cfg := exec.client.GetConfig()
-		if !cfg.IsFork(datas.Height, "ForkTxGroup") {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupNotSupport))
-			continue
-		}
-		//判断GroupCount 是否会产生越界
-		if i+int(tx.GroupCount) > len(datas.Txs) {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
-			continue
-		}
-		receiptlist, err := execute.execTxGroup(datas.Txs[i:i+int(tx.GroupCount)], index)
-		i = i + int(tx.GroupCount) - 1
-		if len(receiptlist) > 0 && len(receiptlist) != int(tx.GroupCount) {
-			panic("len(receiptlist) must be equal tx.GroupCount")
-		}
-		if err != nil {
-			if api.IsAPIEnvError(err) {
-				msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
-				return
-			}
-			for n := 0; n < int(tx.GroupCount); n++ {
-				receipts = append(receipts, types.NewErrReceipt(err))
-			}
-			continue
-		}
-		receipts = append(receipts, receiptlist...)
-		index += int(tx.GroupCount)
+	types.AssertConfig(exec.client)
+	cfg := exec.client.GetConfig()
+	units := splitTxUnits(cfg, datas.Height, datas.Txs)
+	if exec.isParallelEnable(cfg, datas.Height, units) {
+		exec.speculateTxUnits(ctx, datas.Txs, units)
+	}
+	receipts, err := execute.execTxUnits(exec, units)
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
+		return
 	}
 	msg.Reply(exec.client.NewMessage("", types.EventReceipts,
 		&types.Receipts{Receipts: receipts}))
@@ -82,7 +82,8 @@ func TestTxGroup(t *testing.T) {
 	//返回新的区块
 	block, err = util.ExecAndCheckBlock(mock33.GetClient(), block, txgroup.GetTxs(), []int{types.ExecOk, types.ExecOk, types.ExecOk})
 	assert.Nil(t, err)
-	assert.Equal(t, mock33.GetAccount(block.StateHash, mock33.GetGenesisAddress()).Balance, int64(9999999899700000))
+	//交易组只收取消耗的gas对应的手续费
+	assert.Equal(t, mock33.GetAccount(block.StateHash, mock33.GetGenesisAddress()).Balance, int64(9999999899952400))
 	assert.Equal(t, mock33.GetAccount(block.StateHash, addr2).Balance, int64(0))
 	assert.Equal(t, mock33.GetAccount(block.StateHash, addr3).Balance, int64(0))
 	assert.Equal(t, mock33.GetAccount(block.StateHash, addr4).Balance, 1*types.Coin)
@@ -481,8 +482,35 @@ func TestExecLocalSameTimeSetErrKey(t *testing.T) {
 		}
 		if i >= 1 {
 			assert.Equal(t, receipt.GetTy(), int32(1))
-			assert.Equal(t, len(receipt.Logs), 2)
+			//手续费，错误以及退还手续费的日志
+			assert.Equal(t, len(receipt.Logs), 3)
 			assert.Equal(t, receipt.Logs[1].Ty, int32(1))
+			assert.Equal(t, receipt.Logs[2].Ty, int32(types.TyLogFee))
 		}
 	}
 }
+
+func TestReplacedTxOnChain(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genkey := mock33.GetGenesisKey()
+	addr, _ := util.Genaddress()
+
+	//被替换的交易还没有发送，替换交易先上链
+	original := util.CreateCoinsTx(cfg, genkey, addr, types.Coin)
+	replace := util.CreateCoinsTx(cfg, genkey, addr, 2*types.Coin)
+	replace.ReplaceHash = original.Hash()
+	replace.Sign(types.SECP256K1, genkey)
+	mock33.SendTx(replace)
+	_, err := mock33.WaitTx(replace.Hash())
+	assert.Nil(t, err)
+
+	//之后收到的被替换交易视为重复交易，不能进入mempool，也不能打包
+	_, err = mock33.GetAPI().SendTx(original)
+	assert.Equal(t, types.ErrDupTx, err)
+	txs, err := util.CheckDupTx(mock33.GetClient(), []*types.Transaction{original}, mock33.GetLastBlock().Height+1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txs))
+}
@@ -0,0 +1,125 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+//gas的消耗规则，gasPrice为每单位gas收取的手续费
+const (
+	gasPrice    = 1
+	gasStateGet = 1000
+	gasStateSet = 5000
+	gasLocalGet = 500
+	gasLocalSet = 2000
+	gasPerByte  = 10
+)
+
+//gasMeter 记录一笔交易或者一个交易组执行时消耗的gas，为nil时不计算gas
+type gasMeter struct {
+	limit int64
+	used  int64
+}
+
+//consume 消耗gas，超过上限时返回ErrOutOfGas
+func (g *gasMeter) consume(gas int64) error {
+	if g == nil {
+		return nil
+	}
+	g.used += gas
+	if g.used > g.limit {
+		return types.ErrOutOfGas
+	}
+	return nil
+}
+
+func (g *gasMeter) exceeded() bool {
+	return g != nil && g.used > g.limit
+}
+
+//getUsed gas不足时消耗的gas为上限
+func (g *gasMeter) getUsed() int64 {
+	if g == nil {
+		return 0
+	}
+	if g.used > g.limit {
+		return g.limit
+	}
+	return g.used
+}
+
+//fee 实际收取的手续费min(txFee, used*gasPrice)，gas不足时used为上限，收取全部手续费
+//不计算gas时收取全部手续费
+func (g *gasMeter) fee(txFee int64) int64 {
+	if g == nil {
+		return txFee
+	}
+	fee := g.getUsed() * gasPrice
+	if fee > txFee {
+		return txFee
+	}
+	return fee
+}
+
+func bytesGas(data ...[]byte) int64 {
+	size := 0
+	for _, d := range data {
+		size += len(d)
+	}
+	return int64(size) * gasPerByte
+}
+
+//receiptGas 收据中写入状态数据库的kv以及日志的gas
+//执行器可能不调用StateDB.Set而直接在收据中返回kv，所以写入的gas只按照收据计算
+func receiptGas(receipt *types.Receipt) int64 {
+	var gas int64
+	for _, kv := range receipt.GetKV() {
+		gas += gasStateSet + bytesGas(kv.Key, kv.Value)
+	}
+	for _, l := range receipt.GetLogs() {
+		gas += bytesGas(l.Log)
+	}
+	return gas
+}
+
+//newGasMeter 开启ForkTxGas并且收取手续费时，交易的手续费按照gasPrice折算成gas的上限，和execFee收取手续费的条件一致
+//execFee先收取全部手续费，执行结束后refundGas退还没有用完的gas对应的手续费
+func (e *executor) newGasMeter(tx *types.Transaction, index int) *gasMeter {
+	types.AssertConfig(e.api)
+	cfg := e.api.GetConfig()
+	if e.height == 0 || !cfg.IsFork(e.height, "ForkTxGas") {
+		return nil
+	}
+	if cfg.IsPara() || cfg.GetMinTxFeeRate() == 0 || e.loadDriver(tx, index).IsFree() {
+		return nil
+	}
+	return &gasMeter{limit: tx.Fee / gasPrice}
+}
+
+//refundGas 实际收取的手续费为min(tx.Fee, gasUsed*gasPrice)，退还多收的部分到交易发起者的账户
+//gas不足时gasUsed为上限，不退还手续费
+func (e *executor) refundGas(receipt *types.Receipt, tx *types.Transaction) {
+	refund := tx.Fee - e.gas.fee(tx.Fee)
+	if refund <= 0 {
+		return
+	}
+	accFrom := e.coinsAccount.LoadAccount(tx.From())
+	copyfrom := *accFrom
+	accFrom.Balance = accFrom.GetBalance() + refund
+	receiptBalance := &types.ReceiptAccountTransfer{Prev: &copyfrom, Current: accFrom}
+	set := e.coinsAccount.GetKVSet(accFrom)
+	e.coinsAccount.SaveKVSet(set)
+	receipt.KV = append(receipt.KV, set...)
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: types.TyLogFee, Log: types.Encode(receiptBalance)})
+}
+
+//setGasMeter 只计算执行器Exec过程中读写数据库的gas
+func (e *executor) setGasMeter(gas *gasMeter) {
+	e.stateDB.(*StateDB).gas = gas
+	if e.localDB != nil {
+		e.localDB.(*LocalDB).gas = gas
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"bytes"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var gasOnce sync.Once
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte("gastest"))
+}
+
+//gasApp 按照交易中指定的大小在收据中返回kv，不调用StateDB.Set
+type gasApp struct {
+	*drivers.DriverBase
+}
+
+func newGasApp() drivers.Driver {
+	app := &gasApp{DriverBase: &drivers.DriverBase{}}
+	app.SetChild(app)
+	return app
+}
+
+func (app *gasApp) GetDriverName() string {
+	return "gastest"
+}
+
+func (app *gasApp) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
+	size, err := strconv.Atoi(string(tx.Payload))
+	if err != nil {
+		return nil, err
+	}
+	kv := &types.KeyValue{Key: []byte("mavl-gastest-" + string(tx.Hash())), Value: bytes.Repeat([]byte("a"), size)}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}}, nil
+}
+
+func createGasTx(t *testing.T, cfg *types.Chain33Config, size int) *types.Transaction {
+	tx := &types.Transaction{Execer: []byte("gastest"), Payload: []byte(strconv.Itoa(size)), To: address.ExecAddress("gastest")}
+	tx, err := types.FormatTx(cfg, "gastest", tx)
+	require.Nil(t, err)
+	return tx
+}
+
+//chargedFee 根据收据中的手续费日志计算实际收取的手续费
+func chargedFee(t *testing.T, receipt *types.ReceiptData) int64 {
+	var fee int64
+	for _, l := range receipt.Logs {
+		if l.Ty != types.TyLogFee {
+			continue
+		}
+		var transfer types.ReceiptAccountTransfer
+		require.Nil(t, types.Decode(l.Log, &transfer))
+		fee += transfer.Prev.Balance - transfer.Current.Balance
+	}
+	return fee
+}
+
+func TestExecGas(t *testing.T) {
+	mock33 := newParallelNode(0)
+	defer mock33.Close()
+	cfg := mock33.GetClient().GetConfig()
+	gasOnce.Do(func() {
+		drivers.Register(cfg, "gastest", newGasApp, 0)
+	})
+	genkey := mock33.GetGenesisKey()
+	genesis := mock33.GetBlock(0)
+
+	addr, _ := util.Genaddress()
+	txs := []*types.Transaction{util.CreateCoinsTx(cfg, genkey, addr, types.Coin), createGasTx(t, cfg, 10), createGasTx(t, cfg, 20000)}
+	for _, tx := range txs[1:] {
+		tx.Sign(types.SECP256K1, genkey)
+	}
+	block := util.CreateNewBlock(cfg, genesis, txs)
+	detail := execParallelBlock(t, mock33, genesis, block)
+	require.Equal(t, 3, len(detail.Receipts))
+
+	//转账和写入少量数据的交易消耗的gas小于手续费，只收取消耗的gas对应的手续费
+	for _, i := range []int{0, 1} {
+		assert.Equal(t, int32(types.ExecOk), detail.Receipts[i].Ty)
+		assert.True(t, detail.Receipts[i].GasUsed > 0)
+		assert.True(t, detail.Receipts[i].GasUsed < txs[i].Fee)
+		assert.Equal(t, detail.Receipts[i].GasUsed, chargedFee(t, detail.Receipts[i]))
+		last := detail.Receipts[i].Logs[len(detail.Receipts[i].Logs)-1]
+		assert.Equal(t, int32(types.TyLogFee), last.Ty)
+	}
+	//收据中的kv即使没有调用StateDB.Set也要消耗写入的gas
+	key := "mavl-gastest-" + string(txs[1].Hash())
+	assert.True(t, detail.Receipts[1].GasUsed >= 5000+int64(len(key)+10)*10)
+
+	//gas不足时交易执行失败，收取全部手续费
+	receipt := detail.Receipts[2]
+	assert.Equal(t, int32(types.ExecPack), receipt.Ty)
+	assert.Equal(t, txs[2].Fee, receipt.GasUsed)
+	assert.Equal(t, txs[2].Fee, chargedFee(t, receipt))
+	require.Equal(t, 2, len(receipt.Logs))
+	assert.Equal(t, int32(types.TyLogFee), receipt.Logs[0].Ty)
+	assert.Equal(t, int32(types.TyLogErr), receipt.Logs[1].Ty)
+	assert.Equal(t, types.ErrOutOfGas.Error(), string(receipt.Logs[1].Log))
+}
@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"strconv"
+
 	"github.com/33cn/chain33/client"
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
@@ -23,6 +25,10 @@ type LocalDB struct {
 	api          client.QueueProtocolAPI
 	disableread  bool
 	disablewrite bool
+	//执行交易时计算读写的gas
+	gas *gasMeter
+	//跟踪交易时记录读写
+	trace *txTracer
 }
 
 //NewLocalDB 创建一个新的LocalDB
@@ -153,6 +159,15 @@ func (l *LocalDB) Get(key []byte) ([]byte, error) {
 	if l.disableread {
 		return nil, types.ErrDisableRead
 	}
+	value, err := l.get(key)
+	l.trace.add("localGet", key, value, errInfo(err))
+	if gasErr := l.gas.consume(gasLocalGet + bytesGas(value)); gasErr != nil {
+		return nil, gasErr
+	}
+	return value, err
+}
+
+func (l *LocalDB) get(key []byte) ([]byte, error) {
 	skey := string(key)
 	if l.intx && l.txcache != nil {
 		if value, ok := l.txcache[skey]; ok {
@@ -188,6 +203,10 @@ func (l *LocalDB) Set(key []byte, value []byte) error {
 	if l.disablewrite {
 		return types.ErrDisableWrite
 	}
+	l.trace.add("localSet", key, value, "")
+	if err := l.gas.consume(gasLocalSet + bytesGas(key, value)); err != nil {
+		return err
+	}
 	skey := string(key)
 	if l.intx {
 		if l.txcache == nil {
@@ -217,6 +236,10 @@ func (l *LocalDB) List(prefix, key []byte, count, direction int32) ([][]byte, er
 		panic(err) //no happen for ever
 	}
 	values := resp.Values
+	l.trace.add("localList", prefix, key, strconv.Itoa(len(values)))
+	if err := l.gas.consume(gasLocalGet + bytesGas(values...)); err != nil {
+		return nil, err
+	}
 	if values == nil {
 		//panic(string(key))
 		return nil, types.ErrNotFound
@@ -0,0 +1,223 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//并行执行要求交易对状态的修改都在receipt中，并且执行时不能读写localdb
+var parallelForks = []string{"ForkExecRollback", "ForkResetTx0", "ForkStateDBSet", "ForkLocalDBAccess", "ForkCacheDriver"}
+
+//txUnit 区块中的一笔交易或者一个交易组
+type txUnit struct {
+	txs []*types.Transaction
+	//交易组的格式错误，不需要执行
+	err error
+	//假设前面的交易都执行成功时交易的index
+	index int
+	//预执行的结果，不能预执行时为nil
+	spec *specResult
+}
+
+//specResult 在独立的状态数据库上预执行交易的结果
+type specResult struct {
+	receipts []*types.Receipt
+	err      error
+	reads    map[string]bool
+}
+
+//splitTxUnits 把区块中的交易按照单笔交易和交易组划分
+func splitTxUnits(cfg *types.Chain33Config, height int64, txs []*types.Transaction) []*txUnit {
+	var units []*txUnit
+	index := 0
+	for i := 0; i < len(txs); i++ {
+		tx := txs[i]
+		//检查groupcount
+		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
+			units = append(units, &txUnit{txs: txs[i : i+1], err: types.ErrTxGroupCount})
+			continue
+		}
+		if tx.GroupCount == 0 {
+			units = append(units, &txUnit{txs: txs[i : i+1], index: index})
+			index++
+			continue
+		}
+		//所有tx.GroupCount > 0 的交易都是错误的交易
+		if !cfg.IsFork(height, "ForkTxGroup") {
+			units = append(units, &txUnit{txs: txs[i : i+1], err: types.ErrTxGroupNotSupport})
+			continue
+		}
+		//判断GroupCount 是否会产生越界
+		if i+int(tx.GroupCount) > len(txs) {
+			units = append(units, &txUnit{txs: txs[i : i+1], err: types.ErrTxGroupCount})
+			continue
+		}
+		units = append(units, &txUnit{txs: txs[i : i+int(tx.GroupCount)], index: index})
+		index += int(tx.GroupCount)
+		i = i + int(tx.GroupCount) - 1
+	}
+	return units
+}
+
+//canCommit 交易的index和预执行时一致，并且读取的key没有被前面的交易修改，预执行的结果和顺序执行一致
+func (unit *txUnit) canCommit(index int, written map[string]bool) bool {
+	if unit.spec == nil || unit.index != index {
+		return false
+	}
+	for key := range unit.spec.reads {
+		if written[key] {
+			return false
+		}
+	}
+	return true
+}
+
+func (exec *Executor) isParallelEnable(cfg *types.Chain33Config, height int64, units []*txUnit) bool {
+	if exec.parallelWorkers < 2 || height == 0 || len(units) < 2 {
+		return false
+	}
+	for _, fork := range parallelForks {
+		if !cfg.IsFork(height, fork) {
+			return false
+		}
+	}
+	return true
+}
+
+//speculateTxUnits 多个线程预执行交易，每个交易使用独立的状态数据库，记录读取的key
+func (exec *Executor) speculateTxUnits(ctx *executorCtx, txs []*types.Transaction, units []*txUnit) {
+	workers := exec.parallelWorkers
+	if workers > len(units) {
+		workers = len(units)
+	}
+	ch := make(chan *txUnit)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var localdb dbm.KVDB
+			if !exec.disableLocal {
+				localdb = NewLocalDB(exec.client)
+				defer localdb.(*LocalDB).Close()
+			}
+			for unit := range ch {
+				unit.spec = exec.speculateTxUnit(ctx, localdb, txs, unit)
+			}
+		}()
+	}
+	for _, unit := range units {
+		if unit.err == nil {
+			ch <- unit
+		}
+	}
+	close(ch)
+	wg.Wait()
+}
+
+func (exec *Executor) speculateTxUnit(ctx *executorCtx, localdb dbm.KVDB, txs []*types.Transaction, unit *txUnit) (spec *specResult) {
+	//预执行失败时按照顺序重新执行
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Debug("speculateTxUnit", "err", r)
+			spec = nil
+		}
+	}()
+	execute := newExecutor(ctx, exec, localdb, txs, nil)
+	execute.enableMVCC(nil)
+	stateDB := execute.stateDB.(*StateDB)
+	stateDB.trackReads()
+	//执行时会读写localdb的交易只能顺序执行
+	for i, tx := range unit.txs {
+		if execute.isExecLocalSameTime(tx, unit.index+i) {
+			return nil
+		}
+	}
+	receipts, err := execute.execTxUnit(exec, unit.txs, unit.index)
+	if api.IsAPIEnvError(err) {
+		return nil
+	}
+	//删除key时状态数据库的缓存和顺序执行不一致
+	for _, receipt := range receipts {
+		for _, kv := range receipt.KV {
+			if kv.Value == nil {
+				return nil
+			}
+		}
+	}
+	return &specResult{receipts: receipts, err: err, reads: stateDB.getReadKeys()}
+}
+
+//execTxUnit 执行单笔交易或者交易组
+func (e *executor) execTxUnit(exec *Executor, txs []*types.Transaction, index int) ([]*types.Receipt, error) {
+	if txs[0].GroupCount == 0 {
+		receipt, err := e.execTx(exec, txs[0], index)
+		if err != nil {
+			return nil, err
+		}
+		return []*types.Receipt{receipt}, nil
+	}
+	receiptlist, err := e.execTxGroup(txs, index)
+	if len(receiptlist) > 0 && len(receiptlist) != len(txs) {
+		panic("len(receiptlist) must be equal tx.GroupCount")
+	}
+	return receiptlist, err
+}
+
+//execTxUnits 按照区块中的顺序提交交易，预执行的结果有冲突时重新执行
+func (e *executor) execTxUnits(exec *Executor, units []*txUnit) ([]*types.Receipt, error) {
+	var receipts []*types.Receipt
+	written := make(map[string]bool)
+	index := 0
+	for _, unit := range units {
+		if unit.err != nil {
+			receipts = append(receipts, types.NewErrReceipt(unit.err))
+			continue
+		}
+		var receiptlist []*types.Receipt
+		var err error
+		if unit.canCommit(index, written) {
+			receiptlist, err = unit.spec.receipts, unit.spec.err
+			if err == nil {
+				e.applyReceipts(receiptlist)
+			}
+		} else {
+			receiptlist, err = e.execTxUnit(exec, unit.txs, index)
+			if api.IsAPIEnvError(err) {
+				return nil, err
+			}
+		}
+		if err != nil {
+			for range unit.txs {
+				receipts = append(receipts, types.NewErrReceipt(err))
+			}
+			continue
+		}
+		for _, receipt := range receiptlist {
+			for _, kv := range receipt.KV {
+				written[string(kv.Key)] = true
+			}
+		}
+		receipts = append(receipts, receiptlist...)
+		index += len(unit.txs)
+	}
+	return receipts, nil
+}
+
+//applyReceipts 把预执行的结果写入状态数据库
+func (e *executor) applyReceipts(receipts []*types.Receipt) {
+	for _, receipt := range receipts {
+		for _, kv := range receipt.KV {
+			if err := e.stateDB.Set(kv.Key, kv.Value); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newParallelNode(workers int32) *testnode.Chain33Mock {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	cfg.GetModuleConfig().Exec.ParallelWorkers = workers
+	cfg.SetMinFee(100000)
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	mock33.WaitHeight(0)
+	return mock33
+}
+
+func execParallelBlock(t *testing.T, mock33 *testnode.Chain33Mock, prev, block *types.Block) *types.BlockDetail {
+	detail, _, err := util.ExecBlock(mock33.GetClient(), prev.StateHash, proto.Clone(block).(*types.Block), false, true, false)
+	require.Nil(t, err)
+	return detail
+}
+
+func TestParallelExecBlock(t *testing.T) {
+	seq := newParallelNode(0)
+	cfg := seq.GetClient().GetConfig()
+	genkey := seq.GetGenesisKey()
+	genesis := seq.GetBlock(0)
+
+	//第一个区块: 给多个账户转账，交易的发送者相同，全部冲突
+	var privs []crypto.PrivKey
+	var txs []*types.Transaction
+	for i := 0; i < 20; i++ {
+		addr, priv := util.Genaddress()
+		privs = append(privs, priv)
+		txs = append(txs, util.CreateCoinsTx(cfg, genkey, addr, 10*types.Coin))
+	}
+	block1 := util.CreateNewBlock(cfg, genesis, txs)
+	detail1 := execParallelBlock(t, seq, genesis, block1)
+
+	//第二个区块: 互不冲突的转账，冲突的转账，执行失败的交易以及交易组
+	txs = nil
+	for _, priv := range privs[:16] {
+		addr, _ := util.Genaddress()
+		txs = append(txs, util.CreateCoinsTx(cfg, priv, addr, types.Coin))
+	}
+	addr1, _ := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	for i := 0; i < 2; i++ {
+		txs = append(txs, util.CreateCoinsTx(cfg, genkey, addr1, types.Coin))
+		txs = append(txs, util.CreateCoinsTx(cfg, genkey, addr2, types.Coin))
+	}
+	_, nobalance := util.Genaddress()
+	txs = append(txs, util.CreateCoinsTx(cfg, nobalance, addr1, types.Coin))
+	var group []*types.Transaction
+	for _, priv := range privs[16:] {
+		group = append(group, util.CreateCoinsTx(cfg, priv, addr1, types.Coin))
+	}
+	txgroup, err := types.CreateTxGroup(group, cfg.GetMinTxFeeRate())
+	require.Nil(t, err)
+	for i, priv := range privs[16:] {
+		txgroup.SignN(i, types.SECP256K1, priv)
+	}
+	txs = append(txs, txgroup.GetTxs()...)
+	block2 := util.CreateNewBlock(cfg, detail1.Block, txs)
+	detail2 := execParallelBlock(t, seq, detail1.Block, block2)
+	seq.Close()
+
+	par := newParallelNode(8)
+	defer par.Close()
+	pdetail1 := execParallelBlock(t, par, genesis, block1)
+	pdetail2 := execParallelBlock(t, par, pdetail1.Block, block2)
+	for _, pair := range [][2]*types.BlockDetail{{detail1, pdetail1}, {detail2, pdetail2}} {
+		assert.Equal(t, pair[0].Block.StateHash, pair[1].Block.StateHash)
+		require.Equal(t, len(pair[0].Receipts), len(pair[1].Receipts))
+		for i := range pair[0].Receipts {
+			assert.Equal(t, types.Encode(pair[0].Receipts[i]), types.Encode(pair[1].Receipts[i]))
+		}
+	}
+	assert.Equal(t, len(block2.Txs)-1, len(pdetail2.Block.Txs))
+}
@@ -65,6 +65,10 @@ func getTx(executor *executor, tx *types.Transaction, receipt *types.ReceiptData
 	if cfg.IsEnable("quickIndex") {
 		kvlist = append(kvlist, &types.KeyValue{Key: types.CalcTxShortKey(txhash), Value: []byte("1")})
 	}
+	//替换交易上链之后，被替换的交易不能再上链
+	if len(tx.ReplaceHash) != 0 && cfg.IsFork(executor.height, "ForkTxReplace") {
+		kvlist = append(kvlist, &types.KeyValue{Key: types.CalcTxReplacedKey(tx.ReplaceHash), Value: txhash})
+	}
 	return kvlist
 }
 
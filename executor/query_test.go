@@ -0,0 +1,108 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"sync"
+	"testing"
+
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var queryOnce sync.Once
+
+//stateQueryApp 查询状态数据库中的账户
+type stateQueryApp struct {
+	*drivers.DriverBase
+}
+
+func newStateQueryApp() drivers.Driver {
+	app := &stateQueryApp{DriverBase: &drivers.DriverBase{}}
+	app.SetChild(app)
+	return app
+}
+
+func (app *stateQueryApp) GetDriverName() string {
+	return "statequery"
+}
+
+func (app *stateQueryApp) Query(funcName string, params []byte) (types.Message, error) {
+	if funcName == "Local" {
+		_, err := app.GetLocalDB().Get(params)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		return &types.Reply{IsOk: true}, nil
+	}
+	value, err := app.GetStateDB().Get(params)
+	if err != nil {
+		return nil, err
+	}
+	var acc types.Account
+	err = types.Decode(value, &acc)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func TestQueryHistory(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	queryOnce.Do(func() {
+		drivers.Register(cfg, "statequery", newStateQueryApp, 0)
+	})
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	api := mock33.GetAPI()
+	addr, _ := util.Genaddress()
+	for i := 0; i < 2; i++ {
+		tx := util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), addr, types.Coin)
+		mock33.SendTx(tx)
+		_, err := mock33.WaitTx(tx.Hash())
+		require.Nil(t, err)
+	}
+	last := mock33.GetLastBlock()
+	prev := mock33.GetBlock(last.Height - 1)
+	key := []byte("mavl-coins-bty-" + addr)
+	query := func(funcName string, height int64, stateHash []byte) (int64, error) {
+		msg, err := api.QueryChain(&types.ChainExecutor{Driver: "statequery", FuncName: funcName, Param: key, Height: height, StateHash: stateHash})
+		if err != nil {
+			return 0, err
+		}
+		if acc, ok := msg.(*types.Account); ok {
+			return acc.Balance, nil
+		}
+		return 0, nil
+	}
+	balance, err := query("Get", 0, nil)
+	require.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, balance)
+	balance, err = query("Get", 0, last.StateHash)
+	require.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, balance)
+	balance, err = query("Get", prev.Height, nil)
+	require.Nil(t, err)
+	assert.Equal(t, types.Coin, balance)
+	_, err = query("Get", last.Height+1, nil)
+	assert.Equal(t, types.ErrHeightNotExist, err)
+	//高度和状态哈希不能同时指定
+	_, err = query("Get", prev.Height, prev.StateHash)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	//没有开启mvcc时无法根据状态哈希找到历史区块
+	_, err = query("Get", 0, prev.StateHash)
+	assert.Equal(t, types.ErrNotSupport, err)
+
+	//历史状态上不能读取本地数据库
+	_, err = query("Local", 0, nil)
+	assert.Nil(t, err)
+	_, err = query("Local", prev.Height, nil)
+	assert.Equal(t, types.ErrDisableRead, err)
+}
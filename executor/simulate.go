@@ -0,0 +1,128 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+func (exec *Executor) procSimulateTx(msg *queue.Message) {
+	//panic 处理
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Error("panic error", "err", r)
+			msg.Reply(exec.client.NewMessage("", types.EventSimulateTx, types.ErrExecPanic))
+			return
+		}
+	}()
+	reply, err := exec.simulateTx(msg.GetData().(*types.ReqSimulateTx))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventSimulateTx, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventSimulateTx, reply))
+}
+
+//simulateTx 按照打包到指定状态的下一个区块中的环境执行交易，状态数据库和本地数据库的修改都只保存在缓存中
+//本地数据库只有最新的数据，和procExecQuery一样，在历史状态上执行时禁止读取本地数据库，依赖本地数据库的执行返回ErrDisableRead
+func (exec *Executor) simulateTx(req *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	tx := req.GetTx()
+	if tx == nil {
+		return nil, types.ErrInvalidParam
+	}
+	//交易组需要和组内的其他交易一起执行
+	if tx.GroupCount != 0 {
+		return nil, types.ErrNotSupport
+	}
+	last, err := exec.qclient.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	header, err := exec.stateHeader(last, req.GetHeight(), req.GetStateHash())
+	if err != nil {
+		return nil, err
+	}
+	//在历史状态上执行时，区块时间使用该状态所在区块的时间
+	history := header != last
+	blocktime := types.Now().Unix()
+	if history {
+		blocktime = header.GetBlockTime()
+	}
+	ctx := &executorCtx{
+		stateHash:  header.GetStateHash(),
+		height:     header.GetHeight() + 1,
+		blocktime:  blocktime,
+		difficulty: uint64(header.GetDifficulty()),
+		parentHash: header.GetHash(),
+	}
+	var localdb dbm.KVDB
+	if !exec.disableLocal {
+		localdb = NewLocalDB(exec.client)
+		defer localdb.(*LocalDB).Close()
+		if history {
+			localdb.(*LocalDB).DisableRead()
+		}
+	}
+	txs := []*types.Transaction{tx}
+	execute := newExecutor(ctx, exec, localdb, txs, nil)
+	execute.enableMVCC(nil)
+	reply := &types.ReplySimulateTx{}
+	if err := execute.execCheckTx(tx, 0); err != nil {
+		reply.Error = err.Error()
+		return reply, nil
+	}
+	receipt, err := execute.execTx(exec, tx, 0)
+	if api.IsAPIEnvError(err) {
+		return nil, err
+	}
+	if err != nil {
+		reply.Error = err.Error()
+		return reply, nil
+	}
+	reply.Receipt = receipt
+	for _, l := range receipt.Logs {
+		if l.Ty == types.TyLogFee {
+			reply.Fee = execute.gas.fee(tx.Fee)
+		} else if l.Ty == types.TyLogErr {
+			reply.Error = string(l.Log)
+		}
+	}
+	if receipt.Ty != types.ExecOk || localdb == nil {
+		return reply, nil
+	}
+	localKV, err := exec.simulateTxLocal(ctx, tx, receipt, history)
+	if err != nil {
+		reply.Error = err.Error()
+		return reply, nil
+	}
+	reply.LocalKV = localKV
+	return reply, nil
+}
+
+//simulateTxLocal 和区块执行之后一样，在新的本地数据库上执行ExecLocal，history为true时禁止读取本地数据库
+func (exec *Executor) simulateTxLocal(ctx *executorCtx, tx *types.Transaction, receipt *types.Receipt, history bool) ([]*types.KeyValue, error) {
+	localdb := NewLocalDB(exec.client)
+	defer localdb.(*LocalDB).Close()
+	if history {
+		localdb.(*LocalDB).DisableRead()
+	}
+	rdata := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs, GasUsed: receipt.GasUsed}
+	execute := newExecutor(ctx, exec, localdb, []*types.Transaction{tx}, []*types.ReceiptData{rdata})
+	execute.enableMVCC(nil)
+	for _, kv := range receipt.KV {
+		if err := execute.stateDB.Set(kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+	execute.localDB.(*LocalDB).StartTx()
+	kvset, err := execute.execLocalTx(tx, rdata, 0)
+	if err != nil {
+		return nil, err
+	}
+	return kvset.GetKV(), nil
+}
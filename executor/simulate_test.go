@@ -0,0 +1,112 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateTransaction(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	cfg := mock33.GetClient().GetConfig()
+	prev := cfg.GetMinTxFeeRate()
+	cfg.SetMinFee(100000)
+	defer cfg.SetMinFee(prev)
+	mock33.WaitHeight(0)
+	block := mock33.GetBlock(0)
+	genaddr := mock33.GetGenesisAddress()
+	api := mock33.GetAPI()
+
+	addr, priv := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), addr, types.Coin)
+	reply, err := api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx})
+	require.Nil(t, err)
+	assert.Equal(t, "", reply.Error)
+	//只收取消耗的gas对应的手续费
+	assert.True(t, reply.Fee > 0 && reply.Fee < tx.Fee)
+	require.NotNil(t, reply.Receipt)
+	assert.Equal(t, int32(types.ExecOk), reply.Receipt.Ty)
+	assert.True(t, len(reply.Receipt.KV) > 0)
+	assert.Equal(t, 1, len(reply.LocalKV))
+	//模拟执行不修改状态
+	assert.Equal(t, 100000000*types.Coin, mock33.GetAccount(block.StateHash, genaddr).Balance)
+	assert.Equal(t, int64(0), mock33.GetAccount(block.StateHash, addr).Balance)
+
+	//指定状态哈希
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, StateHash: block.StateHash})
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), reply.Receipt.Ty)
+
+	//余额不足
+	tx = util.CreateCoinsTx(cfg, priv, genaddr, types.Coin)
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx})
+	require.Nil(t, err)
+	assert.Equal(t, types.ErrNoBalance.Error(), reply.Error)
+	assert.Nil(t, reply.Receipt)
+
+	_, err = api.SimulateTransaction(&types.ReqSimulateTx{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestSimulateTransactionHistory(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	api := mock33.GetAPI()
+
+	addr, priv := util.Genaddress()
+	for i := 0; i < 2; i++ {
+		tx := util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), addr, types.Coin)
+		mock33.SendTx(tx)
+		_, err := mock33.WaitTx(tx.Hash())
+		require.Nil(t, err)
+	}
+	last := mock33.GetLastBlock()
+	prev := mock33.GetBlock(last.Height - 1)
+
+	//最新的状态上余额足够，前一个区块的状态上余额不足
+	tx := util.CreateCoinsTx(cfg, priv, mock33.GetGenesisAddress(), types.Coin+types.Coin/2)
+	reply, err := api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx})
+	require.Nil(t, err)
+	assert.Equal(t, "", reply.Error)
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, StateHash: last.StateHash})
+	require.Nil(t, err)
+	assert.Equal(t, "", reply.Error)
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Height: prev.Height})
+	require.Nil(t, err)
+	assert.Equal(t, types.ErrNoBalance.Error(), reply.Error)
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Height: prev.Height, StateHash: prev.StateHash})
+	require.Nil(t, err)
+	assert.Equal(t, types.ErrNoBalance.Error(), reply.Error)
+
+	//本地数据库只有最新的数据，在历史状态上执行时禁止读取，demo2在Exec中读取本地数据库
+	runonce.Do(func() {
+		drivers.Register(cfg, "demo2", newdemoApp, 1)
+	})
+	reply, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: util.CreateTxWithExecer(cfg, priv, "demo2"), Height: prev.Height})
+	require.Nil(t, err)
+	require.NotNil(t, reply.Receipt)
+	assert.Equal(t, int32(types.ExecPack), reply.Receipt.Ty)
+	assert.Equal(t, types.ErrDisableRead.Error(), reply.Error)
+	assert.Nil(t, reply.LocalKV)
+
+	//状态哈希和高度不匹配
+	_, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Height: prev.Height, StateHash: last.StateHash})
+	assert.Equal(t, types.ErrCheckStateHash, err)
+	//没有开启mvcc时无法根据状态哈希找到历史区块
+	_, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, StateHash: prev.StateHash})
+	assert.Equal(t, types.ErrNotSupport, err)
+	_, err = api.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Height: last.Height + 1})
+	assert.Equal(t, types.ErrHeightNotExist, err)
+}
@@ -24,6 +24,12 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时记录读取的key，用于检测交易之间的冲突
+	readKeys map[string]bool
+	//执行交易时计算读取的gas，写入的gas按照收据中的kv计算
+	gas *gasMeter
+	//跟踪交易时记录读写
+	trace *txTracer
 }
 
 // StateDBOption state db option enable mvcc
@@ -108,11 +114,18 @@ func (s *StateDB) resetTx() {
 func (s *StateDB) Get(key []byte) ([]byte, error) {
 	v, err := s.get(key)
 	debugAccount("==get==", key, v)
+	s.trace.add("stateGet", key, v, errInfo(err))
+	if gasErr := s.gas.consume(gasStateGet + bytesGas(v)); gasErr != nil {
+		return nil, gasErr
+	}
 	return v, err
 }
 
 func (s *StateDB) get(key []byte) ([]byte, error) {
 	skey := string(key)
+	if s.readKeys != nil {
+		s.readKeys[skey] = true
+	}
 	if s.intx && s.txcache != nil {
 		if value, ok := s.txcache[skey]; ok {
 			return value, nil
@@ -167,6 +180,16 @@ func debugAccount(prefix string, key []byte, value []byte) {
 	*/
 }
 
+//trackReads 开始记录读取的key
+func (s *StateDB) trackReads() {
+	s.readKeys = make(map[string]bool)
+}
+
+//getReadKeys 获取读取过的key，包括不存在的key
+func (s *StateDB) getReadKeys() map[string]bool {
+	return s.readKeys
+}
+
 // StartTx reset state db keys
 func (s *StateDB) StartTx() {
 	s.keys = nil
@@ -180,6 +203,7 @@ func (s *StateDB) GetSetKeys() (keys []string) {
 // Set set key value to state db
 func (s *StateDB) Set(key []byte, value []byte) error {
 	debugAccount("==set==", key, value)
+	s.trace.add("stateSet", key, value, "")
 	skey := string(key)
 	if s.intx {
 		if s.txcache == nil {
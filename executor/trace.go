@@ -0,0 +1,158 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+//txTracer 记录交易执行过程中的数据库读写以及执行路径，为nil时不记录
+type txTracer struct {
+	ops []*types.TraceOp
+}
+
+func (t *txTracer) add(ty string, key, value []byte, info string) {
+	if t == nil {
+		return
+	}
+	//执行器可能重复使用key和value的内存，需要复制
+	key = append([]byte(nil), key...)
+	value = append([]byte(nil), value...)
+	t.ops = append(t.ops, &types.TraceOp{Ty: ty, Key: key, Value: value, Info: info})
+}
+
+func errInfo(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+//execFuncName 和DriverBase.Exec一样根据payload找到执行的函数，没有对应的Exec_函数时为Exec
+func execFuncName(exec drivers.Driver, tx *types.Transaction) string {
+	ety := exec.GetExecutorType()
+	if ety == nil || exec.GetPayloadValue() == nil {
+		return "Exec"
+	}
+	name, _, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return "Exec"
+	}
+	funcname := "Exec_" + name
+	if _, ok := exec.GetFuncMap()[funcname]; !ok {
+		return "Exec"
+	}
+	return funcname
+}
+
+//setTracer 跟踪交易时记录状态数据库和本地数据库的读写
+func (e *executor) setTracer(trace *txTracer) {
+	e.trace = trace
+	e.stateDB.(*StateDB).trace = trace
+	if e.localDB != nil {
+		e.localDB.(*LocalDB).trace = trace
+	}
+}
+
+func (exec *Executor) procTraceTx(msg *queue.Message) {
+	//panic 处理
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Error("panic error", "err", r)
+			msg.Reply(exec.client.NewMessage("", types.EventTraceTx, types.ErrExecPanic))
+			return
+		}
+	}()
+	reply, err := exec.traceTx(msg.GetData().(*types.ReqHash))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventTraceTx, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventTraceTx, reply))
+}
+
+//traceTx 在前一个区块的状态上按顺序重新执行区块中的交易，只跟踪指定的交易或者交易所在的交易组
+//本地数据库使用的是当前的数据，执行器在ExecLocalSameTime时读取的本地数据可能和打包时不同
+func (exec *Executor) traceTx(req *types.ReqHash) (*types.ReplyTraceTx, error) {
+	if req == nil || len(req.Hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	detail, err := exec.qclient.QueryTx(&types.ReqHash{Hash: req.Hash})
+	if err != nil {
+		return nil, err
+	}
+	//创世区块的交易不检查手续费，不需要跟踪
+	if detail.GetHeight() <= 0 {
+		return nil, types.ErrNotSupport
+	}
+	blocks, err := exec.qclient.GetBlocks(&types.ReqBlocks{Start: detail.Height, End: detail.Height})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.GetItems()) != 1 || blocks.Items[0].GetBlock() == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	block := blocks.Items[0].Block
+	last, err := exec.qclient.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	//前一个区块可能是创世区块，不能使用queryHeader，高度为0时会返回最新的区块
+	prev, err := exec.getHeader(last, detail.Height-1)
+	if err != nil {
+		return nil, err
+	}
+	ctx := &executorCtx{
+		stateHash:  prev.GetStateHash(),
+		height:     block.Height,
+		blocktime:  block.BlockTime,
+		difficulty: uint64(block.Difficulty),
+		mainHash:   block.MainHash,
+		mainHeight: block.MainHeight,
+		parentHash: block.ParentHash,
+	}
+	var localdb dbm.KVDB
+	if !exec.disableLocal {
+		localdb = NewLocalDB(exec.client)
+		defer localdb.(*LocalDB).Close()
+	}
+	execute := newExecutor(ctx, exec, localdb, block.Txs, nil)
+	execute.enableMVCC(nil)
+	types.AssertConfig(exec.client)
+	units := splitTxUnits(exec.client.GetConfig(), block.Height, block.Txs)
+	index := 0
+	for _, unit := range units {
+		if unit.err != nil {
+			continue
+		}
+		if detail.Index >= int64(index+len(unit.txs)) {
+			if _, err := execute.execTxUnit(exec, unit.txs, index); api.IsAPIEnvError(err) {
+				return nil, err
+			}
+			index += len(unit.txs)
+			continue
+		}
+		trace := &txTracer{}
+		execute.setTracer(trace)
+		receipts, err := execute.execTxUnit(exec, unit.txs, index)
+		execute.setTracer(nil)
+		if api.IsAPIEnvError(err) {
+			return nil, err
+		}
+		if err != nil {
+			trace.add("error", nil, nil, err.Error())
+		}
+		reply := &types.ReplyTraceTx{Ops: trace.ops, Tx: detail.Tx}
+		if i := int(detail.Index) - index; i < len(receipts) {
+			reply.Receipt = receipts[i]
+		}
+		return reply, nil
+	}
+	return nil, types.ErrTxNotExist
+}
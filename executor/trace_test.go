@@ -0,0 +1,80 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func traceOpTypes(ops []*types.TraceOp) map[string][]*types.TraceOp {
+	tys := make(map[string][]*types.TraceOp)
+	for _, op := range ops {
+		tys[op.Ty] = append(tys[op.Ty], op)
+	}
+	return tys
+}
+
+func TestTraceTransaction(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	api := mock33.GetAPI()
+
+	addr, priv := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), addr, types.Coin)
+	mock33.SendTx(tx)
+	detail, err := mock33.WaitTx(tx.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	reply, err := api.TraceTransaction(&types.ReqHash{Hash: tx.Hash()})
+	require.Nil(t, err)
+	assert.Equal(t, tx.Hash(), reply.Tx.Hash())
+	require.NotNil(t, reply.Receipt)
+	assert.Equal(t, detail.Receipt.Ty, reply.Receipt.Ty)
+	assert.Equal(t, len(detail.Receipt.Logs), len(reply.Receipt.Logs))
+	ops := traceOpTypes(reply.Ops)
+	require.Equal(t, 1, len(ops["fee"]))
+	require.Equal(t, 1, len(ops["exec"]))
+	assert.Equal(t, "Exec_Transfer", ops["exec"][0].Info)
+	assert.True(t, len(ops["stateGet"]) > 0)
+	assert.True(t, len(ops["stateSet"]) > 0)
+	for _, op := range ops["allow"] {
+		assert.Equal(t, "true", op.Info)
+	}
+	assert.Equal(t, 1, len(ops["commit"]))
+	assert.Equal(t, 0, len(ops["rollback"]))
+	//在创世区块的状态上执行，第一次读取时接收地址的账户还不存在
+	for _, op := range ops["stateGet"] {
+		if string(op.Key) == "mavl-coins-bty-"+addr {
+			assert.Equal(t, types.ErrNotFound.Error(), op.Info)
+			break
+		}
+	}
+
+	//余额足够支付手续费但是不够转账，交易执行失败并回滚
+	tx = util.CreateCoinsTx(cfg, priv, mock33.GetGenesisAddress(), 2*types.Coin)
+	mock33.SendTx(tx)
+	detail, err = mock33.WaitTx(tx.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	reply, err = api.TraceTransaction(&types.ReqHash{Hash: tx.Hash()})
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), reply.Receipt.Ty)
+	ops = traceOpTypes(reply.Ops)
+	require.Equal(t, 1, len(ops["rollback"]))
+	assert.Equal(t, types.ErrNoBalance.Error(), ops["rollback"][0].Info)
+	assert.Equal(t, 0, len(ops["commit"]))
+
+	_, err = api.TraceTransaction(&types.ReqHash{Hash: []byte("notexist")})
+	assert.NotNil(t, err)
+}
@@ -291,17 +291,19 @@ func (c Comm) GrpcConfig() grpc.ServiceConfig {
 	var defaulttimeout = 20 * time.Second
 
 	var MethodConf = map[string]grpc.MethodConfig{
-		"/types.p2pgservice/Ping":            {Timeout: &defaulttimeout},
-		"/types.p2pgservice/Version2":        {Timeout: &defaulttimeout},
-		"/types.p2pgservice/BroadCastTx":     {Timeout: &defaulttimeout},
-		"/types.p2pgservice/GetMemPool":      {Timeout: &defaulttimeout},
-		"/types.p2pgservice/GetBlocks":       {Timeout: &defaulttimeout},
-		"/types.p2pgservice/GetPeerInfo":     {Timeout: &defaulttimeout},
-		"/types.p2pgservice/BroadCastBlock":  {Timeout: &defaulttimeout},
-		"/types.p2pgservice/GetAddr":         {Timeout: &defaulttimeout},
-		"/types.p2pgservice/GetHeaders":      {Timeout: &defaulttimeout},
-		"/types.p2pgservice/RemotePeerAddr":  {Timeout: &defaulttimeout},
-		"/types.p2pgservice/RemotePeerNatOk": {Timeout: &defaulttimeout},
+		"/types.p2pgservice/Ping":             {Timeout: &defaulttimeout},
+		"/types.p2pgservice/Version2":         {Timeout: &defaulttimeout},
+		"/types.p2pgservice/BroadCastTx":      {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetMemPool":       {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetMempoolSketch": {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetMempoolTxs":    {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetBlocks":        {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetPeerInfo":      {Timeout: &defaulttimeout},
+		"/types.p2pgservice/BroadCastBlock":   {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetAddr":          {Timeout: &defaulttimeout},
+		"/types.p2pgservice/GetHeaders":       {Timeout: &defaulttimeout},
+		"/types.p2pgservice/RemotePeerAddr":   {Timeout: &defaulttimeout},
+		"/types.p2pgservice/RemotePeerNatOk":  {Timeout: &defaulttimeout},
 	}
 
 	return grpc.ServiceConfig{Methods: MethodConf}
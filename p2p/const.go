@@ -25,6 +25,7 @@ var (
 	CheckActivePeersInterVal    = 5 * time.Second
 	CheckBlackListInterVal      = 30 * time.Second
 	CheckCfgSeedsInterVal       = 1 * time.Minute
+	MempoolSyncInterval         = 1 * time.Minute
 )
 
 const (
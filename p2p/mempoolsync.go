@@ -0,0 +1,306 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mempoolSketchBuckets      = 256
+	maxMempoolSyncTxs         = 2000             //每次同步最多获取的交易数目
+	mempoolSketchCacheTime    = 10 * time.Second //本地mempool摘要的缓存时间
+	mempoolSketchPeerInterval = 10 * time.Second //同一个节点请求摘要的最小间隔
+)
+
+//shortHashValue 短哈希为交易哈希前5个字节的十六进制，返回所在的区间(第一个字节)以及数值
+func shortHashValue(shash string) (int, uint64, bool) {
+	if len(shash) != 10 {
+		return 0, 0, false
+	}
+	v, err := strconv.ParseUint(shash, 16, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return int(v >> 32), v, true
+}
+
+//newMempoolSketch 按照短哈希的第一个字节分成256个区间，计算每个区间的交易数目和短哈希的异或值
+func newMempoolSketch(shortHashes []string) *types.P2PMempoolSketch {
+	sketch := &types.P2PMempoolSketch{
+		Counts: make([]uint32, mempoolSketchBuckets),
+		Xors:   make([]uint64, mempoolSketchBuckets),
+	}
+	for _, shash := range shortHashes {
+		bucket, v, ok := shortHashValue(shash)
+		if !ok {
+			continue
+		}
+		sketch.Counts[bucket]++
+		sketch.Xors[bucket] ^= v
+	}
+	return sketch
+}
+
+//diffMempoolSketch 返回本地摘要和远程摘要不一致的区间中本地交易的短哈希，远程节点可能缺少这些交易
+//local为shortHashes的摘要，摘要格式不正确时认为所有的区间都不一致
+func diffMempoolSketch(remote, local *types.P2PMempoolSketch, shortHashes []string, max int) []string {
+	valid := len(remote.GetCounts()) == mempoolSketchBuckets && len(remote.GetXors()) == mempoolSketchBuckets
+	var diff []string
+	for _, shash := range shortHashes {
+		if len(diff) >= max {
+			break
+		}
+		bucket, _, ok := shortHashValue(shash)
+		if !ok {
+			continue
+		}
+		if valid && local.Counts[bucket] == remote.Counts[bucket] && local.Xors[bucket] == remote.Xors[bucket] {
+			continue
+		}
+		diff = append(diff, shash)
+	}
+	return diff
+}
+
+//mempoolSketchCache 缓存本地mempool的短哈希和摘要，远程节点请求摘要时不用每次都复制整个mempool
+//同时限制每个节点请求摘要的频率
+type mempoolSketchCache struct {
+	mtx         sync.Mutex
+	timestamp   time.Time
+	shortHashes []string
+	sketch      *types.P2PMempoolSketch
+
+	pmtx  sync.Mutex
+	peers map[string]time.Time
+}
+
+func newMempoolSketchCache() *mempoolSketchCache {
+	return &mempoolSketchCache{peers: make(map[string]time.Time)}
+}
+
+//allow 同一个节点在mempoolSketchPeerInterval内只能请求一次摘要，同时清理过期的请求记录
+func (c *mempoolSketchCache) allow(peer string, now time.Time) bool {
+	c.pmtx.Lock()
+	defer c.pmtx.Unlock()
+	for p, t := range c.peers {
+		if now.Sub(t) >= mempoolSketchPeerInterval {
+			delete(c.peers, p)
+		}
+	}
+	if _, ok := c.peers[peer]; ok {
+		return false
+	}
+	c.peers[peer] = now
+	return true
+}
+
+//load 缓存过期之后才重新获取本地的mempool，并发的请求只获取一次
+func (c *mempoolSketchCache) load(now time.Time, loadMempool func() (map[string]*types.Transaction, error)) ([]string, *types.P2PMempoolSketch, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.sketch != nil && now.Sub(c.timestamp) < mempoolSketchCacheTime {
+		return c.shortHashes, c.sketch, nil
+	}
+	memtx, err := loadMempool()
+	if err != nil {
+		return nil, nil, err
+	}
+	c.shortHashes = mempoolShortHashes(memtx)
+	c.sketch = newMempoolSketch(c.shortHashes)
+	c.timestamp = now
+	return c.shortHashes, c.sketch, nil
+}
+
+//mempoolShortHashes 计算交易的短哈希
+func mempoolShortHashes(txs map[string]*types.Transaction) []string {
+	shortHashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		shortHashes = append(shortHashes, types.CalcTxShortHash(tx.Hash()))
+	}
+	return shortHashes
+}
+
+//loadMempool 获取本地mempool中的所有交易
+func (n *Node) loadMempool() (map[string]*types.Transaction, error) {
+	var txmap = make(map[string]*types.Transaction)
+	client := n.nodeInfo.client
+	msg := client.NewMessage("mempool", types.EventGetMempool, nil)
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("loadMempool", "Error", err.Error())
+		return txmap, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return txmap, err
+	}
+
+	txlist := resp.GetData().(*types.ReplyTxList)
+	txs := txlist.GetTxs()
+
+	for _, tx := range txs {
+		txmap[hex.EncodeToString(tx.Hash())] = tx
+	}
+	return txmap, nil
+}
+
+//fetchMempoolTxs 和远程节点对比mempool的摘要，只获取本地缺少的交易
+func (n *Node) fetchMempoolTxs(peer *Peer, local map[string]*types.Transaction) ([]*types.Transaction, error) {
+	shortHashes := mempoolShortHashes(local)
+	known := make(map[string]bool, len(shortHashes))
+	for _, shash := range shortHashes {
+		known[shash] = true
+	}
+	sketch := newMempoolSketch(shortHashes)
+	sketch.Version = n.nodeInfo.channelVersion
+	diff, err := peer.mconn.gcli.GetMempoolSketch(context.Background(), sketch, grpc.FailFast(true))
+	collectMempoolSyncStat(err, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.P2PGetMempoolTxs{Version: n.nodeInfo.channelVersion}
+	requested := make(map[string]bool)
+	for _, shash := range diff.GetShortHashes() {
+		if len(req.ShortHashes) >= maxMempoolSyncTxs {
+			break
+		}
+		if known[shash] || requested[shash] {
+			continue
+		}
+		requested[shash] = true
+		req.ShortHashes = append(req.ShortHashes, shash)
+	}
+	if len(req.ShortHashes) == 0 {
+		return nil, nil
+	}
+	reply, err := peer.mconn.gcli.GetMempoolTxs(context.Background(), req, grpc.FailFast(true))
+	collectMempoolSyncStat(err, peer)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*types.Transaction
+	for _, tx := range reply.GetTxs() {
+		if tx != nil && requested[types.CalcTxShortHash(tx.Hash())] {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+//collectMempoolSyncStat 老版本的节点不支持mempool同步，以及请求过于频繁被拒绝，不影响节点的状态
+func collectMempoolSyncStat(err error, peer *Peer) {
+	if code := status.Code(err); code == codes.Unimplemented || code == codes.ResourceExhausted {
+		return
+	}
+	P2pComm.CollectPeerStat(err, peer)
+}
+
+//syncMempool 和远程节点同步mempool，local为本地mempool中的交易，获取的交易按照从该节点接收的交易处理
+func (n *Node) syncMempool(peer *Peer, local map[string]*types.Transaction) {
+	txs, err := n.fetchMempoolTxs(peer, local)
+	if err != nil {
+		log.Debug("syncMempool", "peer", peer.Addr(), "err", err)
+		return
+	}
+	log.Debug("syncMempool", "peer", peer.Addr(), "local", len(local), "fetched", len(txs))
+	for _, tx := range txs {
+		n.recvTx(&types.P2PTx{Tx: tx, Route: &types.P2PRoute{TTL: 1}}, peer.GetPeerName(), peer.Addr())
+	}
+}
+
+//syncPeerMempool 和新连接的节点完成版本握手之后同步mempool
+func (n *Node) syncPeerMempool(peer *Peer) {
+	local, err := n.loadMempool()
+	if err != nil {
+		return
+	}
+	n.syncMempool(peer, local)
+}
+
+//monitorMempool 定期和所有连接的节点同步mempool，每次只获取一次本地的mempool
+func (n *Node) monitorMempool() {
+	ticker := time.NewTicker(MempoolSyncInterval)
+	defer ticker.Stop()
+	for {
+		if n.isClose() {
+			log.Debug("monitorMempool", "loop", "done")
+			return
+		}
+		<-ticker.C
+		peers, _ := n.GetActivePeers()
+		if len(peers) == 0 {
+			continue
+		}
+		local, err := n.loadMempool()
+		if err != nil {
+			continue
+		}
+		for _, peer := range peers {
+			n.syncMempool(peer, local)
+		}
+	}
+}
+
+// GetMempoolSketch 对比远程节点mempool的摘要，返回摘要不一致的区间中本地交易的短哈希
+// 本地的摘要按照mempoolSketchCacheTime缓存，同一个节点请求过于频繁时返回ResourceExhausted
+func (s *P2pserver) GetMempoolSketch(ctx context.Context, in *types.P2PMempoolSketch) (*types.P2PMempoolDiff, error) {
+	channel, ver := decodeChannelVersion(in.GetVersion())
+	log.Debug("p2pServer GetMempoolSketch", "p2pChannel", channel, "p2p version", ver)
+	if !s.node.verifyP2PChannel(channel) {
+		return nil, types.ErrP2PChannel
+	}
+	now := time.Now()
+	if peerIP, _, err := resolveClientNetAddr(ctx); err == nil && !s.sketch.allow(peerIP, now) {
+		return nil, status.Error(codes.ResourceExhausted, "GetMempoolSketch too frequently")
+	}
+	shortHashes, local, err := s.sketch.load(now, s.loadMempool)
+	if err != nil {
+		return nil, err
+	}
+	return &types.P2PMempoolDiff{ShortHashes: diffMempoolSketch(in, local, shortHashes, maxMempoolSyncTxs)}, nil
+}
+
+// GetMempoolTxs 通过短哈希获取本地mempool中的交易
+func (s *P2pserver) GetMempoolTxs(ctx context.Context, in *types.P2PGetMempoolTxs) (*types.ReplyTxList, error) {
+	channel, ver := decodeChannelVersion(in.GetVersion())
+	log.Debug("p2pServer GetMempoolTxs", "p2pChannel", channel, "p2p version", ver)
+	if !s.node.verifyP2PChannel(channel) {
+		return nil, types.ErrP2PChannel
+	}
+	shortHashes := in.GetShortHashes()
+	if len(shortHashes) > maxMempoolSyncTxs {
+		shortHashes = shortHashes[:maxMempoolSyncTxs]
+	}
+	client := s.node.nodeInfo.client
+	msg := client.NewMessage("mempool", types.EventTxListByHash, &types.ReqTxHashList{Hashes: shortHashes, IsShortHash: true})
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("GetMempoolTxs", "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	//不存在的交易为nil，不返回
+	reply := &types.ReplyTxList{}
+	for _, tx := range resp.GetData().(*types.ReplyTxList).GetTxs() {
+		if tx != nil {
+			reply.Txs = append(reply.Txs, tx)
+		}
+	}
+	return reply, nil
+}
@@ -0,0 +1,106 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	pr "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var testMempoolTxs = []*types.Transaction{
+	{Execer: []byte("coins"), Nonce: 1},
+	{Execer: []byte("coins"), Nonce: 2},
+}
+
+func TestMempoolSketch(t *testing.T) {
+	_, _, ok := shortHashValue("zz")
+	assert.False(t, ok)
+	bucket, v, ok := shortHashValue("ff00000001")
+	require.True(t, ok)
+	assert.Equal(t, 255, bucket)
+	assert.Equal(t, uint64(0xff00000001), v)
+
+	common := []string{"0100000001", "0200000002", "ff00000003"}
+	local := append([]string{"0100000004"}, common...)
+	remote := append([]string{"ff00000005"}, common...)
+	sketch := newMempoolSketch(remote)
+	assert.Equal(t, mempoolSketchBuckets, len(sketch.Counts))
+	assert.Equal(t, uint32(2), sketch.Counts[255])
+	assert.Equal(t, uint64(0xff00000003^0xff00000005), sketch.Xors[255])
+
+	//只返回摘要不一致的区间中的短哈希
+	localSketch := newMempoolSketch(local)
+	diff := diffMempoolSketch(sketch, localSketch, local, maxMempoolSyncTxs)
+	assert.Equal(t, []string{"0100000004", "0100000001", "ff00000003"}, diff)
+	assert.Equal(t, 0, len(diffMempoolSketch(localSketch, localSketch, local, maxMempoolSyncTxs)))
+	//摘要格式不正确时返回所有的短哈希
+	assert.Equal(t, local, diffMempoolSketch(&types.P2PMempoolSketch{}, localSketch, local, maxMempoolSyncTxs))
+	assert.Equal(t, 2, len(diffMempoolSketch(&types.P2PMempoolSketch{}, localSketch, local, 2)))
+}
+
+func TestMempoolSketchCache(t *testing.T) {
+	cache := newMempoolSketchCache()
+	loads := 0
+	loadMempool := func() (map[string]*types.Transaction, error) {
+		loads++
+		return map[string]*types.Transaction{"tx": testMempoolTxs[0]}, nil
+	}
+	now := time.Now()
+	shortHashes, sketch, err := cache.load(now, loadMempool)
+	require.NoError(t, err)
+	assert.Equal(t, []string{types.CalcTxShortHash(testMempoolTxs[0].Hash())}, shortHashes)
+	assert.Equal(t, newMempoolSketch(shortHashes), sketch)
+	//缓存时间内不重新获取mempool
+	_, _, err = cache.load(now.Add(mempoolSketchCacheTime-time.Second), loadMempool)
+	require.NoError(t, err)
+	assert.Equal(t, 1, loads)
+	_, _, err = cache.load(now.Add(mempoolSketchCacheTime), loadMempool)
+	require.NoError(t, err)
+	assert.Equal(t, 2, loads)
+
+	//同一个节点的请求间隔不能小于mempoolSketchPeerInterval
+	assert.True(t, cache.allow("192.168.1.1", now))
+	assert.True(t, cache.allow("192.168.1.2", now))
+	assert.False(t, cache.allow("192.168.1.1", now.Add(mempoolSketchPeerInterval-time.Second)))
+	assert.True(t, cache.allow("192.168.1.1", now.Add(mempoolSketchPeerInterval)))
+	//过期的请求记录被清理
+	assert.Equal(t, 1, len(cache.peers))
+}
+
+func testMempoolSync(t *testing.T, p2p *P2p) {
+	server := p2p.node.server.p2pserver
+	version := calcChannelVersion(testChannel)
+	_, err := server.GetMempoolSketch(context.Background(), &types.P2PMempoolSketch{})
+	assert.Equal(t, types.ErrP2PChannel, err)
+
+	shash0 := types.CalcTxShortHash(testMempoolTxs[0].Hash())
+	sketch := newMempoolSketch([]string{shash0})
+	sketch.Version = version
+	diff, err := server.GetMempoolSketch(context.Background(), sketch)
+	require.NoError(t, err)
+	shash1 := types.CalcTxShortHash(testMempoolTxs[1].Hash())
+	assert.Contains(t, diff.ShortHashes, shash1)
+
+	//同一个节点频繁请求摘要时被拒绝
+	ctx := pr.NewContext(context.Background(), &pr.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.168.1.1"), Port: 13802}})
+	_, err = server.GetMempoolSketch(ctx, sketch)
+	require.NoError(t, err)
+	_, err = server.GetMempoolSketch(ctx, sketch)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	txs, err := server.GetMempoolTxs(context.Background(), &types.P2PGetMempoolTxs{Version: version, ShortHashes: []string{shash1, "0000000000"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(txs.Txs))
+	assert.Equal(t, testMempoolTxs[1].Hash(), txs.Txs[0].Hash())
+}
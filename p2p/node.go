@@ -351,6 +351,7 @@ func (n *Node) monitor() {
 	go n.monitorFilter()
 	go n.monitorPeers()
 	go n.nodeReBalance()
+	go n.monitorMempool()
 }
 
 func (n *Node) needMore() bool {
@@ -343,6 +343,8 @@ func (network *P2p) subP2pMsg() {
 				network.processEvent(msg, taskIndex, network.p2pCli.GetHeaders)
 			case types.EventGetNetInfo:
 				network.processEvent(msg, taskIndex, network.p2pCli.GetNetInfo)
+			case types.EventFetchSnapshotChunk:
+				network.processEvent(msg, taskIndex, network.p2pCli.GetSnapshotChunk)
 			default:
 				log.Warn("unknown msgtype", "msg", msg)
 				msg.Reply(network.client.NewMessage("", msg.Ty, types.Reply{Msg: []byte("unknown msgtype")}))
@@ -104,6 +104,20 @@ func processMsg(q queue.Queue) {
 			switch msg.Ty {
 			case types.EventGetMempoolSize:
 				msg.Reply(client.NewMessage("p2p", types.EventMempoolSize, &types.MempoolSize{Size: 0}))
+			case types.EventGetMempool:
+				msg.Reply(client.NewMessage("p2p", types.EventReplyTxList, &types.ReplyTxList{Txs: testMempoolTxs}))
+			case types.EventTxListByHash:
+				reply := &types.ReplyTxList{}
+				for _, shash := range msg.GetData().(*types.ReqTxHashList).GetHashes() {
+					var found *types.Transaction
+					for _, tx := range testMempoolTxs {
+						if types.CalcTxShortHash(tx.Hash()) == shash {
+							found = tx
+						}
+					}
+					reply.Txs = append(reply.Txs, found)
+				}
+				msg.Reply(client.NewMessage("p2p", types.EventReplyTxList, reply))
 			}
 		}
 	}()
@@ -437,5 +451,6 @@ func Test_p2p(t *testing.T) {
 	testGrpcStreamConns(t, p2p)
 	testP2pComm(t, p2p)
 	testAddrBook(t, p2p)
+	testMempoolSync(t, p2p)
 	testRestart(t, p2p)
 }
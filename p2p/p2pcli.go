@@ -18,6 +18,8 @@ import (
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type p2pEventFunc func(message *queue.Message, taskIndex int64)
@@ -28,6 +30,7 @@ type EventInterface interface {
 	GetMemPool(msg *queue.Message, taskindex int64)
 	GetPeerInfo(msg *queue.Message, taskindex int64)
 	GetHeaders(msg *queue.Message, taskindex int64)
+	GetSnapshotChunk(msg *queue.Message, taskindex int64)
 	GetBlocks(msg *queue.Message, taskindex int64)
 	BlockBroadcast(msg *queue.Message, taskindex int64)
 	GetNetInfo(msg *queue.Message, taskindex int64)
@@ -99,8 +102,24 @@ func (m *Cli) GetMemPool(msg *queue.Message, taskindex int64) {
 	var Txs = make([]*pb.Transaction, 0)
 	var ableInv = make([]*pb.Inventory, 0)
 	peers, _ := m.network.node.GetActivePeers()
+	//与本地mempool 对比 tx数组，所有节点共用
+	txmap, err := m.network.node.loadMempool()
+	if err != nil {
+		msg.Reply(m.network.client.NewMessage("mempool", pb.EventReplyTxList, &pb.ReplyTxList{Txs: Txs}))
+		return
+	}
 
 	for _, peer := range peers {
+		//对比mempool的摘要，只获取本地缺少的交易，老版本的节点获取全部的交易列表
+		txs, err := m.network.node.fetchMempoolTxs(peer, txmap)
+		if err == nil {
+			Txs = append(Txs, txs...)
+			break
+		}
+		if status.Code(err) != codes.Unimplemented {
+			continue
+		}
+
 		//获取远程 peer invs
 		resp, err := peer.mconn.gcli.GetMemPool(context.Background(),
 			&pb.P2PGetMempool{Version: m.network.node.nodeInfo.channelVersion}, grpc.FailFast(true))
@@ -114,19 +133,6 @@ func (m *Cli) GetMemPool(msg *queue.Message, taskindex int64) {
 		}
 
 		invs := resp.GetInvs()
-		//与本地mempool 对比 tx数组
-		tmpMsg := m.network.client.NewMessage("mempool", pb.EventGetMempool, nil)
-		txresp, err := m.network.client.Wait(tmpMsg)
-		if err != nil {
-			continue
-		}
-		txlist := txresp.GetData().(*pb.ReplyTxList)
-		txs := txlist.GetTxs()
-		var txmap = make(map[string]*pb.Transaction)
-		for _, tx := range txs {
-			txmap[hex.EncodeToString(tx.Hash())] = tx
-		}
-
 		//去重过滤
 		for _, inv := range invs {
 			if _, ok := txmap[hex.EncodeToString(inv.Hash)]; !ok {
@@ -410,6 +416,31 @@ func (m *Cli) GetHeaders(msg *queue.Message, taskindex int64) {
 
 }
 
+// GetSnapshotChunk 从指定的peer获取快照分片，同步返回给blockchain模块校验
+func (m *Cli) GetSnapshotChunk(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetSnapshotChunk", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqSnapshotChunk)
+	peers, infos := m.network.node.GetActivePeers()
+	peer, ok := peers[req.GetPid()]
+	if !ok || peer == nil {
+		log.Debug("GetSnapshotChunk", "pid", req.GetPid(), "ActivePeers", peers, "infos", infos)
+		msg.Reply(m.network.client.NewMessage("blockchain", pb.EventFetchSnapshotChunk, pb.ErrSnapshotNoPeer))
+		return
+	}
+	chunk, err := peer.mconn.gcli.GetSnapshotChunk(context.Background(), &pb.P2PGetSnapshotChunk{Req: req,
+		Version: m.network.node.nodeInfo.channelVersion}, grpc.FailFast(true))
+	P2pComm.CollectPeerStat(err, peer)
+	if err != nil {
+		log.Error("GetSnapshotChunk", "pid", req.GetPid(), "Err", err.Error())
+		msg.Reply(m.network.client.NewMessage("blockchain", pb.EventFetchSnapshotChunk, err))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("blockchain", pb.EventFetchSnapshotChunk, chunk))
+}
+
 // GetBlocks get blocks information
 func (m *Cli) GetBlocks(msg *queue.Message, taskindex int64) {
 	defer func() {
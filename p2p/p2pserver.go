@@ -27,6 +27,7 @@ type P2pserver struct {
 	node         *Node
 	streams      map[string]chan interface{}
 	inboundpeers map[string]*innerpeer
+	sketch       *mempoolSketchCache
 	closed       int32
 }
 type innerpeer struct {
@@ -57,6 +58,7 @@ func NewP2pServer() *P2pserver {
 	return &P2pserver{
 		streams:      make(map[string]chan interface{}),
 		inboundpeers: make(map[string]*innerpeer),
+		sketch:       newMempoolSketchCache(),
 	}
 
 }
@@ -342,6 +344,34 @@ func (s *P2pserver) GetHeaders(ctx context.Context, in *pb.P2PGetHeaders) (*pb.P
 	return &pb.P2PHeaders{Headers: headers.GetItems()}, nil
 }
 
+// GetSnapshotChunk 向blockchain模块获取快照分片
+func (s *P2pserver) GetSnapshotChunk(ctx context.Context, in *pb.P2PGetSnapshotChunk) (*pb.SnapshotChunk, error) {
+	channel, ver := decodeChannelVersion(in.GetVersion())
+	log.Debug("p2pServer GetSnapshotChunk", "p2pChannel", channel, "p2p version", ver)
+	if !s.node.verifyP2PChannel(channel) {
+		return nil, pb.ErrP2PChannel
+	}
+	if in.GetReq() == nil {
+		return nil, pb.ErrInvalidParam
+	}
+
+	client := s.node.nodeInfo.client
+	msg := client.NewMessage("blockchain", pb.EventGetSnapshotChunk, in.GetReq())
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("GetSnapshotChunk", "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+	return resp.GetData().(*pb.SnapshotChunk), nil
+}
+
 // GetPeerInfo get peer information of p2pServer
 func (s *P2pserver) GetPeerInfo(ctx context.Context, in *pb.P2PGetPeerInfo) (*pb.P2PPeerInfo, error) {
 	channel, ver := decodeChannelVersion(in.GetVersion())
@@ -570,27 +600,7 @@ func (s *P2pserver) CollectInPeers2(ctx context.Context, in *pb.P2PPing) (*pb.Pe
 }
 
 func (s *P2pserver) loadMempool() (map[string]*pb.Transaction, error) {
-
-	var txmap = make(map[string]*pb.Transaction)
-	client := s.node.nodeInfo.client
-	msg := client.NewMessage("mempool", pb.EventGetMempool, nil)
-	err := client.SendTimeout(msg, true, time.Minute)
-	if err != nil {
-		log.Error("loadMempool", "Error", err.Error())
-		return txmap, err
-	}
-	resp, err := client.WaitTimeout(msg, time.Minute)
-	if err != nil {
-		return txmap, err
-	}
-
-	txlist := resp.GetData().(*pb.ReplyTxList)
-	txs := txlist.GetTxs()
-
-	for _, tx := range txs {
-		txmap[hex.EncodeToString(tx.Hash())] = tx
-	}
-	return txmap, nil
+	return s.node.loadMempool()
 }
 
 func (s *P2pserver) manageStream() {
@@ -151,6 +151,8 @@ func (p *Peer) heartBeat() {
 		p.taskChan = p.node.pubsub.Sub("block", "tx", peername)
 		go p.sendStream()
 		go p.readStream()
+		//版本握手之后同步mempool中的交易
+		go p.node.syncPeerMempool(p)
 		break
 	}
 
@@ -181,7 +181,7 @@ func (n *Node) recvTx(tx *types.P2PTx, pid, peerAddr string) {
 	}
 	txHashFilter.Add(txHash, tx.GetRoute())
 
-	msg := n.nodeInfo.client.NewMessage("mempool", types.EventTx, tx.GetTx())
+	msg := n.nodeInfo.client.NewMessage("mempool", types.EventTx, &types.P2PRecvTx{Tx: tx.GetTx(), Peer: pid})
 	errs := n.nodeInfo.client.Send(msg, false)
 	if errs != nil {
 		log.Error("recvTx", "process EventTx msg Error", errs.Error())
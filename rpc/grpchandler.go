@@ -5,6 +5,7 @@
 package rpc
 
 import (
+	"sync"
 	"time"
 
 	"strings"
@@ -14,6 +15,12 @@ import (
 	"golang.org/x/net/context"
 )
 
+// blockSeqSubs names of the SubBlockSeq streams, only one stream is allowed for each name
+var (
+	blockSeqSubs     = make(map[string]bool)
+	blockSeqSubsLock sync.Mutex
+)
+
 // SendTransaction send transaction by network
 func (g *Grpc) SendTransaction(ctx context.Context, in *pb.Transaction) (*pb.Reply, error) {
 	return g.cli.SendTx(in)
@@ -262,6 +269,164 @@ func (g *Grpc) GetHeaders(ctx context.Context, in *pb.ReqBlocks) (*pb.Headers, e
 	return g.cli.GetHeaders(in)
 }
 
+// GetLightHeader 获取轻节点本地保存的区块头
+func (g *Grpc) GetLightHeader(ctx context.Context, in *pb.ReqInt) (*pb.Header, error) {
+	return g.cli.GetLightHeader(in)
+}
+
+// AddCheckpoint 添加签名的检查点
+func (g *Grpc) AddCheckpoint(ctx context.Context, in *pb.ChainCheckpoint) (*pb.Reply, error) {
+	return g.cli.AddCheckpoint(in)
+}
+
+// GetCheckpoints 获取所有的检查点
+func (g *Grpc) GetCheckpoints(ctx context.Context, in *pb.ReqNil) (*pb.ChainCheckpoints, error) {
+	return g.cli.GetCheckpoints()
+}
+
+// GetReindexProgress 获取重建索引的进度
+func (g *Grpc) GetReindexProgress(ctx context.Context, in *pb.ReqNil) (*pb.ReindexProgress, error) {
+	return g.cli.GetReindexProgress()
+}
+
+// SubBlockSeq 订阅grpc方式推送的seq数据，订阅方处理完成之后需要调用AckBlockSeq确认
+func (g *Grpc) SubBlockSeq(in *pb.ReqString, stream pb.Chain33_SubBlockSeqServer) error {
+	name := in.GetData()
+	blockSeqSubsLock.Lock()
+	if blockSeqSubs[name] {
+		blockSeqSubsLock.Unlock()
+		return pb.ErrPushSubscribed
+	}
+	blockSeqSubs[name] = true
+	blockSeqSubsLock.Unlock()
+	defer func() {
+		blockSeqSubsLock.Lock()
+		delete(blockSeqSubs, name)
+		blockSeqSubsLock.Unlock()
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		default:
+		}
+		data, err := g.cli.GetPushSeqData(in)
+		if err == pb.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		err = stream.Send(data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// AckBlockSeq 确认已经处理完成的推送数据
+func (g *Grpc) AckBlockSeq(ctx context.Context, in *pb.PushSeqAck) (*pb.Reply, error) {
+	return g.cli.AckPushSeq(in)
+}
+
+// SubTxEvents 订阅mempool中交易的状态变化事件，连接断开时取消订阅
+// 每个连接使用服务端生成的订阅名字，避免和其他客户端相同名字的订阅互相覆盖
+func (g *Grpc) SubTxEvents(in *pb.ReqSubTxEvents, stream pb.Chain33_SubTxEventsServer) error {
+	req := &pb.ReqSubTxEvents{
+		Name:   "grpc-" + in.GetName() + "-" + common.GetRandPrintString(16, 16),
+		Hashes: in.GetHashes(),
+		Addrs:  in.GetAddrs(),
+	}
+	_, err := g.cli.SubTxEvents(req)
+	if err != nil {
+		return err
+	}
+	name := &pb.ReqString{Data: req.Name}
+	defer g.cli.UnSubTxEvents(name)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		default:
+		}
+		events, err := g.cli.GetTxEvents(name)
+		if err != nil {
+			return err
+		}
+		for _, event := range events.GetEvents() {
+			err = stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CheckTransaction 检查交易能否进入mempool，交易不会被加入mempool
+func (g *Grpc) CheckTransaction(ctx context.Context, in *pb.Transaction) (*pb.ReplyCheckTx, error) {
+	return g.cli.CheckTransaction(in)
+}
+
+// QueryMempoolTxs 按照条件查询mempool中的交易
+func (g *Grpc) QueryMempoolTxs(ctx context.Context, in *pb.ReqMempoolTxs) (*pb.ReplyMempoolTxs, error) {
+	return g.cli.QueryMempoolTxs(in)
+}
+
+// EvictMempoolTxs 从mempool中删除交易，只允许管理员调用
+func (g *Grpc) EvictMempoolTxs(ctx context.Context, in *pb.ReqEvictMempoolTxs) (*pb.TxHashList, error) {
+	return g.cli.EvictMempoolTxs(in)
+}
+
+// GetMempoolStats mempool中交易的统计
+func (g *Grpc) GetMempoolStats(ctx context.Context, in *pb.ReqNil) (*pb.MempoolStats, error) {
+	return g.cli.GetMempoolStats()
+}
+
+// GetScheduledTxs mempool中还没有生效的交易
+func (g *Grpc) GetScheduledTxs(ctx context.Context, in *pb.ReqNil) (*pb.ReplyTxList, error) {
+	return g.cli.GetScheduledTxs()
+}
+
+// SimulateTransaction 在当前或者指定的状态上模拟执行交易，不会修改任何数据
+func (g *Grpc) SimulateTransaction(ctx context.Context, in *pb.ReqSimulateTx) (*pb.ReplySimulateTx, error) {
+	return g.cli.SimulateTransaction(in)
+}
+
+// TraceTransaction 在区块中重新执行交易，返回执行过程中的读写记录
+func (g *Grpc) TraceTransaction(ctx context.Context, in *pb.ReqHash) (*pb.ReplyTraceTx, error) {
+	return g.cli.TraceTransaction(in)
+}
+
+// GetReorgEvents 获取最近的链重组事件
+func (g *Grpc) GetReorgEvents(ctx context.Context, in *pb.ReqReorgEvents) (*pb.ReorgEvents, error) {
+	return g.cli.GetReorgEvents(in)
+}
+
+// GetStateProof 获取指定高度状态树中key的证明
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.ReqStateProof) (*pb.StateProof, error) {
+	return g.cli.GetStateProof(in)
+}
+
+// GetBlockStateDiff 获取区块修改的状态key以及修改前后的value
+func (g *Grpc) GetBlockStateDiff(ctx context.Context, in *pb.ReqBlockStateDiff) (*pb.StateDiff, error) {
+	return g.cli.GetBlockStateDiff(in)
+}
+
+// GetStateDiff 获取两个状态哈希之间的差异
+func (g *Grpc) GetStateDiff(ctx context.Context, in *pb.ReqStateDiff) (*pb.StateDiff, error) {
+	return g.cli.GetStateDiff(in)
+}
+
+// VerifyStateProof 通过本地区块头校验状态证明
+func (g *Grpc) VerifyStateProof(ctx context.Context, in *pb.StateProof) (*pb.Reply, error) {
+	return g.cli.VerifyStateProof(in)
+}
+
+// VerifyTxProof 通过本地区块头校验交易的merkle证明
+func (g *Grpc) VerifyTxProof(ctx context.Context, in *pb.TransactionDetail) (*pb.Reply, error) {
+	return g.cli.VerifyTxProof(in)
+}
+
 // GetLastMemPool return last mempool contents
 func (g *Grpc) GetLastMemPool(ctx context.Context, in *pb.ReqNil) (*pb.ReplyTxList, error) {
 	return g.cli.GetLastMempool()
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 )
 
@@ -493,6 +494,55 @@ func TestGetHeaders(t *testing.T) {
 	testGetHeadersOK(t)
 }
 
+func TestVerifyProof(t *testing.T) {
+	var detail *pb.TransactionDetail
+	qapi.On("VerifyTxProof", detail).Return(&pb.Reply{IsOk: true}, nil)
+	reply, err := g.VerifyTxProof(getOkCtx(), detail)
+	assert.Nil(t, err)
+	assert.True(t, reply.IsOk)
+
+	var proof *pb.StateProof
+	qapi.On("VerifyStateProof", proof).Return(nil, pb.ErrStateProof)
+	_, err = g.VerifyStateProof(getOkCtx(), proof)
+	assert.Equal(t, pb.ErrStateProof, err)
+}
+
+func TestGetReorgEvents(t *testing.T) {
+	req := &pb.ReqReorgEvents{Count: 10}
+	qapi.On("GetReorgEvents", req).Return(&pb.ReorgEvents{Items: []*pb.ReorgEvent{{Index: 1}}}, nil)
+	reply, err := g.GetReorgEvents(getOkCtx(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reply.Items))
+}
+
+func TestCheckpoints(t *testing.T) {
+	var point *pb.ChainCheckpoint
+	qapi.On("AddCheckpoint", point).Return(nil, pb.ErrCheckpointSign)
+	_, err := g.AddCheckpoint(getOkCtx(), point)
+	assert.Equal(t, pb.ErrCheckpointSign, err)
+
+	qapi.On("GetCheckpoints").Return(&pb.ChainCheckpoints{Items: []*pb.ChainCheckpoint{{Height: 1}}}, nil)
+	points, err := g.GetCheckpoints(getOkCtx(), &pb.ReqNil{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(points.Items))
+}
+
+func TestAckBlockSeq(t *testing.T) {
+	ack := &pb.PushSeqAck{Name: "test", Seq: 1}
+	qapi.On("AckPushSeq", ack).Return(&pb.Reply{IsOk: true}, nil)
+	reply, err := g.AckBlockSeq(getOkCtx(), ack)
+	assert.Nil(t, err)
+	assert.True(t, reply.IsOk)
+}
+
+func TestGetReindexProgress(t *testing.T) {
+	qapi.On("GetReindexProgress").Return(&pb.ReindexProgress{Running: true, Total: 100, Done: 10}, nil)
+	progress, err := g.GetReindexProgress(getOkCtx(), &pb.ReqNil{})
+	assert.Nil(t, err)
+	assert.True(t, progress.Running)
+	assert.Equal(t, int64(10), progress.Done)
+}
+
 func testGetBlockOverviewOK(t *testing.T) {
 	var in *pb.ReqHash
 	qapi.On("GetBlockOverview", in).Return(nil, nil)
@@ -710,3 +760,139 @@ func TestGrpc_GetParaTxByHeight(t *testing.T) {
 	_, err := g.GetParaTxByHeight(getOkCtx(), &pb.ReqParaTxByHeight{})
 	assert.NoError(t, err)
 }
+
+func TestGetStateDiff(t *testing.T) {
+	diff := &pb.StateDiff{Height: 1, Items: []*pb.KeyValueDiff{{Key: []byte("key"), Ty: pb.StateDiffAdd, NewValue: []byte("value")}}}
+	qapi.On("GetBlockStateDiff", &pb.ReqBlockStateDiff{Height: 1}).Return(diff, nil)
+	reply, err := g.GetBlockStateDiff(getOkCtx(), &pb.ReqBlockStateDiff{Height: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, diff, reply)
+
+	qapi.On("GetStateDiff", &pb.ReqStateDiff{NewStateHash: []byte("hash")}).Return(nil, pb.ErrNotFound)
+	_, err = g.GetStateDiff(getOkCtx(), &pb.ReqStateDiff{NewStateHash: []byte("hash")})
+	assert.Equal(t, pb.ErrNotFound, err)
+}
+
+type txEventsStream struct {
+	grpc.ServerStream
+	events []*pb.TxLifecycleEvent
+}
+
+func (s *txEventsStream) Context() context.Context {
+	return getOkCtx()
+}
+
+func (s *txEventsStream) Send(event *pb.TxLifecycleEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestSubTxEvents(t *testing.T) {
+	req := &pb.ReqSubTxEvents{Name: "sub", Addrs: []string{"addr"}}
+	events := &pb.TxLifecycleEvents{Events: []*pb.TxLifecycleEvent{{Hash: []byte("hash"), Ty: pb.TxEventAccepted}}}
+	//每个连接使用服务端生成的订阅名字
+	var names []string
+	qapi.On("SubTxEvents", mock.MatchedBy(func(in *pb.ReqSubTxEvents) bool {
+		return strings.HasPrefix(in.Name, "grpc-sub-") && in.Addrs[0] == "addr"
+	})).Return(&pb.Reply{IsOk: true}, nil).Run(func(args mock.Arguments) {
+		names = append(names, args.Get(0).(*pb.ReqSubTxEvents).Name)
+	})
+	isSub := func(in *pb.ReqString) bool { return in.Data == names[len(names)-1] }
+	qapi.On("GetTxEvents", mock.MatchedBy(isSub)).Return(events, nil).Once()
+	qapi.On("GetTxEvents", mock.MatchedBy(isSub)).Return(nil, pb.ErrNotFound).Once()
+	qapi.On("UnSubTxEvents", mock.MatchedBy(isSub)).Return(&pb.Reply{IsOk: true}, nil)
+	stream := &txEventsStream{}
+	err := g.SubTxEvents(req, stream)
+	assert.Equal(t, pb.ErrNotFound, err)
+	assert.Equal(t, events.Events, stream.events)
+	qapi.AssertCalled(t, "UnSubTxEvents", &pb.ReqString{Data: names[0]})
+
+	//相同名字的连接不会取消其他连接的订阅
+	qapi.On("GetTxEvents", mock.MatchedBy(isSub)).Return(nil, pb.ErrNotFound).Once()
+	err = g.SubTxEvents(req, &txEventsStream{})
+	assert.Equal(t, pb.ErrNotFound, err)
+	if assert.Equal(t, 2, len(names)) {
+		assert.NotEqual(t, names[0], names[1])
+	}
+}
+
+type blockSeqStream struct {
+	grpc.ServerStream
+	send func(data *pb.PushSeqData) error
+}
+
+func (s *blockSeqStream) Context() context.Context {
+	return getOkCtx()
+}
+
+func (s *blockSeqStream) Send(data *pb.PushSeqData) error {
+	return s.send(data)
+}
+
+func TestSubBlockSeq(t *testing.T) {
+	name := &pb.ReqString{Data: "seqsub"}
+	qapi.On("GetPushSeqData", name).Return(&pb.PushSeqData{Name: "seqsub", Seq: 1}, nil)
+	var dupErr error
+	stream := &blockSeqStream{send: func(data *pb.PushSeqData) error {
+		//同名的订阅已经存在时拒绝
+		dupErr = g.SubBlockSeq(name, &blockSeqStream{})
+		return pb.ErrPushSeqPostData
+	}}
+	assert.Equal(t, pb.ErrPushSeqPostData, g.SubBlockSeq(name, stream))
+	assert.Equal(t, pb.ErrPushSubscribed, dupErr)
+
+	//前一个订阅断开之后可以重新订阅
+	stream.send = func(data *pb.PushSeqData) error { return pb.ErrPushSeqPostData }
+	assert.Equal(t, pb.ErrPushSeqPostData, g.SubBlockSeq(name, stream))
+}
+
+func TestCheckTransaction(t *testing.T) {
+	tx := &pb.Transaction{Execer: []byte("none"), Fee: 1}
+	reply := &pb.ReplyCheckTx{Stages: []*pb.TxCheckStage{{Name: "basic", Error: pb.ErrTxFeeTooLow.Error()}}, ProperFee: 100000}
+	qapi.On("CheckTransaction", tx).Return(reply, nil)
+	data, err := g.CheckTransaction(getOkCtx(), tx)
+	assert.NoError(t, err)
+	assert.Equal(t, reply, data)
+}
+
+func TestMempoolAdmin(t *testing.T) {
+	req := &pb.ReqMempoolTxs{From: "addr", Count: 10}
+	qapi.On("QueryMempoolTxs", req).Return(&pb.ReplyMempoolTxs{Total: 1}, nil)
+	txs, err := g.QueryMempoolTxs(getOkCtx(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), txs.Total)
+
+	evict := &pb.ReqEvictMempoolTxs{Addr: "addr"}
+	qapi.On("EvictMempoolTxs", evict).Return(&pb.TxHashList{Count: 2}, nil)
+	hashes, err := g.EvictMempoolTxs(getOkCtx(), evict)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), hashes.Count)
+
+	qapi.On("GetMempoolStats").Return(&pb.MempoolStats{TxCount: 3}, nil)
+	stats, err := g.GetMempoolStats(getOkCtx(), &pb.ReqNil{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TxCount)
+
+	qapi.On("GetScheduledTxs").Return(&pb.ReplyTxList{Txs: []*pb.Transaction{{NotBefore: 100}}}, nil)
+	scheduled, err := g.GetScheduledTxs(getOkCtx(), &pb.ReqNil{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), scheduled.Txs[0].NotBefore)
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	req := &pb.ReqSimulateTx{Tx: &pb.Transaction{Execer: []byte("none")}}
+	reply := &pb.ReplySimulateTx{Receipt: &pb.Receipt{Ty: pb.ExecOk}, Fee: 100000}
+	qapi.On("SimulateTransaction", req).Return(reply, nil)
+	data, err := g.SimulateTransaction(getOkCtx(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, reply, data)
+}
+
+func TestTraceTransaction(t *testing.T) {
+	req := &pb.ReqHash{Hash: []byte("hash")}
+	reply := &pb.ReplyTraceTx{Receipt: &pb.Receipt{Ty: pb.ExecOk}, Ops: []*pb.TraceOp{{Ty: "commit"}}}
+	qapi.On("TraceTransaction", req).Return(reply, nil)
+	data, err := g.TraceTransaction(getOkCtx(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, reply, data)
+}
@@ -90,7 +90,7 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			ipaddr := net.ParseIP(ip)
 			if !ipaddr.IsLoopback() {
 				//funcName := strings.Split(client.Method, ".")[len(strings.Split(client.Method, "."))-1]
-				if checkJrpcFuncBlacklist(funcName) || !checkJrpcFuncWhitelist(funcName) {
+				if checkJrpcFuncBlacklist(funcName) || !checkJrpcFuncWhitelist(funcName) || !checkAdminFunc(funcName, jrpcFuncWhitelist) {
 					writeError(w, r, client.ID, fmt.Sprintf(`The %s method is not authorized!`, funcName))
 					return
 				}
@@ -175,7 +175,7 @@ func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
 		}
 
 		funcName := strings.Split(info.FullMethod, "/")[len(strings.Split(info.FullMethod, "/"))-1]
-		if checkGrpcFuncBlacklist(funcName) || !checkGrpcFuncWhitelist(funcName) {
+		if checkGrpcFuncBlacklist(funcName) || !checkGrpcFuncWhitelist(funcName) || !checkAdminFunc(funcName, grpcFuncWhitelist) {
 			return fmt.Errorf("the %s method is not authorized", funcName)
 		}
 		return nil
@@ -130,6 +130,127 @@ func (c *Chain33) SendTransaction(in rpctypes.RawParm, result *interface{}) erro
 	return err
 }
 
+// CheckTransaction check whether the transaction can be accepted by mempool without adding it
+func (c *Chain33) CheckTransaction(in rpctypes.RawParm, result *interface{}) error {
+	var parm types.Transaction
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	err = types.Decode(data, &parm)
+	if err != nil {
+		return err
+	}
+
+	var reply *types.ReplyCheckTx
+	//para chain, forward to main chain
+	cfg := c.cli.GetConfig()
+	if cfg.IsPara() {
+		reply, err = c.mainGrpcCli.CheckTransaction(context.Background(), &parm)
+	} else {
+		reply, err = c.cli.CheckTransaction(&parm)
+	}
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// SimulateTransaction execute the transaction on the latest or given state without persisting anything
+func (c *Chain33) SimulateTransaction(in rpctypes.ReqSimulateTx, result *interface{}) error {
+	var tx types.Transaction
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return err
+	}
+	req := &types.ReqSimulateTx{Tx: &tx, Height: in.Height}
+	if in.StateHash != "" {
+		req.StateHash, err = common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+	}
+	reply, err := c.cli.SimulateTransaction(req)
+	if err != nil {
+		return err
+	}
+	*result, err = fmtSimulateTx(&tx, reply)
+	return err
+}
+
+func fmtSimulateTx(tx *types.Transaction, reply *types.ReplySimulateTx) (*rpctypes.ReplySimulateTx, error) {
+	result := &rpctypes.ReplySimulateTx{Fee: reply.GetFee(), Error: reply.GetError()}
+	if reply.GetReceipt() != nil {
+		var recp rpctypes.ReceiptData
+		recp.Ty = reply.GetReceipt().GetTy()
+		recp.GasUsed = reply.GetReceipt().GetGasUsed()
+		for _, lg := range reply.GetReceipt().GetLogs() {
+			recp.Logs = append(recp.Logs,
+				&rpctypes.ReceiptLog{Ty: lg.Ty, Log: common.ToHex(lg.GetLog())})
+		}
+		recpResult, err := rpctypes.DecodeLog(tx.Execer, &recp)
+		if err != nil {
+			log.Error("SimulateTransaction", "Failed to DecodeLog for type", err)
+			return nil, err
+		}
+		result.Receipt = recpResult
+	}
+	result.KV = fmtKeyValues(reply.GetReceipt().GetKV())
+	result.LocalKV = fmtKeyValues(reply.GetLocalKV())
+	return result, nil
+}
+
+// TraceTransaction re-execute the transaction in its block and return the recorded db access and execution steps
+func (c *Chain33) TraceTransaction(in rpctypes.QueryParm, result *interface{}) error {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.TraceTransaction(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return err
+	}
+	*result, err = fmtTraceTx(reply)
+	return err
+}
+
+func fmtTraceTx(reply *types.ReplyTraceTx) (*rpctypes.ReplyTraceTx, error) {
+	result := &rpctypes.ReplyTraceTx{}
+	if reply.GetReceipt() != nil {
+		var recp rpctypes.ReceiptData
+		recp.Ty = reply.GetReceipt().GetTy()
+		recp.GasUsed = reply.GetReceipt().GetGasUsed()
+		for _, lg := range reply.GetReceipt().GetLogs() {
+			recp.Logs = append(recp.Logs,
+				&rpctypes.ReceiptLog{Ty: lg.Ty, Log: common.ToHex(lg.GetLog())})
+		}
+		recpResult, err := rpctypes.DecodeLog(reply.GetTx().GetExecer(), &recp)
+		if err != nil {
+			log.Error("TraceTransaction", "Failed to DecodeLog for type", err)
+			return nil, err
+		}
+		result.Receipt = recpResult
+	}
+	result.KV = fmtKeyValues(reply.GetReceipt().GetKV())
+	for _, op := range reply.GetOps() {
+		result.Ops = append(result.Ops, &rpctypes.TraceOp{Ty: op.Ty, Key: common.ToHex(op.Key), Value: common.ToHex(op.Value), Info: op.Info})
+	}
+	return result, nil
+}
+
+func fmtKeyValues(kvs []*types.KeyValue) []*rpctypes.KeyValue {
+	var result []*rpctypes.KeyValue
+	for _, kv := range kvs {
+		result = append(result, &rpctypes.KeyValue{Key: common.ToHex(kv.GetKey()), Value: common.ToHex(kv.GetValue())})
+	}
+	return result
+}
+
 // GetHexTxByHash get hex transaction by hash
 func (c *Chain33) GetHexTxByHash(in rpctypes.QueryParm, result *interface{}) error {
 	var data types.ReqHash
@@ -220,6 +341,316 @@ func (c *Chain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// GetLightHeader get header saved by light node, height < 0 means the last header
+func (c *Chain33) GetLightHeader(in *types.ReqInt, result *interface{}) error {
+	reply, err := c.cli.GetLightHeader(in)
+	if err != nil {
+		return err
+	}
+
+	var header rpctypes.Header
+	header.BlockTime = reply.GetBlockTime()
+	header.Height = reply.GetHeight()
+	header.ParentHash = common.ToHex(reply.GetParentHash())
+	header.StateHash = common.ToHex(reply.GetStateHash())
+	header.TxHash = common.ToHex(reply.GetTxHash())
+	header.Version = reply.GetVersion()
+	header.Hash = common.ToHex(reply.GetHash())
+	header.TxCount = reply.TxCount
+	header.Difficulty = reply.GetDifficulty()
+	*result = &header
+	return nil
+}
+
+// AddCheckpoint add signed checkpoint
+func (c *Chain33) AddCheckpoint(in *rpctypes.Checkpoint, result *interface{}) error {
+	if in == nil || in.Signature == nil {
+		return types.ErrInvalidParam
+	}
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	pubkey, err := common.FromHex(in.Signature.Pubkey)
+	if err != nil {
+		return err
+	}
+	sign, err := common.FromHex(in.Signature.Signature)
+	if err != nil {
+		return err
+	}
+	point := &types.ChainCheckpoint{
+		Height:    in.Height,
+		Hash:      hash,
+		Signature: &types.Signature{Ty: in.Signature.Ty, Pubkey: pubkey, Signature: sign},
+	}
+	reply, err := c.cli.AddCheckpoint(point)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
+// GetStateProof get mavl proof of the state key at the height
+func (c *Chain33) GetStateProof(in *rpctypes.ReqStateProof, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	key, err := common.FromHex(in.Key)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetStateProof(&types.ReqStateProof{Height: in.Height, Key: key})
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.StateProof{
+		Height:    reply.GetHeight(),
+		StateHash: common.ToHex(reply.GetStateHash()),
+		Key:       common.ToHex(reply.GetKey()),
+		Value:     common.ToHex(reply.GetValue()),
+		Proof:     common.ToHex(reply.GetProof()),
+	}
+	return nil
+}
+
+// VerifyStateProof verify state proof with the local header
+func (c *Chain33) VerifyStateProof(in *rpctypes.StateProof, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	fields, err := parseProofs([]string{in.StateHash, in.Key, in.Value, in.Proof})
+	if err != nil {
+		return err
+	}
+	proof := &types.StateProof{Height: in.Height, StateHash: fields[0], Key: fields[1], Value: fields[2], Proof: fields[3]}
+	reply, err := c.cli.VerifyStateProof(proof)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
+// VerifyTxProof verify merkle proof of the raw tx with the local header
+func (c *Chain33) VerifyTxProof(in *rpctypes.ReqVerifyTxProof, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	raw, err := common.FromHex(in.Tx)
+	if err != nil {
+		return err
+	}
+	var tx types.Transaction
+	err = types.Decode(raw, &tx)
+	if err != nil {
+		return err
+	}
+	detail := &types.TransactionDetail{Tx: &tx, Height: in.Height, Index: in.Index}
+	detail.Proofs, err = parseProofs(in.Proofs)
+	if err != nil {
+		return err
+	}
+	for _, txproof := range in.TxProofs {
+		proofs, err := parseProofs(txproof.Proofs)
+		if err != nil {
+			return err
+		}
+		var root []byte
+		if txproof.RootHash != "" {
+			root, err = common.FromHex(txproof.RootHash)
+			if err != nil {
+				return err
+			}
+		}
+		detail.TxProofs = append(detail.TxProofs, &types.TxProof{Proofs: proofs, Index: txproof.Index, RootHash: root})
+	}
+	reply, err := c.cli.VerifyTxProof(detail)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
+func parseProofs(in []string) ([][]byte, error) {
+	var proofs [][]byte
+	for _, hex := range in {
+		proof, err := common.FromHex(hex)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs, nil
+}
+
+// GetCheckpoints get all checkpoints
+func (c *Chain33) GetCheckpoints(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetCheckpoints()
+	if err != nil {
+		return err
+	}
+	var points []*rpctypes.Checkpoint
+	for _, item := range reply.GetItems() {
+		points = append(points, &rpctypes.Checkpoint{Height: item.GetHeight(), Hash: common.ToHex(item.GetHash())})
+	}
+	*result = points
+	return nil
+}
+
+// GetReindexProgress get reindex progress
+func (c *Chain33) GetReindexProgress(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetReindexProgress()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// GetBlockStateDiff get state keys changed by block with old and new values
+func (c *Chain33) GetBlockStateDiff(in *rpctypes.ReqBlockStateDiff, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetBlockStateDiff(&types.ReqBlockStateDiff{Height: in.Height, Hash: hash})
+	if err != nil {
+		return err
+	}
+	*result = convertStateDiff(reply)
+	return nil
+}
+
+// GetStateDiff get difference between two state hashes
+func (c *Chain33) GetStateDiff(in *rpctypes.ReqStateDiff, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	oldStateHash, err := common.FromHex(in.OldStateHash)
+	if err != nil {
+		return err
+	}
+	newStateHash, err := common.FromHex(in.NewStateHash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetStateDiff(&types.ReqStateDiff{OldStateHash: oldStateHash, NewStateHash: newStateHash})
+	if err != nil {
+		return err
+	}
+	*result = convertStateDiff(reply)
+	return nil
+}
+
+func convertStateDiff(diff *types.StateDiff) *rpctypes.StateDiff {
+	result := &rpctypes.StateDiff{
+		Height:       diff.GetHeight(),
+		BlockHash:    common.ToHex(diff.GetBlockHash()),
+		OldStateHash: common.ToHex(diff.GetOldStateHash()),
+		NewStateHash: common.ToHex(diff.GetNewStateHash()),
+	}
+	for _, item := range diff.GetItems() {
+		result.Items = append(result.Items, &rpctypes.KeyValueDiff{
+			Key:      common.ToHex(item.GetKey()),
+			Ty:       item.GetTy(),
+			OldValue: common.ToHex(item.GetOldValue()),
+			NewValue: common.ToHex(item.GetNewValue()),
+		})
+	}
+	return result
+}
+
+// SubTxEvents subscribe mempool tx lifecycle events, events are fetched by GetTxEvents
+func (c *Chain33) SubTxEvents(in *rpctypes.ReqSubTxEvents, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	req := &types.ReqSubTxEvents{Name: in.Name, Addrs: in.Addrs}
+	for _, h := range in.Hashes {
+		hash, err := common.FromHex(h)
+		if err != nil {
+			return err
+		}
+		req.Hashes = append(req.Hashes, hash)
+	}
+	reply, err := c.cli.SubTxEvents(req)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk()}
+	return nil
+}
+
+// GetTxEvents get mempool tx lifecycle events of the subscription, wait a while if there is no event
+func (c *Chain33) GetTxEvents(in *types.ReqString, result *interface{}) error {
+	reply, err := c.cli.GetTxEvents(in)
+	if err != nil {
+		return err
+	}
+	events := &rpctypes.TxLifecycleEvents{Events: make([]*rpctypes.TxLifecycleEvent, 0, len(reply.GetEvents()))}
+	for _, event := range reply.GetEvents() {
+		item := &rpctypes.TxLifecycleEvent{
+			Hash:   common.ToHex(event.GetHash()),
+			From:   event.GetFrom(),
+			Ty:     event.GetTy(),
+			Reason: event.GetReason(),
+			Height: event.GetHeight(),
+			Time:   event.GetTime(),
+		}
+		if len(event.GetReplacedBy()) > 0 {
+			item.ReplacedBy = common.ToHex(event.GetReplacedBy())
+		}
+		events.Events = append(events.Events, item)
+	}
+	*result = events
+	return nil
+}
+
+// UnSubTxEvents cancel the subscription of mempool tx lifecycle events
+func (c *Chain33) UnSubTxEvents(in *types.ReqString, result *interface{}) error {
+	reply, err := c.cli.UnSubTxEvents(in)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk()}
+	return nil
+}
+
+// GetReorgEvents get recent chain reorganization events
+func (c *Chain33) GetReorgEvents(in *types.ReqReorgEvents, result *interface{}) error {
+	reply, err := c.cli.GetReorgEvents(in)
+	if err != nil {
+		return err
+	}
+
+	var events rpctypes.ReorgEvents
+	for _, item := range reply.GetItems() {
+		event := &rpctypes.ReorgEvent{
+			Index:      item.GetIndex(),
+			ForkHeight: item.GetForkHeight(),
+			ForkHash:   common.ToHex(item.GetForkHash()),
+			Depth:      item.GetDepth(),
+			Time:       item.GetTime(),
+			Sequence:   item.GetSequence(),
+		}
+		for _, hash := range item.GetDetachedHashes() {
+			event.DetachedHashes = append(event.DetachedHashes, common.ToHex(hash))
+		}
+		for _, hash := range item.GetAttachedHashes() {
+			event.AttachedHashes = append(event.AttachedHashes, common.ToHex(hash))
+		}
+		events.Items = append(events.Items, event)
+	}
+	*result = &events
+	return nil
+}
+
 // GetTxByAddr get transaction by address
 // GetTxByAddr(parm *types.ReqAddr) (*types.ReplyTxInfo, error)
 func (c *Chain33) GetTxByAddr(in types.ReqAddr, result *interface{}) error {
@@ -289,6 +720,7 @@ func fmtTxDetail(tx *types.TransactionDetail, disableDetail bool) (*rpctypes.Tra
 
 	var recp rpctypes.ReceiptData
 	recp.Ty = tx.GetReceipt().GetTy()
+	recp.GasUsed = tx.GetReceipt().GetGasUsed()
 	logs := tx.GetReceipt().GetLogs()
 	if disableDetail {
 		logs = nil
@@ -640,6 +1072,82 @@ func (c *Chain33) GetLastMemPool(in types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// QueryMempoolTxs query mempool txs filtered by sender, executor, fee and age
+func (c *Chain33) QueryMempoolTxs(in *types.ReqMempoolTxs, result *interface{}) error {
+	reply, err := c.cli.QueryMempoolTxs(in)
+	if err != nil {
+		return err
+	}
+	txs := &rpctypes.ReplyMempoolTxs{Total: reply.GetTotal()}
+	for _, item := range reply.GetTxs() {
+		tx := item.GetTx()
+		tran, err := rpctypes.DecodeTx(tx)
+		if err != nil {
+			continue
+		}
+		tran.Amount, err = tx.Amount()
+		if err != nil {
+			tran.Amount = 0
+		}
+		txs.Txs = append(txs.Txs, &rpctypes.MempoolTx{Tx: tran, EnterTime: item.GetEnterTime()})
+	}
+	*result = txs
+	return nil
+}
+
+// EvictMempoolTxs evict txs from mempool, admin only
+func (c *Chain33) EvictMempoolTxs(in *rpctypes.ReqEvictMempoolTxs, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	req := &types.ReqEvictMempoolTxs{Addr: in.Addr}
+	for _, h := range in.Hashes {
+		hash, err := common.FromHex(h)
+		if err != nil {
+			return err
+		}
+		req.Hashes = append(req.Hashes, hash)
+	}
+	reply, err := c.cli.EvictMempoolTxs(req)
+	if err != nil {
+		return err
+	}
+	var hashes rpctypes.ReplyHashes
+	for _, hash := range reply.GetHashes() {
+		hashes.Hashes = append(hashes.Hashes, common.ToHex(hash))
+	}
+	*result = &hashes
+	return nil
+}
+
+// GetMempoolStats get statistics of mempool txs
+func (c *Chain33) GetMempoolStats(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetMempoolStats()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// GetScheduledTxs get txs waiting in mempool for their not-before height or time
+func (c *Chain33) GetScheduledTxs(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetScheduledTxs()
+	if err != nil {
+		return err
+	}
+	var txlist rpctypes.ReplyTxList
+	for _, tx := range reply.GetTxs() {
+		tran, err := rpctypes.DecodeTx(tx)
+		if err != nil {
+			continue
+		}
+		txlist.Txs = append(txlist.Txs, tran)
+	}
+	*result = &txlist
+	return nil
+}
+
 // GetProperFee get  contents in proper fee
 func (c *Chain33) GetProperFee(in types.ReqProperFee, result *interface{}) error {
 	reply, err := c.cli.GetProperFee(&in)
@@ -856,7 +1364,22 @@ func (c *Chain33) Query(in rpctypes.Query4Jrpc, result *interface{}) error {
 		log.Error("EventQuery1", "err", err.Error())
 		return err
 	}
-	resp, err := c.cli.Query(cfg.ExecName(in.Execer), in.FuncName, decodePayload)
+	if types.IsNilP(decodePayload) {
+		return types.ErrInvalidParam
+	}
+	query := &types.ChainExecutor{
+		Driver:   cfg.ExecName(in.Execer),
+		FuncName: in.FuncName,
+		Param:    types.Encode(decodePayload),
+		Height:   in.Height,
+	}
+	if in.StateHash != "" {
+		query.StateHash, err = common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+	}
+	resp, err := c.cli.QueryChain(query)
 	if err != nil {
 		log.Error("EventQuery2", "err", err.Error())
 		return err
@@ -1233,6 +1756,7 @@ func convertBlockDetails(details []*types.BlockDetail, retDetails *rpctypes.Bloc
 		for i, rp := range item.Receipts {
 			var recp rpctypes.ReceiptData
 			recp.Ty = rp.GetTy()
+			recp.GasUsed = rp.GetGasUsed()
 			for _, log := range rp.Logs {
 				recp.Logs = append(recp.Logs,
 					&rpctypes.ReceiptLog{Ty: log.Ty, Log: common.ToHex(log.GetLog())})
@@ -614,6 +614,137 @@ func TestChain33_GetLastHeader(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_GetLightHeader(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("GetLightHeader", &types.ReqInt{Height: -1}).Return(&types.Header{Height: 10, Hash: []byte("hash")}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetLightHeader(&types.ReqInt{Height: -1}, &testResult)
+	assert.NoError(t, err)
+	header := testResult.(*rpctypes.Header)
+	assert.Equal(t, int64(10), header.Height)
+	assert.Equal(t, common.ToHex([]byte("hash")), header.Hash)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_GetReorgEvents(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	event := &types.ReorgEvent{Index: 1, ForkHeight: 5, ForkHash: []byte("fork"), Depth: 1,
+		DetachedHashes: [][]byte{[]byte("main6")}, AttachedHashes: [][]byte{[]byte("side6"), []byte("side7")}}
+	api.On("GetReorgEvents", &types.ReqReorgEvents{Count: 1}).Return(&types.ReorgEvents{Items: []*types.ReorgEvent{event}}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetReorgEvents(&types.ReqReorgEvents{Count: 1}, &testResult)
+	assert.NoError(t, err)
+	events := testResult.(*rpctypes.ReorgEvents)
+	assert.Equal(t, 1, len(events.Items))
+	assert.Equal(t, common.ToHex([]byte("fork")), events.Items[0].ForkHash)
+	assert.Equal(t, []string{common.ToHex([]byte("main6"))}, events.Items[0].DetachedHashes)
+	assert.Equal(t, 2, len(events.Items[0].AttachedHashes))
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_TxEvents(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("SubTxEvents", &types.ReqSubTxEvents{Name: "sub", Hashes: [][]byte{[]byte("hash")}}).Return(&types.Reply{IsOk: true}, nil)
+	event := &types.TxLifecycleEvent{Hash: []byte("old"), Ty: types.TxEventReplaced, ReplacedBy: []byte("new")}
+	api.On("GetTxEvents", &types.ReqString{Data: "sub"}).Return(&types.TxLifecycleEvents{Events: []*types.TxLifecycleEvent{event}}, nil)
+	api.On("UnSubTxEvents", &types.ReqString{Data: "sub"}).Return(&types.Reply{IsOk: true}, nil)
+	testChain33 := newTestChain33(api)
+
+	var testResult interface{}
+	err := testChain33.SubTxEvents(&rpctypes.ReqSubTxEvents{Name: "sub", Hashes: []string{"0xzz"}}, &testResult)
+	assert.Error(t, err)
+	err = testChain33.SubTxEvents(&rpctypes.ReqSubTxEvents{Name: "sub", Hashes: []string{common.ToHex([]byte("hash"))}}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+
+	err = testChain33.GetTxEvents(&types.ReqString{Data: "sub"}, &testResult)
+	assert.NoError(t, err)
+	events := testResult.(*rpctypes.TxLifecycleEvents)
+	assert.Equal(t, 1, len(events.Events))
+	assert.Equal(t, common.ToHex([]byte("old")), events.Events[0].Hash)
+	assert.Equal(t, common.ToHex([]byte("new")), events.Events[0].ReplacedBy)
+
+	err = testChain33.UnSubTxEvents(&types.ReqString{Data: "sub"}, &testResult)
+	assert.NoError(t, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_AddCheckpoint(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	point := &types.ChainCheckpoint{Height: 1, Hash: []byte("hash"),
+		Signature: &types.Signature{Ty: types.SECP256K1, Pubkey: []byte("pub"), Signature: []byte("sign")}}
+	api.On("AddCheckpoint", point).Return(&types.Reply{IsOk: true}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	in := &rpctypes.Checkpoint{Height: 1, Hash: common.ToHex([]byte("hash")),
+		Signature: &rpctypes.Signature{Ty: types.SECP256K1, Pubkey: common.ToHex([]byte("pub")), Signature: common.ToHex([]byte("sign"))}}
+	err := testChain33.AddCheckpoint(in, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+	err = testChain33.AddCheckpoint(&rpctypes.Checkpoint{Height: 1}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_StateProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	proof := &types.StateProof{Height: 1, StateHash: []byte("state"), Key: []byte("key"), Value: []byte("value"), Proof: []byte("proof")}
+	api.On("GetStateProof", &types.ReqStateProof{Height: 1, Key: []byte("key")}).Return(proof, nil)
+	api.On("VerifyStateProof", proof).Return(&types.Reply{IsOk: true}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetStateProof(&rpctypes.ReqStateProof{Height: 1, Key: common.ToHex([]byte("key"))}, &testResult)
+	assert.NoError(t, err)
+	reply := testResult.(*rpctypes.StateProof)
+	assert.Equal(t, common.ToHex([]byte("proof")), reply.Proof)
+	err = testChain33.VerifyStateProof(reply, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+	err = testChain33.VerifyStateProof(&rpctypes.StateProof{Height: 1, Key: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_VerifyTxProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1}
+	detail := &types.TransactionDetail{Tx: tx, Height: 2, Index: 1, Proofs: [][]byte{[]byte("proof")},
+		TxProofs: []*types.TxProof{{Proofs: [][]byte{[]byte("txproof")}, Index: 1}}}
+	api.On("VerifyTxProof", mock.MatchedBy(func(in *types.TransactionDetail) bool {
+		return bytes.Equal(types.Encode(in), types.Encode(detail))
+	})).Return(&types.Reply{IsOk: true}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	in := &rpctypes.ReqVerifyTxProof{Tx: common.ToHex(types.Encode(tx)), Height: 2, Index: 1, Proofs: []string{common.ToHex([]byte("proof"))},
+		TxProofs: []*rpctypes.TxProof{{Proofs: []string{common.ToHex([]byte("txproof"))}, Index: 1}}}
+	err := testChain33.VerifyTxProof(in, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+	in.Tx = "0xzz"
+	err = testChain33.VerifyTxProof(in, &testResult)
+	assert.NotNil(t, err)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
 func TestChain33_GetTxByAddr(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1005,6 +1136,127 @@ func TestChain33_GetProperFee(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_CheckTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+
+	tx := &types.Transaction{Execer: []byte("none"), Fee: 100000}
+	reply := &types.ReplyCheckTx{Ok: true, Stages: []*types.TxCheckStage{{Name: "basic"}}, ProperFee: 100000}
+	api.On("CheckTransaction", mock.Anything).Return(reply, nil)
+
+	var testResult interface{}
+	err := testChain33.CheckTransaction(rpctypes.RawParm{Data: "0xzz"}, &testResult)
+	assert.Error(t, err)
+	err = testChain33.CheckTransaction(rpctypes.RawParm{Data: common.ToHex(types.Encode(tx))}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, reply, testResult)
+}
+
+func TestChain33_MempoolAdmin(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+
+	tx := &types.Transaction{Execer: []byte("none"), Fee: 100000}
+	req := &types.ReqMempoolTxs{Execer: "none"}
+	api.On("QueryMempoolTxs", req).Return(&types.ReplyMempoolTxs{Txs: []*types.MempoolTx{{Tx: tx, EnterTime: 10}}, Total: 1}, nil)
+	var testResult interface{}
+	err := testChain33.QueryMempoolTxs(req, &testResult)
+	assert.NoError(t, err)
+	txs := testResult.(*rpctypes.ReplyMempoolTxs)
+	assert.Equal(t, int64(1), txs.Total)
+	assert.Equal(t, 1, len(txs.Txs))
+	assert.Equal(t, "none", txs.Txs[0].Tx.Execer)
+	assert.Equal(t, int64(10), txs.Txs[0].EnterTime)
+
+	err = testChain33.EvictMempoolTxs(&rpctypes.ReqEvictMempoolTxs{Hashes: []string{"0xzz"}}, &testResult)
+	assert.Error(t, err)
+	api.On("EvictMempoolTxs", &types.ReqEvictMempoolTxs{Hashes: [][]byte{[]byte("hash")}, Addr: "addr"}).Return(&types.TxHashList{Hashes: [][]byte{[]byte("hash")}, Count: 1}, nil)
+	err = testChain33.EvictMempoolTxs(&rpctypes.ReqEvictMempoolTxs{Hashes: []string{common.ToHex([]byte("hash"))}, Addr: "addr"}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.ToHex([]byte("hash"))}, testResult.(*rpctypes.ReplyHashes).Hashes)
+
+	api.On("GetMempoolStats").Return(&types.MempoolStats{TxCount: 1}, nil)
+	err = testChain33.GetMempoolStats(&types.ReqNil{}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), testResult.(*types.MempoolStats).TxCount)
+
+	api.On("GetScheduledTxs").Return(&types.ReplyTxList{Txs: []*types.Transaction{{Execer: []byte("none"), NotBefore: 100}}}, nil)
+	err = testChain33.GetScheduledTxs(&types.ReqNil{}, &testResult)
+	assert.NoError(t, err)
+	scheduled := testResult.(*rpctypes.ReplyTxList)
+	assert.Equal(t, 1, len(scheduled.Txs))
+	assert.Equal(t, int64(100), scheduled.Txs[0].NotBefore)
+}
+
+func TestChain33_SimulateTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+
+	tx := &types.Transaction{Execer: []byte("coins"), Fee: 100000}
+	fee := &types.ReceiptAccountTransfer{Prev: &types.Account{Balance: 200000}, Current: &types.Account{Balance: 100000}}
+	receipt := &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: []byte("key"), Value: []byte("value")}},
+		Logs: []*types.ReceiptLog{{Ty: types.TyLogFee, Log: types.Encode(fee)}},
+	}
+	matchReq := mock.MatchedBy(func(req *types.ReqSimulateTx) bool {
+		return string(req.StateHash) == "hash" && req.Height == 1 && string(req.Tx.Execer) == "coins"
+	})
+	api.On("SimulateTransaction", matchReq).Return(&types.ReplySimulateTx{Receipt: receipt, Fee: 100000}, nil)
+
+	var testResult interface{}
+	err := testChain33.SimulateTransaction(rpctypes.ReqSimulateTx{Data: "0xzz"}, &testResult)
+	assert.Error(t, err)
+	err = testChain33.SimulateTransaction(rpctypes.ReqSimulateTx{Data: common.ToHex(types.Encode(tx)), StateHash: common.ToHex([]byte("hash")), Height: 1}, &testResult)
+	assert.NoError(t, err)
+	result := testResult.(*rpctypes.ReplySimulateTx)
+	assert.Equal(t, int64(100000), result.Fee)
+	assert.Equal(t, "ExecOk", result.Receipt.TyName)
+	assert.Equal(t, "LogFee", result.Receipt.Logs[0].TyName)
+	assert.Equal(t, []*rpctypes.KeyValue{{Key: common.ToHex([]byte("key")), Value: common.ToHex([]byte("value"))}}, result.KV)
+}
+
+func TestChain33_TraceTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	testChain33 := newTestChain33(api)
+
+	fee := &types.ReceiptAccountTransfer{Prev: &types.Account{Balance: 200000}, Current: &types.Account{Balance: 100000}}
+	receipt := &types.Receipt{
+		Ty:      types.ExecPack,
+		KV:      []*types.KeyValue{{Key: []byte("key"), Value: []byte("value")}},
+		Logs:    []*types.ReceiptLog{{Ty: types.TyLogFee, Log: types.Encode(fee)}},
+		GasUsed: 100,
+	}
+	ops := []*types.TraceOp{
+		{Ty: "stateGet", Key: []byte("key"), Value: []byte("value")},
+		{Ty: "rollback", Info: types.ErrNoBalance.Error()},
+	}
+	reply := &types.ReplyTraceTx{Receipt: receipt, Ops: ops, Tx: &types.Transaction{Execer: []byte("coins")}}
+	api.On("TraceTransaction", &types.ReqHash{Hash: []byte("hash")}).Return(reply, nil)
+
+	var testResult interface{}
+	err := testChain33.TraceTransaction(rpctypes.QueryParm{Hash: "0xzz"}, &testResult)
+	assert.Error(t, err)
+	err = testChain33.TraceTransaction(rpctypes.QueryParm{Hash: common.ToHex([]byte("hash"))}, &testResult)
+	assert.NoError(t, err)
+	result := testResult.(*rpctypes.ReplyTraceTx)
+	assert.Equal(t, "ExecPack", result.Receipt.TyName)
+	assert.Equal(t, int64(100), result.Receipt.GasUsed)
+	assert.Equal(t, []*rpctypes.KeyValue{{Key: common.ToHex([]byte("key")), Value: common.ToHex([]byte("value"))}}, result.KV)
+	assert.Equal(t, []*rpctypes.TraceOp{
+		{Ty: "stateGet", Key: common.ToHex([]byte("key")), Value: common.ToHex([]byte("value"))},
+		{Ty: "rollback", Info: types.ErrNoBalance.Error()},
+	}, result.Ops)
+}
+
 func TestChain33_GetBlockOverview(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1460,6 +1712,30 @@ func TestChain33_Query(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestChain33_QueryHistory(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	matchReq := mock.MatchedBy(func(req *types.ChainExecutor) bool {
+		return req.Driver == "coins" && req.FuncName == "GetTxsByAddr" && req.Height == 10 && string(req.StateHash) == "hash"
+	})
+	api.On("QueryChain", matchReq).Return(&types.ReplyTxInfos{}, nil)
+	var testResult interface{}
+	in := rpctypes.Query4Jrpc{
+		Execer:    "coins",
+		FuncName:  "GetTxsByAddr",
+		Payload:   []byte(`{"addr":"1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}`),
+		Height:    10,
+		StateHash: common.ToHex([]byte("hash")),
+	}
+	err := client.Query(in, &testResult)
+	assert.Nil(t, err)
+	in.StateHash = "0xzz"
+	err = client.Query(in, &testResult)
+	assert.NotNil(t, err)
+}
+
 func TestChain33_DumpPrivkey(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1623,3 +1899,45 @@ func TestChain33_QueryTotalFee(t *testing.T) {
 	assert.Equal(t, total.Fee, queryTotalFee(client, req1, t))
 	assert.True(t, bytes.Equal(req.Keys[0], req1.Keys[0]))
 }
+
+func TestChain33_GetReindexProgress(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("GetReindexProgress").Return(&types.ReindexProgress{Running: true, Total: 100, Done: 50}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetReindexProgress(&types.ReqNil{}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), testResult.(*types.ReindexProgress).Done)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_GetBlockStateDiff(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	diff := &types.StateDiff{
+		Height:    1,
+		BlockHash: []byte{1},
+		Items:     []*types.KeyValueDiff{{Key: []byte{2}, Ty: types.StateDiffModify, OldValue: []byte{3}, NewValue: []byte{4}}},
+	}
+	api.On("GetBlockStateDiff", &types.ReqBlockStateDiff{Hash: []byte{1}}).Return(diff, nil)
+	api.On("GetStateDiff", &types.ReqStateDiff{OldStateHash: []byte{5}, NewStateHash: []byte{6}}).Return(diff, nil)
+	testChain33 := newTestChain33(api)
+
+	var testResult interface{}
+	err := testChain33.GetBlockStateDiff(&rpctypes.ReqBlockStateDiff{Hash: "0x01"}, &testResult)
+	assert.NoError(t, err)
+	result := testResult.(*rpctypes.StateDiff)
+	assert.Equal(t, "0x01", result.BlockHash)
+	assert.Equal(t, &rpctypes.KeyValueDiff{Key: "0x02", Ty: types.StateDiffModify, OldValue: "0x03", NewValue: "0x04"}, result.Items[0])
+
+	err = testChain33.GetStateDiff(&rpctypes.ReqStateDiff{OldStateHash: "0x05", NewStateHash: "0x06"}, &testResult)
+	assert.NoError(t, err)
+	err = testChain33.GetStateDiff(&rpctypes.ReqStateDiff{OldStateHash: "0xzz"}, &testResult)
+	assert.Error(t, err)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
@@ -30,6 +30,12 @@ var (
 	jrpcFuncBlacklist           = make(map[string]bool)
 	grpcFuncBlacklist           = make(map[string]bool)
 	rpcFilterPrintFuncBlacklist = make(map[string]bool)
+	//管理员接口，远程调用时需要在方法白名单中明确配置，"*"不包括管理员接口
+	rpcAdminFuncs = map[string]bool{
+		"EvictMempoolTxs": true,
+		//推送地址由调用方指定，远程调用可以让节点向任意地址推送数据
+		"AddSeqCallBack": true,
+	}
 )
 
 // Chain33  a channel client
@@ -123,6 +129,15 @@ func checkJrpcFuncBlacklist(funcName string) bool {
 	}
 	return false
 }
+
+// checkAdminFunc 远程调用管理员接口需要在白名单中明确配置
+func checkAdminFunc(funcName string, whitelist map[string]bool) bool {
+	if !rpcAdminFuncs[funcName] {
+		return true
+	}
+	return whitelist[funcName]
+}
+
 func checkGrpcFuncBlacklist(funcName string) bool {
 	if _, ok := grpcFuncBlacklist[funcName]; ok {
 		return true
@@ -161,6 +176,14 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 		return handler(ctx, req)
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	//stream接口同样需要做权限检查
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := auth(ss.Context(), &grpc.UnaryServerInfo{FullMethod: info.FullMethod}); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	opts = append(opts, grpc.StreamInterceptor(streamInterceptor))
 	if rpcCfg.EnableTLS {
 		creds, err := credentials.NewServerTLSFromFile(rpcCfg.CertFile, rpcCfg.KeyFile)
 		if err != nil {
@@ -6,6 +6,7 @@ package rpc
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -253,4 +254,37 @@ func TestCheckFuncList(t *testing.T) {
 	grpcFuncBlacklist[funcName] = true
 	assert.True(t, checkGrpcFuncBlacklist(funcName))
 
+	//管理员接口需要在白名单中明确配置
+	whitelist := map[string]bool{"*": true}
+	assert.True(t, checkAdminFunc(funcName, whitelist))
+	assert.False(t, checkAdminFunc("EvictMempoolTxs", whitelist))
+	whitelist["EvictMempoolTxs"] = true
+	assert.True(t, checkAdminFunc("EvictMempoolTxs", whitelist))
+	assert.False(t, checkAdminFunc("AddSeqCallBack", whitelist))
+}
+
+func TestUpgradeServer(t *testing.T) {
+	rpcCfg = new(types.RPC)
+	rpcCfg.JrpcBindAddr = "127.0.0.1:0"
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetReindexProgress").Return(&types.ReindexProgress{Running: true, Total: 100, Done: 50}, nil)
+	api.On("Close").Return()
+	server := NewUpgradeServer(nil, api)
+	port, err := server.Listen()
+	assert.Nil(t, err)
+
+	jsonClient, err := jsonclient.NewJSONClient(fmt.Sprintf("http://127.0.0.1:%d", port))
+	assert.Nil(t, err)
+	var progress types.ReindexProgress
+	err = jsonClient.Call("Chain33.GetReindexProgress", &types.ReqNil{}, &progress)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50), progress.Done)
+	//升级期间不提供其他接口
+	var isSync bool
+	err = jsonClient.Call("Chain33.IsSync", &types.ReqNil{}, &isSync)
+	assert.NotNil(t, err)
+	server.Close()
+	mock.AssertExpectationsForObjects(t, api)
 }
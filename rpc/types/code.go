@@ -28,7 +28,7 @@ func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
 	default:
 		rTy = "Unknown"
 	}
-	rd := &ReceiptDataResult{Ty: rlog.Ty, TyName: rTy}
+	rd := &ReceiptDataResult{Ty: rlog.Ty, TyName: rTy, GasUsed: rlog.GasUsed}
 	for _, l := range rlog.Logs {
 		var lTy string
 		var logIns json.RawMessage
@@ -130,6 +130,10 @@ func DecodeTx(tx *types.Transaction) (*Transaction, error) {
 		Header:     common.ToHex(tx.Header),
 		Next:       common.ToHex(tx.Next),
 		Hash:       common.ToHex(tx.Hash()),
+		NotBefore:  tx.NotBefore,
+	}
+	if len(tx.ReplaceHash) > 0 {
+		result.ReplaceHash = common.ToHex(tx.ReplaceHash)
 	}
 	feeResult := strconv.FormatFloat(float64(tx.Fee)/float64(types.Coin), 'f', 4, 64)
 	result.FeeFmt = feeResult
@@ -57,6 +57,106 @@ type Header struct {
 	Signature  *Signature `json:"signature,omitempty"`
 }
 
+// ReorgEvent chain reorganization event
+type ReorgEvent struct {
+	Index          int64    `json:"index"`
+	ForkHeight     int64    `json:"forkHeight"`
+	ForkHash       string   `json:"forkHash"`
+	DetachedHashes []string `json:"detachedHashes"`
+	AttachedHashes []string `json:"attachedHashes"`
+	Depth          int64    `json:"depth"`
+	Time           int64    `json:"time"`
+	Sequence       int64    `json:"sequence"`
+}
+
+// ReorgEvents recent chain reorganization events
+type ReorgEvents struct {
+	Items []*ReorgEvent `json:"items"`
+}
+
+// ReqBlockStateDiff query the state changed by a block, hash has priority over height
+type ReqBlockStateDiff struct {
+	Height int64  `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// ReqStateDiff query the difference between two state hashes
+type ReqStateDiff struct {
+	OldStateHash string `json:"oldStateHash"`
+	NewStateHash string `json:"newStateHash"`
+}
+
+// KeyValueDiff changed state key with old and new value
+type KeyValueDiff struct {
+	Key      string `json:"key"`
+	Ty       int32  `json:"ty"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// StateDiff state difference ordered by key
+type StateDiff struct {
+	Height       int64           `json:"height"`
+	BlockHash    string          `json:"blockHash"`
+	OldStateHash string          `json:"oldStateHash"`
+	NewStateHash string          `json:"newStateHash"`
+	Items        []*KeyValueDiff `json:"items"`
+}
+
+// ReqSubTxEvents subscribe mempool tx lifecycle events by tx hash or address, all txs if both are empty
+type ReqSubTxEvents struct {
+	Name   string   `json:"name"`
+	Hashes []string `json:"hashes"`
+	Addrs  []string `json:"addrs"`
+}
+
+// TxLifecycleEvent mempool tx lifecycle event
+type TxLifecycleEvent struct {
+	Hash       string `json:"hash"`
+	From       string `json:"from"`
+	Ty         int32  `json:"ty"`
+	Reason     string `json:"reason,omitempty"`
+	Height     int64  `json:"height,omitempty"`
+	ReplacedBy string `json:"replacedBy,omitempty"`
+	Time       int64  `json:"time"`
+}
+
+// TxLifecycleEvents mempool tx lifecycle events
+type TxLifecycleEvents struct {
+	Events []*TxLifecycleEvent `json:"events"`
+}
+
+// Checkpoint chain checkpoint, signature is required when added at runtime
+type Checkpoint struct {
+	Height    int64      `json:"height"`
+	Hash      string     `json:"hash"`
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+// ReqStateProof get proof of the state key at the height
+type ReqStateProof struct {
+	Height int64  `json:"height"`
+	Key    string `json:"key"`
+}
+
+// StateProof mavl proof of the state key
+type StateProof struct {
+	Height    int64  `json:"height"`
+	StateHash string `json:"stateHash"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Proof     string `json:"proof"`
+}
+
+// ReqVerifyTxProof verify merkle proof of the raw tx at the height
+type ReqVerifyTxProof struct {
+	Tx       string     `json:"tx"`
+	Height   int64      `json:"height"`
+	Index    int64      `json:"index"`
+	Proofs   []string   `json:"proofs"`
+	TxProofs []*TxProof `json:"txProofs"`
+}
+
 // Signature parameter
 type Signature struct {
 	Ty        int32  `json:"ty"`
@@ -66,22 +166,24 @@ type Signature struct {
 
 // Transaction parameter
 type Transaction struct {
-	Execer     string          `json:"execer"`
-	Payload    json.RawMessage `json:"payload"`
-	RawPayload string          `json:"rawPayload"`
-	Signature  *Signature      `json:"signature"`
-	Fee        int64           `json:"fee"`
-	FeeFmt     string          `json:"feefmt"`
-	Expire     int64           `json:"expire"`
-	Nonce      int64           `json:"nonce"`
-	From       string          `json:"from,omitempty"`
-	To         string          `json:"to"`
-	Amount     int64           `json:"amount,omitempty"`
-	AmountFmt  string          `json:"amountfmt,omitempty"`
-	GroupCount int32           `json:"groupCount,omitempty"`
-	Header     string          `json:"header,omitempty"`
-	Next       string          `json:"next,omitempty"`
-	Hash       string          `json:"hash,omitempty"`
+	Execer      string          `json:"execer"`
+	Payload     json.RawMessage `json:"payload"`
+	RawPayload  string          `json:"rawPayload"`
+	Signature   *Signature      `json:"signature"`
+	Fee         int64           `json:"fee"`
+	FeeFmt      string          `json:"feefmt"`
+	Expire      int64           `json:"expire"`
+	Nonce       int64           `json:"nonce"`
+	From        string          `json:"from,omitempty"`
+	To          string          `json:"to"`
+	Amount      int64           `json:"amount,omitempty"`
+	AmountFmt   string          `json:"amountfmt,omitempty"`
+	GroupCount  int32           `json:"groupCount,omitempty"`
+	Header      string          `json:"header,omitempty"`
+	Next        string          `json:"next,omitempty"`
+	Hash        string          `json:"hash,omitempty"`
+	ReplaceHash string          `json:"replaceHash,omitempty"`
+	NotBefore   int64           `json:"notBefore,omitempty"`
 }
 
 // ReceiptLog defines receipt log command
@@ -92,15 +194,17 @@ type ReceiptLog struct {
 
 // ReceiptData defines receipt data rpc command
 type ReceiptData struct {
-	Ty   int32         `json:"ty"`
-	Logs []*ReceiptLog `json:"logs"`
+	Ty      int32         `json:"ty"`
+	Logs    []*ReceiptLog `json:"logs"`
+	GasUsed int64         `json:"gasUsed,omitempty"`
 }
 
 // ReceiptDataResult receipt data result
 type ReceiptDataResult struct {
-	Ty     int32               `json:"ty"`
-	TyName string              `json:"tyName"`
-	Logs   []*ReceiptLogResult `json:"logs"`
+	Ty      int32               `json:"ty"`
+	TyName  string              `json:"tyName"`
+	Logs    []*ReceiptLogResult `json:"logs"`
+	GasUsed int64               `json:"gasUsed,omitempty"`
 }
 
 // ReceiptLogResult receipt log result
@@ -187,6 +291,63 @@ type ReplyTxList struct {
 	Txs []*Transaction `json:"txs"`
 }
 
+// MempoolTx tx in mempool with enter time
+type MempoolTx struct {
+	Tx        *Transaction `json:"tx"`
+	EnterTime int64        `json:"enterTime"`
+}
+
+// ReplyMempoolTxs reply mempool txs matching the query
+type ReplyMempoolTxs struct {
+	Txs   []*MempoolTx `json:"txs"`
+	Total int64        `json:"total"`
+}
+
+// ReqEvictMempoolTxs evict txs of hashes and all txs sent by addr from mempool
+type ReqEvictMempoolTxs struct {
+	Hashes []string `json:"hashes"`
+	Addr   string   `json:"addr"`
+}
+
+// ReqSimulateTx simulate the raw tx on the state of stateHash or of the block at height, the latest state if both are empty.
+// The localdb only holds the latest data, so it can not be read when simulating on a history state,
+// and the simulation fails with ErrDisableRead if the executor reads the localdb.
+type ReqSimulateTx struct {
+	Data      string `json:"data"`
+	StateHash string `json:"stateHash"`
+	Height    int64  `json:"height"`
+}
+
+// KeyValue hex encoded key and value
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ReplySimulateTx result of the simulated tx, nothing is persisted
+type ReplySimulateTx struct {
+	Receipt *ReceiptDataResult `json:"receipt"`
+	KV      []*KeyValue        `json:"kv"`
+	LocalKV []*KeyValue        `json:"localKV"`
+	Fee     int64              `json:"fee"`
+	Error   string             `json:"error"`
+}
+
+// TraceOp one db access or execution step of the traced tx, key and value are hex encoded
+type TraceOp struct {
+	Ty    string `json:"ty"`
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+	Info  string `json:"info,omitempty"`
+}
+
+// ReplyTraceTx trace of the tx re-executed in its block
+type ReplyTraceTx struct {
+	Receipt *ReceiptDataResult `json:"receipt"`
+	KV      []*KeyValue        `json:"kv"`
+	Ops     []*TraceOp         `json:"ops"`
+}
+
 // ReplyProperFee reply proper fee
 type ReplyProperFee struct {
 	ProperFee int64 `json:"properFee"`
@@ -290,11 +451,13 @@ type BlockOverview struct {
 	TxHashes []string `json:"txHashes"`
 }
 
-// Query4Jrpc query jrpc
+// Query4Jrpc query jrpc, query on the state of stateHash or of the block at height (not both), the latest state otherwise
 type Query4Jrpc struct {
-	Execer   string          `json:"execer"`
-	FuncName string          `json:"funcName"`
-	Payload  json.RawMessage `json:"payload"`
+	Execer    string          `json:"execer"`
+	FuncName  string          `json:"funcName"`
+	Payload   json.RawMessage `json:"payload"`
+	Height    int64           `json:"height,omitempty"`
+	StateHash string          `json:"stateHash,omitempty"`
 }
 
 // ChainExecutor chain executor
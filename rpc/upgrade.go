@@ -0,0 +1,58 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"net/rpc"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+// UpgradeServer json rpc server used while the blockchain upgrades, before the rpc module starts.
+// Only GetReindexProgress is served, other methods are not available until startup finishes.
+type UpgradeServer struct {
+	JSONRPCServer
+	api client.QueueProtocolAPI
+}
+
+type upgradeChain33 struct {
+	api client.QueueProtocolAPI
+}
+
+// GetReindexProgress get reindex progress
+func (c *upgradeChain33) GetReindexProgress(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.api.GetReindexProgress()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// NewUpgradeServer new UpgradeServer object, rpcCfg must be initialized by InitCfg
+func NewUpgradeServer(c queue.Client, api client.QueueProtocolAPI) *UpgradeServer {
+	if api == nil {
+		var err error
+		api, err = client.New(c, nil)
+		if err != nil {
+			panic(err)
+		}
+	}
+	u := &UpgradeServer{api: api}
+	u.s = rpc.NewServer()
+	err := u.s.RegisterName("Chain33", &upgradeChain33{api: api})
+	if err != nil {
+		return nil
+	}
+	return u
+}
+
+// Close close the listener, the rpc module listens on the same address afterwards
+func (u *UpgradeServer) Close() {
+	u.JSONRPCServer.Close()
+	u.api.Close()
+}
@@ -616,6 +616,11 @@ func isExpire(cfg *types.Chain33Config, txs []*types.Transaction, height int64,
 			log.Debug("isExpire", "height", height, "blocktime", blocktime, "hash", common.ToHex(tx.Hash()), "Expire", tx.Expire)
 			return true
 		}
+		//还没有生效的交易同样不能打包
+		if height > 0 && !tx.IsMature(height, blocktime) {
+			log.Debug("isExpire not mature", "height", height, "blocktime", blocktime, "hash", common.ToHex(tx.Hash()), "NotBefore", tx.NotBefore)
+			return true
+		}
 	}
 	return false
 }
@@ -124,7 +124,7 @@ func (client *Client) CreateBlock() {
 		var newblock types.Block
 		newblock.ParentHash = lastBlock.Hash(cfg)
 		newblock.Height = lastBlock.Height + 1
-		client.AddTxsToBlock(&newblock, txs)
+		client.BuildBlockTemplate(&newblock, txs)
 		//solo 挖矿固定难度
 		newblock.Difficulty = cfg.GetP(0).PowLimitBits
 		//需要首先对交易进行排序然后再计算TxHash
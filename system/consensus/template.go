@@ -0,0 +1,167 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"container/heap"
+
+	"github.com/33cn/chain33/types"
+)
+
+//BlockTemplate 按照手续费率从mempool返回的交易中选择交易打包，使区块的手续费尽量高
+//交易组作为一个整体打包，同一个账户的交易(包括交易组中的交易)按照mempool返回的先后顺序打包
+type BlockTemplate struct {
+	//区块的最大字节数
+	MaxBytes int
+	//区块的最大交易数目
+	MaxTxs int64
+}
+
+//txPackage 打包的单元，单笔交易或者一个交易组
+type txPackage struct {
+	txs   []*types.Transaction
+	fee   int64
+	size  int
+	score int64
+	index int
+	//依赖的同一个账户之前的交易数目，为0时才可以打包
+	parents  int
+	children []*txPackage
+	skipped  bool
+}
+
+func newTxPackage(txs []*types.Transaction, index int) *txPackage {
+	pkg := &txPackage{txs: txs, index: index}
+	for _, tx := range txs {
+		pkg.fee += tx.Fee
+		pkg.size += tx.Size()
+	}
+	if pkg.size > 0 {
+		pkg.score = pkg.fee * 1000 / int64(pkg.size)
+	}
+	return pkg
+}
+
+//Pack 选择交易加入区块，返回加入的交易，交易组会展开加入
+func (t *BlockTemplate) Pack(block *types.Block, txs []*types.Transaction) []*types.Transaction {
+	size := block.Size()
+	count := int64(len(block.Txs))
+	dup := make(map[string]bool)
+	for _, tx := range block.Txs {
+		dup[string(tx.Hash())] = true
+	}
+	//同一个账户最后一个交易所在的打包单元
+	lastOfAddr := make(map[string]*txPackage)
+	var ready packageHeap
+	for i, tx := range txs {
+		group, err := tx.GetTxGroup()
+		if err != nil {
+			continue
+		}
+		pkgTxs := []*types.Transaction{tx}
+		if group != nil {
+			pkgTxs = group.Txs
+		}
+		//重复的交易只打包第一次出现的
+		var conflict bool
+		for _, tx := range pkgTxs {
+			if dup[string(tx.Hash())] {
+				conflict = true
+			}
+		}
+		if conflict {
+			continue
+		}
+		for _, tx := range pkgTxs {
+			dup[string(tx.Hash())] = true
+		}
+		pkg := newTxPackage(pkgTxs, i)
+		for _, tx := range pkgTxs {
+			from := tx.From()
+			parent := lastOfAddr[from]
+			if parent != nil && parent != pkg {
+				parent.children = append(parent.children, pkg)
+				pkg.parents++
+			}
+			lastOfAddr[from] = pkg
+		}
+		if pkg.parents == 0 {
+			ready = append(ready, pkg)
+		}
+	}
+	heap.Init(&ready)
+
+	added := make([]*types.Transaction, 0, len(txs))
+	for ready.Len() > 0 {
+		pkg := heap.Pop(&ready).(*txPackage)
+		if count+int64(len(pkg.txs)) > t.MaxTxs || size+pkg.size > t.MaxBytes {
+			//放不下的交易之后，同一个账户的交易都不能打包
+			pkg.skip()
+			continue
+		}
+		count += int64(len(pkg.txs))
+		size += pkg.size
+		added = append(added, pkg.txs...)
+		block.Txs = append(block.Txs, pkg.txs...)
+		for _, child := range pkg.children {
+			child.parents--
+			if child.parents == 0 && !child.skipped {
+				heap.Push(&ready, child)
+			}
+		}
+	}
+	return added
+}
+
+func (pkg *txPackage) skip() {
+	pkg.skipped = true
+	for _, child := range pkg.children {
+		if !child.skipped {
+			child.skip()
+		}
+	}
+}
+
+//packageHeap 按照手续费率从高到低排序，相同时按照mempool返回的顺序
+type packageHeap []*txPackage
+
+func (h packageHeap) Len() int { return len(h) }
+
+func (h packageHeap) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score > h[j].score
+	}
+	return h[i].index < h[j].index
+}
+
+func (h packageHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *packageHeap) Push(x interface{}) { *h = append(*h, x.(*txPackage)) }
+
+func (h *packageHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+//BuildBlockTemplate 按照手续费率选择交易加入区块，交易组保持完整，同一个账户的交易保持先后顺序
+//区块的大小和交易数目不超过配置的限制，没有配置时使用链的限制
+func (bc *BaseClient) BuildBlockTemplate(block *types.Block, txs []*types.Transaction) []*types.Transaction {
+	types.AssertConfig(bc.client)
+	cfg := bc.client.GetConfig()
+	t := &BlockTemplate{
+		MaxBytes: types.MaxBlockSize - 100000, //留下100K空间，添加其他的交易
+		MaxTxs:   cfg.GetP(block.Height).MaxTxNumber,
+	}
+	if bc.Cfg.MaxBlockBytes > 0 && int(bc.Cfg.MaxBlockBytes) < t.MaxBytes {
+		t.MaxBytes = int(bc.Cfg.MaxBlockBytes)
+	}
+	if bc.Cfg.MaxBlockTxs > 0 && bc.Cfg.MaxBlockTxs < t.MaxTxs {
+		t.MaxTxs = bc.Cfg.MaxBlockTxs
+	}
+	return t.Pack(block, txs)
+}
@@ -0,0 +1,111 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+var cfg = types.NewChain33Config(types.GetDefaultCfgstring())
+
+func newFeeTx(priv crypto.PrivKey, fee int64) *types.Transaction {
+	tx := util.CreateNoneTx(cfg, priv)
+	tx.Fee = fee
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func newGroupTx(t *testing.T, fee int64, privs ...crypto.PrivKey) *types.Transaction {
+	var txs []*types.Transaction
+	for _, priv := range privs {
+		txs = append(txs, newFeeTx(priv, 0))
+	}
+	group, err := types.CreateTxGroup(txs, cfg.GetMinTxFeeRate())
+	require.NoError(t, err)
+	group.Txs[0].Fee = fee
+	for i, priv := range privs {
+		require.NoError(t, group.SignN(i, types.SECP256K1, priv))
+	}
+	return group.Tx()
+}
+
+func hashes(txs []*types.Transaction) []string {
+	var list []string
+	for _, tx := range txs {
+		list = append(list, string(tx.Hash()))
+	}
+	return list
+}
+
+func TestBlockTemplateOrder(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	a1 := newFeeTx(priv1, 1e5)
+	a2 := newFeeTx(priv1, 1e7)
+	b1 := newFeeTx(priv2, 1e6)
+	template := &BlockTemplate{MaxBytes: types.MaxBlockSize, MaxTxs: 100}
+
+	block := &types.Block{}
+	added := template.Pack(block, []*types.Transaction{a1, a2, b1, a1})
+	//同一个账户的交易按照先后顺序打包，重复的交易只打包一次
+	assert.Equal(t, hashes([]*types.Transaction{b1, a1, a2}), hashes(added))
+	assert.Equal(t, added, block.Txs)
+
+	//区块中已经存在的交易不再打包
+	added = template.Pack(block, []*types.Transaction{b1})
+	assert.Equal(t, 0, len(added))
+}
+
+func TestBlockTemplateGroup(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	_, priv3 := util.Genaddress()
+	a1 := newFeeTx(priv1, 1e5)
+	group := newGroupTx(t, 1e8, priv1, priv2)
+	b2 := newFeeTx(priv2, 1e7)
+	c1 := newFeeTx(priv3, 1e6)
+	template := &BlockTemplate{MaxBytes: types.MaxBlockSize, MaxTxs: 100}
+
+	block := &types.Block{}
+	added := template.Pack(block, []*types.Transaction{a1, group, b2, c1})
+	txs, err := group.GetTxGroup()
+	require.NoError(t, err)
+	//交易组依赖priv1的a1，priv2的b2依赖交易组
+	assert.Equal(t, hashes([]*types.Transaction{c1, a1, txs.Txs[0], txs.Txs[1], b2}), hashes(added))
+
+	//交易数目不够时交易组不打包，同一个账户之后的交易也不打包
+	template.MaxTxs = 3
+	block = &types.Block{}
+	added = template.Pack(block, []*types.Transaction{a1, group, b2, c1})
+	assert.Equal(t, hashes([]*types.Transaction{c1, a1}), hashes(added))
+}
+
+func TestBlockTemplateLimit(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	_, priv3 := util.Genaddress()
+	a1 := newFeeTx(priv1, 1e5)
+	b1 := newFeeTx(priv2, 1e7)
+	c1 := newFeeTx(priv3, 1e6)
+
+	block := &types.Block{}
+	template := &BlockTemplate{MaxBytes: block.Size() + b1.Size() + c1.Size(), MaxTxs: 100}
+	added := template.Pack(block, []*types.Transaction{a1, b1, c1})
+	assert.Equal(t, hashes([]*types.Transaction{b1, c1}), hashes(added))
+
+	block = &types.Block{Txs: []*types.Transaction{a1}}
+	template = &BlockTemplate{MaxBytes: types.MaxBlockSize, MaxTxs: 2}
+	added = template.Pack(block, []*types.Transaction{c1, b1})
+	assert.Equal(t, hashes([]*types.Transaction{b1}), hashes(added))
+	assert.Equal(t, 2, len(block.Txs))
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
@@ -38,6 +39,8 @@ func BlockCmd() *cobra.Command {
 		AddBlockSeqCallBackCmd(),
 		ListBlockSeqCallBackCmd(),
 		GetSeqCallBackLastNumCmd(),
+		GetReindexProgressCmd(),
+		GetStateDiffCmd(),
 	)
 
 	return cmd
@@ -412,3 +415,96 @@ func getSeqCallBackLastNumCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetSeqCallBackLastNum", params, &res)
 	ctx.Run()
 }
+
+// GetStateDiffCmd get state keys changed by a block or between two state hashes
+func GetStateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state_diff",
+		Short: "Get state keys changed by block or between two state hashes",
+		Run:   stateDiff,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "block height")
+	cmd.Flags().StringP("hash", "s", "", "block hash, has priority over height")
+	cmd.Flags().StringP("old", "o", "", "old state hash, compare state hashes when set")
+	cmd.Flags().StringP("new", "n", "", "new state hash")
+	return cmd
+}
+
+func stateDiff(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	hash, _ := cmd.Flags().GetString("hash")
+	oldStateHash, _ := cmd.Flags().GetString("old")
+	newStateHash, _ := cmd.Flags().GetString("new")
+	var res rpctypes.StateDiff
+	if oldStateHash != "" || newStateHash != "" {
+		params := rpctypes.ReqStateDiff{OldStateHash: oldStateHash, NewStateHash: newStateHash}
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetStateDiff", params, &res)
+		ctx.Run()
+		return
+	}
+	params := rpctypes.ReqBlockStateDiff{Height: height, Hash: hash}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetBlockStateDiff", params, &res)
+	ctx.Run()
+}
+
+// GetReindexProgressCmd get reindex progress
+func GetReindexProgressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex_progress",
+		Short: "View reindex progress",
+		Run:   reindexProgress,
+	}
+	cmd.Flags().BoolP("watch", "w", false, "show progress bar until reindex complete")
+	return cmd
+}
+
+func reindexProgress(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	watch, _ := cmd.Flags().GetBool("watch")
+	if !watch {
+		var res types.ReindexProgress
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetReindexProgress", nil, &res)
+		ctx.Run()
+		return
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	for {
+		var res types.ReindexProgress
+		err = rpc.Call("Chain33.GetReindexProgress", nil, &res)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Printf("\r%s", reindexProgressBar(&res, 40))
+		if !res.Running {
+			fmt.Println()
+			return
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+//reindexProgressBar 显示重建索引的进度条，如[=====>    ] 50.00% 500/1000 10 blocks/s
+func reindexProgressBar(progress *types.ReindexProgress, width int) string {
+	var percent float64
+	if progress.Total > 0 {
+		percent = float64(progress.Done) / float64(progress.Total)
+	} else if !progress.Running {
+		percent = 1
+	}
+	filled := int(percent * float64(width))
+	bar := strings.Repeat("=", filled)
+	if filled < width {
+		bar += ">" + strings.Repeat(" ", width-filled-1)
+	}
+	var rate int64
+	if progress.Elapsed > 0 {
+		rate = progress.Done / progress.Elapsed
+	}
+	return fmt.Sprintf("[%s] %6.2f%% %d/%d %d blocks/s", bar, percent*100, progress.Done, progress.Total, rate)
+}
@@ -5,6 +5,8 @@
 package commands
 
 import (
+	"strings"
+
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/system/dapp/commands/types"
@@ -24,6 +26,10 @@ func MempoolCmd() *cobra.Command {
 		GetMempoolCmd(),
 		GetLastMempoolCmd(),
 		GetProperFeeCmd(),
+		QueryMempoolTxsCmd(),
+		EvictMempoolTxsCmd(),
+		GetMempoolStatsCmd(),
+		GetScheduledTxsCmd(),
 	)
 
 	return cmd
@@ -109,3 +115,124 @@ func properFee(cmd *cobra.Command, args []string) {
 	ctx.SetResultCb(nil)
 	ctx.Run()
 }
+
+// QueryMempoolTxsCmd query mempool txs with filter
+func QueryMempoolTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query mempool txs filtered by sender, executor, fee and age",
+		Run:   queryMempoolTxs,
+	}
+	addQueryMempoolTxsFlags(cmd)
+	return cmd
+}
+
+func addQueryMempoolTxsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("from", "f", "", "sender address")
+	cmd.Flags().StringP("exec", "e", "", "executor name")
+	cmd.Flags().Float64P("min_fee", "m", 0, "min transaction fee")
+	cmd.Flags().Float64P("max_fee", "x", 0, "max transaction fee (0: no limit)")
+	cmd.Flags().Int64P("min_age", "a", 0, "min seconds in mempool")
+	cmd.Flags().Int64P("max_age", "g", 0, "max seconds in mempool (0: no limit)")
+	cmd.Flags().Int64P("offset", "o", 0, "skip the first matched txs")
+	cmd.Flags().Int64P("count", "c", 0, "count of txs to return (default 100, max 1000)")
+}
+
+func queryMempoolTxs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	from, _ := cmd.Flags().GetString("from")
+	exec, _ := cmd.Flags().GetString("exec")
+	minFee, _ := cmd.Flags().GetFloat64("min_fee")
+	maxFee, _ := cmd.Flags().GetFloat64("max_fee")
+	minAge, _ := cmd.Flags().GetInt64("min_age")
+	maxAge, _ := cmd.Flags().GetInt64("max_age")
+	offset, _ := cmd.Flags().GetInt64("offset")
+	count, _ := cmd.Flags().GetInt64("count")
+	params := &ctypes.ReqMempoolTxs{
+		From:   from,
+		Execer: exec,
+		MinFee: int64(minFee*1e4) * 1e4,
+		MaxFee: int64(maxFee*1e4) * 1e4,
+		MinAge: minAge,
+		MaxAge: maxAge,
+		Offset: offset,
+		Count:  count,
+	}
+	var res rpctypes.ReplyMempoolTxs
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.QueryMempoolTxs", params, &res)
+	ctx.SetResultCb(parseQueryMempoolTxsRes)
+	ctx.Run()
+}
+
+func parseQueryMempoolTxsRes(arg interface{}) (interface{}, error) {
+	res := arg.(*rpctypes.ReplyMempoolTxs)
+	result := types.MempoolTxsResult{Total: res.Total}
+	for _, v := range res.Txs {
+		result.Txs = append(result.Txs, &types.MempoolTxResult{Tx: types.DecodeTransaction(v.Tx), EnterTime: v.EnterTime})
+	}
+	return result, nil
+}
+
+// EvictMempoolTxsCmd evict txs from mempool
+func EvictMempoolTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evict",
+		Short: "Evict txs of hashes or all txs sent by address from mempool (admin only)",
+		Run:   evictMempoolTxs,
+	}
+	addEvictMempoolTxsFlags(cmd)
+	return cmd
+}
+
+func addEvictMempoolTxsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hashes", "s", "", "transaction hash(es), separated by space")
+	cmd.Flags().StringP("addr", "a", "", "evict all txs sent by the address")
+}
+
+func evictMempoolTxs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hashes, _ := cmd.Flags().GetString("hashes")
+	addr, _ := cmd.Flags().GetString("addr")
+	params := &rpctypes.ReqEvictMempoolTxs{Addr: addr}
+	if hashes != "" {
+		params.Hashes = strings.Split(hashes, " ")
+	}
+	var res rpctypes.ReplyHashes
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.EvictMempoolTxs", params, &res)
+	ctx.Run()
+}
+
+// GetMempoolStatsCmd get statistics of mempool
+func GetMempoolStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Get mempool statistics: fee rate histogram, bytes per executor and oldest tx age",
+		Run:   mempoolStats,
+	}
+	return cmd
+}
+
+func mempoolStats(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ctypes.MempoolStats
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetMempoolStats", nil, &res)
+	ctx.Run()
+}
+
+// GetScheduledTxsCmd get txs waiting for their not-before height or time
+func GetScheduledTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled",
+		Short: "List txs waiting in mempool for their not-before height or time",
+		Run:   scheduledTxs,
+	}
+	return cmd
+}
+
+func scheduledTxs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res rpctypes.ReplyTxList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetScheduledTxs", nil, &res)
+	ctx.SetResultCb(parseListMempoolTxsRes)
+	ctx.Run()
+}
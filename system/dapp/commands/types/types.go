@@ -42,22 +42,36 @@ type TxListResult struct {
 	Txs []*TxResult `json:"txs"`
 }
 
+// MempoolTxResult defines mempool tx result command
+type MempoolTxResult struct {
+	Tx        *TxResult `json:"tx"`
+	EnterTime int64     `json:"enterTime"`
+}
+
+// MempoolTxsResult defines mempool txs query result command
+type MempoolTxsResult struct {
+	Txs   []*MempoolTxResult `json:"txs"`
+	Total int64              `json:"total"`
+}
+
 // TxResult defines txresult command
 type TxResult struct {
-	Execer     string              `json:"execer"`
-	Payload    interface{}         `json:"payload"`
-	RawPayload string              `json:"rawpayload"`
-	Signature  *rpctypes.Signature `json:"signature"`
-	Fee        string              `json:"fee"`
-	Expire     int64               `json:"expire"`
-	Nonce      int64               `json:"nonce"`
-	To         string              `json:"to"`
-	Amount     string              `json:"amount,omitempty"`
-	From       string              `json:"from,omitempty"`
-	GroupCount int32               `json:"groupCount,omitempty"`
-	Header     string              `json:"header,omitempty"`
-	Next       string              `json:"next,omitempty"`
-	Hash       string              `json:"hash,omitempty"`
+	Execer      string              `json:"execer"`
+	Payload     interface{}         `json:"payload"`
+	RawPayload  string              `json:"rawpayload"`
+	Signature   *rpctypes.Signature `json:"signature"`
+	Fee         string              `json:"fee"`
+	Expire      int64               `json:"expire"`
+	Nonce       int64               `json:"nonce"`
+	To          string              `json:"to"`
+	Amount      string              `json:"amount,omitempty"`
+	From        string              `json:"from,omitempty"`
+	GroupCount  int32               `json:"groupCount,omitempty"`
+	Header      string              `json:"header,omitempty"`
+	Next        string              `json:"next,omitempty"`
+	Hash        string              `json:"hash,omitempty"`
+	ReplaceHash string              `json:"replaceHash,omitempty"`
+	NotBefore   int64               `json:"notBefore,omitempty"`
 }
 
 // ReceiptAccountTransfer defines receipt account transfer
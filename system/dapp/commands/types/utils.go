@@ -29,20 +29,22 @@ import (
 // DecodeTransaction decode transaction function
 func DecodeTransaction(tx *rpctypes.Transaction) *TxResult {
 	result := &TxResult{
-		Execer:     tx.Execer,
-		Payload:    tx.Payload,
-		RawPayload: tx.RawPayload,
-		Signature:  tx.Signature,
-		Fee:        tx.FeeFmt,
-		Amount:     tx.AmountFmt,
-		Expire:     tx.Expire,
-		Nonce:      tx.Nonce,
-		To:         tx.To,
-		From:       tx.From,
-		GroupCount: tx.GroupCount,
-		Header:     tx.Header,
-		Next:       tx.Next,
-		Hash:       tx.Hash,
+		Execer:      tx.Execer,
+		Payload:     tx.Payload,
+		RawPayload:  tx.RawPayload,
+		Signature:   tx.Signature,
+		Fee:         tx.FeeFmt,
+		Amount:      tx.AmountFmt,
+		Expire:      tx.Expire,
+		Nonce:       tx.Nonce,
+		To:          tx.To,
+		From:        tx.From,
+		GroupCount:  tx.GroupCount,
+		Header:      tx.Header,
+		Next:        tx.Next,
+		Hash:        tx.Hash,
+		ReplaceHash: tx.ReplaceHash,
+		NotBefore:   tx.NotBefore,
 	}
 	return result
 }
@@ -314,6 +314,8 @@ func addSignRawTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
 	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional), auto set proper fee if not set or zero fee")
 	cmd.Flags().StringP("to", "t", "", "new to addr (optional)")
+	cmd.Flags().StringP("replace", "r", "", "hash of the pending transaction to replace, fee must be higher than the replaced one (optional)")
+	cmd.Flags().Int64P("not_before", "b", 0, "block height or unix time before which the transaction can not be packed (optional)")
 
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
@@ -354,6 +356,8 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	index, _ := cmd.Flags().GetInt32("index")
 	to, _ := cmd.Flags().GetString("to")
 	fee, _ := cmd.Flags().GetFloat64("fee")
+	replace, _ := cmd.Flags().GetString("replace")
+	notBefore, _ := cmd.Flags().GetInt64("not_before")
 	expire, _ := cmd.Flags().GetString("expire")
 	expire, err := commandtypes.CheckExpireOpt(expire)
 	if err != nil {
@@ -362,13 +366,15 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	}
 	feeInt64 := int64(fee * 1e4)
 	params := types.ReqSignRawTx{
-		Addr:      addr,
-		Privkey:   key,
-		TxHex:     data,
-		Expire:    expire,
-		Index:     index,
-		Fee:       feeInt64 * 1e4,
-		NewToAddr: to,
+		Addr:        addr,
+		Privkey:     key,
+		TxHex:       data,
+		Expire:      expire,
+		Index:       index,
+		Fee:         feeInt64 * 1e4,
+		NewToAddr:   to,
+		ReplaceHash: replace,
+		NotBefore:   notBefore,
 	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SignRawTx", params, nil)
 	ctx.RunWithoutMarshal()
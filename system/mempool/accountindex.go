@@ -52,6 +52,15 @@ func (cache *AccountTxIndex) GetAccTxs(addrs *types.ReqAddrs) *types.Transaction
 	return res
 }
 
+//WalkAccTxs 按照交易进入mempool的先后顺序遍历账户的交易
+func (cache *AccountTxIndex) WalkAccTxs(addr string, cb func(tx *types.Transaction) bool) {
+	if lm, ok := cache.accMap[addr]; ok {
+		lm.Walk(func(val interface{}) bool {
+			return cb(val.(*types.Transaction))
+		})
+	}
+}
+
 //Remove 根据交易哈希删除对应账户的对应交易
 func (cache *AccountTxIndex) Remove(tx *types.Transaction) {
 	addr := tx.From()
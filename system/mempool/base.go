@@ -32,6 +32,10 @@ type Mempool struct {
 	done              chan struct{}
 	removeBlockTicket *time.Ticker
 	cache             *txCache
+	journal           *txJournal
+	quota             *txQuota
+	txEvents          *txEventHub
+	scheduled         *scheduledTxs
 }
 
 //GetSync 判断是否mempool 同步
@@ -53,6 +57,21 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.ReplaceFeeBump == 0 {
+		cfg.ReplaceFeeBump = replaceFeeBump
+	}
+	if cfg.MaxScheduledTxs == 0 {
+		cfg.MaxScheduledTxs = maxScheduledTxs
+	}
+	if cfg.MaxScheduledTxsPerAccount == 0 {
+		cfg.MaxScheduledTxsPerAccount = maxScheduledTxsPerAcc
+	}
+	if cfg.MaxNotBeforeHeight == 0 {
+		cfg.MaxNotBeforeHeight = maxNotBeforeHeight
+	}
+	if cfg.MaxNotBeforeTime == 0 {
+		cfg.MaxNotBeforeTime = mempoolExpiredInterval
+	}
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -60,6 +79,15 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.poolHeader = make(chan struct{}, 2)
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast, cfg.PoolCacheSize)
+	pool.quota = newTxQuota(cfg)
+	pool.cache.quota = pool.quota
+	pool.txEvents = newTxEventHub()
+	pool.cache.events = pool.txEvents
+	pool.scheduled = newScheduledTxs(cfg.MaxScheduledTxs, cfg.MaxScheduledTxsPerAccount)
+	if cfg.JournalPath != "" {
+		pool.journal = newTxJournal(cfg.JournalPath)
+		pool.cache.journal = pool.journal
+	}
 	return pool
 }
 
@@ -76,6 +104,9 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	if mem.journal != nil {
+		mem.journal.close()
+	}
 	mlog.Info("mempool module closed")
 }
 
@@ -139,6 +170,10 @@ func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool, isAll bool
 		if isExpired(cfg, tx, height, blocktime) && !isAll {
 			return true
 		}
+		//区块回滚之后可能有还没有生效的交易
+		if !tx.Value.IsMature(height+1, types.Now().Unix()) && !isAll {
+			return true
+		}
 		txs = append(txs, tx.Value)
 		return true
 	})
@@ -162,6 +197,9 @@ func (mem *Mempool) RemoveTxs(hashList *types.TxHashList) error {
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
+	if len(tx.ReplaceHash) > 0 {
+		return mem.cache.Replace(tx, mem.cfg.ReplaceFeeBump)
+	}
 	err := mem.cache.Push(tx)
 	return err
 }
@@ -271,6 +309,7 @@ func (mem *Mempool) removeBlockedTxs() {
 				return
 			}
 			mem.removeExpired()
+			mem.releaseScheduledTxs()
 		case <-mem.done:
 			return
 		}
@@ -287,6 +326,7 @@ func (mem *Mempool) RemoveTxsOfBlock(block *types.Block) bool {
 		if exist {
 			mem.cache.Remove(string(hash))
 		}
+		mem.txEvents.emit(types.TxEventIncluded, tx, "", block.Height, nil)
 	}
 	return true
 }
@@ -378,7 +418,9 @@ func (mem *Mempool) delBlock(block *types.Block) {
 		err = mem.PushTx(tx)
 		if err != nil {
 			mlog.Error("mem", "push tx err", err)
+			continue
 		}
+		mem.txEvents.emit(types.TxEventAccepted, tx, "rollback", header.GetHeight(), nil)
 	}
 }
 
@@ -420,6 +462,8 @@ func (mem *Mempool) checkSync() {
 		mem.poolHeader <- struct{}{}
 	}()
 	defer mem.wg.Done()
+	//同步完成之后按照当前的区块头恢复磁盘上记录的交易
+	defer mem.replayJournal()
 	if mem.getSync() {
 		return
 	}
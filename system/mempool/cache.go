@@ -20,6 +20,11 @@ type QueueCache interface {
 	GetCacheBytes() int64
 }
 
+//QueueCacheEvictor 排队策略在缓存已满时可以淘汰队列中的交易，被淘汰的交易通过回调通知txCache清理索引
+type QueueCacheEvictor interface {
+	SetEvictCallback(cb func(item *Item))
+}
+
 // Item 为Mempool中包装交易的数据结构
 type Item struct {
 	Value     *types.Transaction
@@ -34,6 +39,11 @@ type txCache struct {
 	qcache   QueueCache
 	totalFee int64
 	*SHashTxCache
+	journal *txJournal
+	quota   *txQuota
+	events  *txEventHub
+	//mempool中的替换交易，被替换交易的hash -> 替换交易的hash
+	replaced map[string]string
 }
 
 //NewTxCache init accountIndex and last cache
@@ -42,12 +52,19 @@ func newCache(maxTxPerAccount int64, sizeLast int64, poolCacheSize int64) *txCac
 		AccountTxIndex: NewAccountTxIndex(int(maxTxPerAccount)),
 		LastTxCache:    NewLastTxCache(int(sizeLast)),
 		SHashTxCache:   NewSHashTxCache(int(poolCacheSize)),
+		replaced:       make(map[string]string),
 	}
 }
 
 //SetQueueCache set queue cache , 这个接口可以扩展
 func (cache *txCache) SetQueueCache(qcache QueueCache) {
 	cache.qcache = qcache
+	if evictor, ok := qcache.(QueueCacheEvictor); ok {
+		evictor.SetEvictCallback(func(item *Item) {
+			cache.removeIndex(item)
+			cache.events.emit(types.TxEventEvicted, item.Value, types.ErrMemFull.Error(), 0, nil)
+		})
+	}
 }
 
 //Remove 移除txCache中给定tx
@@ -56,15 +73,29 @@ func (cache *txCache) Remove(hash string) {
 	if err != nil {
 		return
 	}
-	tx := item.Value
 	err = cache.qcache.Remove(hash)
 	if err != nil {
 		mlog.Error("Remove", "cache Remove err", err)
 	}
+	cache.removeIndex(item)
+}
+
+//removeIndex 删除交易在账户索引，最后的交易等缓存中的数据
+func (cache *txCache) removeIndex(item *Item) {
+	tx := item.Value
 	cache.AccountTxIndex.Remove(tx)
 	cache.LastTxCache.Remove(tx)
 	cache.totalFee -= tx.Fee
 	cache.SHashTxCache.Remove(tx)
+	if cache.journal != nil {
+		cache.journal.remove(tx)
+	}
+	if cache.quota != nil {
+		cache.quota.remove(tx)
+	}
+	if len(tx.ReplaceHash) != 0 && cache.replaced[string(tx.ReplaceHash)] == string(tx.Hash()) {
+		delete(cache.replaced, string(tx.ReplaceHash))
+	}
 }
 
 //Exist 是否存在
@@ -103,35 +134,97 @@ func (cache *txCache) RemoveTxs(txs []string) {
 	}
 }
 
-//Push 存入交易到cache 中
+//Push 存入交易到cache 中，已经被mempool中的替换交易替换掉的交易不能再加入
 func (cache *txCache) Push(tx *types.Transaction) error {
+	if _, ok := cache.replaced[string(tx.Hash())]; ok {
+		return types.ErrTxReplaced
+	}
 	if !cache.AccountTxIndex.CanPush(tx) {
 		return types.ErrManyTx
 	}
-	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+	if cache.quota != nil {
+		err := cache.quota.check(tx, int64(tx.Size()), false)
+		if err != nil {
+			return err
+		}
+	}
+	return cache.pushItem(&Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()})
+}
+
+func (cache *txCache) pushItem(item *Item) error {
+	tx := item.Value
 	err := cache.qcache.Push(item)
 	if err != nil {
 		return err
 	}
+	//账户索引和排队策略中的交易需要保持一致，加入账户索引失败时从队列中删除
 	err = cache.AccountTxIndex.Push(tx)
 	if err != nil {
+		if err1 := cache.qcache.Remove(string(tx.Hash())); err1 != nil {
+			mlog.Error("pushItem", "remove queue err", err1)
+		}
 		return err
 	}
 	cache.LastTxCache.Push(tx)
 	cache.totalFee += tx.Fee
 	cache.SHashTxCache.Push(tx)
+	if cache.journal != nil {
+		cache.journal.add(item)
+	}
+	if cache.quota != nil {
+		cache.quota.add(tx)
+	}
+	if len(tx.ReplaceHash) != 0 {
+		cache.replaced[string(tx.ReplaceHash)] = string(tx.Hash())
+	}
+	return nil
+}
+
+//Replace 用同一个账户发送的新交易替换cache中的交易，新交易的手续费需要比原交易高出feeBump%
+//新交易加入失败时恢复原交易，cache中没有原交易时按普通交易加入
+//替换交易只能在ForkTxReplace之后通过交易检查，替换交易留在mempool中时，被替换的交易不能再加入，
+//替换交易上链之后由CheckTxDup拒绝被替换的交易
+func (cache *txCache) Replace(tx *types.Transaction, feeBump int64) error {
+	if cache.Exist(string(tx.Hash())) {
+		return types.ErrTxExist
+	}
+	oldHash := string(tx.ReplaceHash)
+	old, err := cache.qcache.GetItem(oldHash)
+	if err != nil {
+		return cache.Push(tx)
+	}
+	if old.Value.From() != tx.From() {
+		return types.ErrFromAddr
+	}
+	if tx.Fee < old.Value.Fee+old.Value.Fee*feeBump/100 {
+		return types.ErrReplaceFeeTooLow
+	}
+	cache.Remove(oldHash)
+	err = cache.Push(tx)
+	if err != nil {
+		if err1 := cache.pushItem(old); err1 != nil {
+			mlog.Error("Replace", "restore tx err", err1)
+		}
+		return err
+	}
+	cache.events.emit(types.TxEventReplaced, old.Value, "", 0, tx.Hash())
 	return nil
 }
 
 func (cache *txCache) removeExpiredTx(cfg *types.Chain33Config, height, blocktime int64) {
 	var txs []string
+	var expired []*types.Transaction
 	cache.qcache.Walk(0, func(tx *Item) bool {
 		if isExpired(cfg, tx, height, blocktime) {
 			txs = append(txs, string(tx.Value.Hash()))
+			expired = append(expired, tx.Value)
 		}
 		return true
 	})
 	cache.RemoveTxs(txs)
+	for _, tx := range expired {
+		cache.events.emit(types.TxEventExpired, tx, types.ErrTxExpire.Error(), height, nil)
+	}
 }
 
 //判断交易是否过期
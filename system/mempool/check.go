@@ -57,15 +57,8 @@ func (mem *Mempool) checkExpireValid(tx *types.Transaction) bool {
 // CheckTx 初步检查并筛选交易消息
 func (mem *Mempool) checkTx(msg *queue.Message) *queue.Message {
 	tx := msg.GetData().(types.TxGroup).Tx()
-	// 检查接收地址是否合法
-	if err := address.CheckAddress(tx.To); err != nil {
-		msg.Data = types.ErrInvalidAddress
-		return msg
-	}
-	// 检查交易账户在mempool中是否存在过多交易
-	from := tx.From()
-	if mem.TxNumOfAccount(from) >= mem.cfg.MaxTxNumPerAccount {
-		msg.Data = types.ErrManyTx
+	if err := mem.checkTxAccount(tx); err != nil {
+		msg.Data = err
 		return msg
 	}
 	// 检查交易是否过期
@@ -77,6 +70,24 @@ func (mem *Mempool) checkTx(msg *queue.Message) *queue.Message {
 	return msg
 }
 
+//checkTxAccount 检查接收地址以及交易账户在mempool中的交易数目
+func (mem *Mempool) checkTxAccount(tx *types.Transaction) error {
+	// 检查接收地址是否合法
+	if err := address.CheckAddress(tx.To); err != nil {
+		return types.ErrInvalidAddress
+	}
+	// 检查交易账户在mempool中是否存在过多交易，替换mempool中已有的交易不会增加交易数量
+	from := tx.From()
+	mem.proxyMtx.Lock()
+	replace := len(tx.ReplaceHash) != 0 && mem.cache.Exist(string(tx.ReplaceHash))
+	num := int64(mem.cache.TxNumOfAccount(from))
+	mem.proxyMtx.Unlock()
+	if !replace && num >= mem.cfg.MaxTxNumPerAccount {
+		return types.ErrManyTx
+	}
+	return nil
+}
+
 // CheckTxs 初步检查并筛选交易消息
 func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 	// 判断消息是否含有nil交易
@@ -139,15 +150,48 @@ func (mem *Mempool) checkLevelFee(tx *types.TransactionCache) error {
 
 //checkTxRemote 检查账户余额是否足够，并加入到Mempool，成功则传入goodChan，若加入Mempool失败则传入badChan
 func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
-	tx := msg.GetData().(types.TxGroup)
+	tx, err := mem.checkTxExec(msg.GetData().(types.TxGroup))
+	if err != nil {
+		mlog.Error("wrong tx", "err", err)
+		msg.Data = err
+		return msg
+	}
+	//还没有生效的交易放入等待区
+	if mem.isScheduled(tx) {
+		err = mem.pushScheduledTx(tx)
+	} else {
+		err = mem.PushTx(tx)
+	}
+	if err != nil {
+		mlog.Error("wrong tx", "err", err)
+		msg.Data = err
+		return msg
+	}
+	return msg
+}
+
+//checkTxExec 检查交易是否重复，并由执行器检查交易，返回可以加入mempool的交易
+func (mem *Mempool) checkTxExec(tx types.TxGroup) (*types.Transaction, error) {
+	err := mem.checkTxDup(tx)
+	if err != nil {
+		return nil, err
+	}
+	err = mem.checkTxExecRemote(tx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Tx(), nil
+}
+
+//checkTxDup 检查交易(交易组中的每个交易)是否已经被打包
+func (mem *Mempool) checkTxDup(tx types.TxGroup) error {
 	lastheader := mem.GetHeader()
 
 	//add check dup tx需要区分单笔交易/交易组
 	temtxlist := &types.ExecTxList{}
 	txGroup, err := tx.GetTxGroup()
 	if err != nil {
-		msg.Data = err
-		return msg
+		return err
 	}
 	if txGroup == nil {
 		temtxlist.Txs = append(temtxlist.Txs, tx.Tx())
@@ -157,15 +201,17 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 	temtxlist.Height = lastheader.Height
 	newtxs, err := util.CheckDupTx(mem.client, temtxlist.Txs, temtxlist.Height)
 	if err != nil {
-		msg.Data = err
-		return msg
+		return err
 	}
 	if len(newtxs) != len(temtxlist.Txs) {
-		msg.Data = types.ErrDupTx
-		return msg
+		return types.ErrDupTx
 	}
+	return nil
+}
 
-	//exec模块检查交易
+//checkTxExecRemote exec模块检查交易
+func (mem *Mempool) checkTxExecRemote(tx types.TxGroup) error {
+	lastheader := mem.GetHeader()
 	txlist := &types.ExecTxList{}
 	txlist.Txs = append(txlist.Txs, tx.Tx())
 	txlist.BlockTime = lastheader.BlockTime
@@ -177,19 +223,11 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 
 	result, err := mem.checkTxListRemote(txlist)
 	if err != nil {
-		msg.Data = err
-		return msg
+		return err
 	}
 	errstr := result.Errs[0]
-	if errstr == "" {
-		err1 := mem.PushTx(txlist.Txs[0])
-		if err1 != nil {
-			mlog.Error("wrong tx", "err", err1)
-			msg.Data = err1
-		}
-		return msg
+	if errstr != "" {
+		return errors.New(errstr)
 	}
-	mlog.Error("wrong tx", "err", errstr)
-	msg.Data = errors.New(errstr)
-	return msg
+	return nil
 }
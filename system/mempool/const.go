@@ -14,6 +14,10 @@ var (
 	mempoolExpiredInterval int64 = 600   // mempool内交易过期时间，10分钟
 	maxTxNumPerAccount     int64 = 100   // TODO 每个账户在mempool中最大交易数量，10
 	maxTxLast              int64 = 10
+	replaceFeeBump         int64 = 10   // 替换交易时手续费需要比原交易高出的百分比
+	maxScheduledTxs        int64 = 1024 // 等待生效的交易最大数量
+	maxScheduledTxsPerAcc  int64 = 10   // 每个账户等待生效的交易最大数量
+	maxNotBeforeHeight     int64 = 100  // 交易的生效高度最多比当前高度高出的区块数
 	processNum             int
 )
 
@@ -0,0 +1,117 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//模拟准入检查的各个阶段
+const (
+	checkStageBasic    = "basic"    //交易大小，手续费以及交易组的检查
+	checkStageLevelFee = "levelFee" //阶梯手续费
+	checkStageAccount  = "account"  //接收地址以及账户在mempool中的交易数目
+	checkStageExpire   = "expire"   //交易是否过期
+	checkStageSign     = "sign"     //签名
+	checkStageMempool  = "mempool"  //交易是否已经在mempool中以及mempool配额
+	checkStageDup      = "dup"      //交易是否已经被打包
+	checkStageExec     = "exec"     //执行器检查
+)
+
+//checkTxDryRun 按照交易进入mempool的流程检查交易，但是不加入mempool
+//每个阶段独立检查，返回所有阶段的检查结果，前面的阶段失败时后面依赖的阶段不再检查
+func (mem *Mempool) checkTxDryRun(tx *types.Transaction) *types.ReplyCheckTx {
+	reply := &types.ReplyCheckTx{Ok: true}
+	stage := func(name string, err error) bool {
+		item := &types.TxCheckStage{Name: name}
+		if err != nil {
+			item.Error = err.Error()
+			reply.Ok = false
+		}
+		reply.Stages = append(reply.Stages, item)
+		return err == nil
+	}
+	types.AssertConfig(mem.client)
+	header := mem.GetHeader()
+	txCache := types.NewTransactionCache(tx)
+	reply.ProperFee = mem.getProperFee(txCache)
+
+	err := txCache.Check(mem.client.GetConfig(), header.GetHeight(), mem.cfg.MinTxFeeRate, mem.cfg.MaxTxFee)
+	var txs []*types.Transaction
+	if err == nil {
+		var group *types.Transactions
+		group, err = txCache.GetTxGroup()
+		txs = []*types.Transaction{tx}
+		if group != nil {
+			txs = group.Txs
+		}
+	}
+	if !stage(checkStageBasic, err) {
+		return reply
+	}
+	if mem.cfg.IsLevelFee {
+		stage(checkStageLevelFee, mem.checkLevelFee(txCache))
+	}
+	var accountErr, expireErr error
+	for _, tx := range txs {
+		if accountErr == nil {
+			accountErr = mem.checkTxAccount(tx)
+		}
+		if expireErr == nil {
+			_, expireErr = mem.CheckExpireValid(&queue.Message{Data: tx})
+		}
+	}
+	stage(checkStageAccount, accountErr)
+	stage(checkStageExpire, expireErr)
+	if !stage(checkStageSign, mem.checkSign(&queue.Message{Data: txCache}).Err()) {
+		return reply
+	}
+	stage(checkStageMempool, mem.checkTxMempool(tx))
+	stage(checkStageDup, mem.checkTxDup(txCache))
+	stage(checkStageExec, mem.checkTxExecRemote(txCache))
+	return reply
+}
+
+//checkTxMempool 检查交易是否已经在mempool中以及是否超过mempool的配额，不计入每秒接收的交易数量
+func (mem *Mempool) checkTxMempool(tx *types.Transaction) error {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	if mem.cache.Exist(string(tx.Hash())) || mem.scheduled.exist(string(tx.Hash())) {
+		return types.ErrTxExist
+	}
+	if mem.quota != nil {
+		return mem.quota.check(tx, int64(tx.Size()), false)
+	}
+	return nil
+}
+
+//getProperFee 按照当前mempool合适的手续费率计算交易(交易组)需要的手续费
+func (mem *Mempool) getProperFee(tx *types.TransactionCache) int64 {
+	var count int32 = 1
+	if group, err := tx.GetTxGroup(); err == nil && group != nil {
+		count = int32(len(group.Txs))
+	}
+	feeRate := mem.GetProperFeeRate(&types.ReqProperFee{TxCount: count, TxSize: int32(tx.Size())})
+	fee, err := tx.GetTotalFee(feeRate)
+	if err != nil {
+		return 0
+	}
+	return fee
+}
+
+// eventCheckTxDryRun 检查交易能否进入mempool，需要等待执行器的检查结果，在单独的goroutine中处理
+func (mem *Mempool) eventCheckTxDryRun(msg *queue.Message) {
+	tx, ok := msg.GetData().(*types.Transaction)
+	if !ok || tx == nil {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventCheckTxDryRun, types.ErrEmptyTx))
+		return
+	}
+	if !mem.getSync() {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventCheckTxDryRun, types.ErrNotSync))
+		return
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventCheckTxDryRun, mem.checkTxDryRun(tx)))
+}
@@ -0,0 +1,83 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkTxDryRun(t *testing.T, client queue.Client, tx *types.Transaction) *types.ReplyCheckTx {
+	msg := client.NewMessage("mempool", types.EventCheckTxDryRun, tx)
+	require.NoError(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.NoError(t, err)
+	require.NoError(t, resp.Err())
+	return resp.GetData().(*types.ReplyCheckTx)
+}
+
+func stageErrors(reply *types.ReplyCheckTx) map[string]string {
+	errs := make(map[string]string)
+	for _, stage := range reply.Stages {
+		errs[stage.Name] = stage.Error
+	}
+	return errs
+}
+
+func TestCheckTxDryRun(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	client := q.Client()
+
+	_, priv := genaddress()
+	tx1 := createTx(priv, toAddr, 10000)
+	reply := checkTxDryRun(t, client, tx1)
+	assert.True(t, reply.Ok)
+	assert.Equal(t, []string{checkStageBasic, checkStageAccount, checkStageExpire, checkStageSign,
+		checkStageMempool, checkStageDup, checkStageExec}, func() (names []string) {
+		for _, stage := range reply.Stages {
+			names = append(names, stage.Name)
+		}
+		return
+	}())
+	assert.Equal(t, mem.cfg.MinTxFeeRate, reply.ProperFee)
+	//交易不会加入mempool
+	assert.Equal(t, 0, mem.Size())
+
+	//已经在mempool中的交易
+	tx2 := createTx(priv, toAddr, 10000)
+	require.NoError(t, mem.PushTx(tx2))
+	reply = checkTxDryRun(t, client, tx2)
+	assert.False(t, reply.Ok)
+	errs := stageErrors(reply)
+	assert.Equal(t, types.ErrTxExist.Error(), errs[checkStageMempool])
+	assert.Equal(t, "", errs[checkStageExec])
+
+	//签名错误时不再检查之后的阶段
+	badSign := createTx(priv, toAddr, 10000)
+	badSign.Fee++
+	reply = checkTxDryRun(t, client, badSign)
+	assert.False(t, reply.Ok)
+	assert.Equal(t, 4, len(reply.Stages))
+	assert.Equal(t, types.ErrSign.Error(), stageErrors(reply)[checkStageSign])
+
+	//基本检查失败时直接返回
+	lowFee := createTx(priv, toAddr, 10000)
+	lowFee.Fee = 1
+	reply = checkTxDryRun(t, client, lowFee)
+	assert.False(t, reply.Ok)
+	require.Equal(t, 1, len(reply.Stages))
+	assert.Equal(t, types.ErrTxFeeTooLow.Error(), reply.Stages[0].Error)
+
+	msg := client.NewMessage("mempool", types.EventCheckTxDryRun, nil)
+	require.NoError(t, client.Send(msg, true))
+	_, err := client.Wait(msg)
+	assert.Equal(t, types.ErrEmptyTx, err)
+}
@@ -13,7 +13,9 @@ func (mem *Mempool) reply() {
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 				&types.Reply{IsOk: false, Msg: []byte(m.Err().Error())}))
 		} else {
-			mem.sendTxToP2P(m.GetData().(types.TxGroup).Tx())
+			tx := m.GetData().(types.TxGroup).Tx()
+			mem.sendTxToP2P(tx)
+			mem.txEvents.emit(types.TxEventAccepted, tx, "", 0, nil)
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: nil}))
 		}
 	}
@@ -25,20 +27,33 @@ func (mem *Mempool) pipeLine() <-chan *queue.Message {
 		if data.Err() != nil {
 			return data
 		}
-		return mem.checkSign(data)
+		return mem.checkWithEvent(data, mem.checkSign)
 	}
 	chs := make([]<-chan *queue.Message, processNum)
 	for i := 0; i < processNum; i++ {
 		chs[i] = step(mem.done, mem.in, step1)
 	}
-	out1 := merge(mem.done, chs)
+	out0 := merge(mem.done, chs)
+
+	//check quota
+	stepQuota := func(data *queue.Message) *queue.Message {
+		if data.Err() != nil {
+			return data
+		}
+		return mem.checkWithEvent(data, mem.checkQuota)
+	}
+	chsQuota := make([]<-chan *queue.Message, processNum)
+	for i := 0; i < processNum; i++ {
+		chsQuota[i] = step(mem.done, out0, stepQuota)
+	}
+	out1 := merge(mem.done, chsQuota)
 
 	//checktx remote
 	step2 := func(data *queue.Message) *queue.Message {
 		if data.Err() != nil {
 			return data
 		}
-		return mem.checkTxRemote(data)
+		return mem.checkWithEvent(data, mem.checkTxRemote)
 	}
 	chs2 := make([]<-chan *queue.Message, processNum)
 	for i := 0; i < processNum; i++ {
@@ -93,6 +108,24 @@ func (mem *Mempool) eventProcess() {
 			// 消息类型EventTxListByHash：通过hash获取对应的tx列表
 		case types.EventTxListByHash:
 			mem.eventTxListByHash(msg)
+		case types.EventSubTxEvents:
+			mem.eventSubTxEvents(msg)
+		case types.EventGetTxEvents:
+			// 没有事件时会等待，避免阻塞其他消息
+			go mem.eventGetTxEvents(msg)
+		case types.EventUnSubTxEvents:
+			mem.eventUnSubTxEvents(msg)
+		case types.EventCheckTxDryRun:
+			// 需要等待执行器的检查结果，避免阻塞其他消息
+			go mem.eventCheckTxDryRun(msg)
+		case types.EventQueryMempoolTxs:
+			mem.eventQueryMempoolTxs(msg)
+		case types.EventEvictMempoolTxs:
+			mem.eventEvictMempoolTxs(msg)
+		case types.EventGetMempoolStats:
+			mem.eventGetMempoolStats(msg)
+		case types.EventGetScheduledTxs:
+			mem.eventGetScheduledTxs(msg)
 		default:
 		}
 		mlog.Debug("mempool", "cost", types.Since(beg), "msg", types.GetEventName(int(msg.Ty)))
@@ -105,7 +138,21 @@ func (mem *Mempool) eventTx(msg *queue.Message) {
 		msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrNotSync.Error())}))
 		mlog.Debug("wrong tx", "err", types.ErrNotSync.Error())
 	} else {
+		//从其他节点接收的交易记录来源节点
+		if recv, ok := msg.GetData().(*types.P2PRecvTx); ok {
+			msg.Data = nil
+			if recv.GetTx() != nil {
+				msg.Data = recv.GetTx()
+				if mem.quota != nil {
+					mem.quota.setPeer(recv.GetTx(), recv.GetPeer())
+				}
+			}
+		}
+		tx, _ := msg.GetData().(*types.Transaction)
 		checkedMsg := mem.checkTxs(msg)
+		if tx != nil && checkedMsg.Err() != nil {
+			mem.txEvents.emit(types.TxEventRejected, tx, checkedMsg.Err().Error(), 0, nil)
+		}
 		select {
 		case mem.in <- checkedMsg:
 		case <-mem.done:
@@ -159,6 +206,7 @@ func (mem *Mempool) eventAddBlock(msg *queue.Message) {
 		mem.setHeader(header)
 	}
 	mem.RemoveTxsOfBlock(block)
+	mem.releaseScheduledTxs()
 }
 
 // EventGetMempoolSize 获取mempool大小
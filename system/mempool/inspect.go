@@ -0,0 +1,172 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	defaultMempoolTxsCount = 100  //查询交易时默认返回的数目
+	maxMempoolTxsCount     = 1000 //查询交易时最多返回的数目
+	feeHistogramBuckets    = 10   //手续费率直方图的区间数目，最后一个区间不限制上限
+	txEvictedByAdmin       = "evicted by admin"
+)
+
+func matchMempoolTx(req *types.ReqMempoolTxs, item *Item, now int64) bool {
+	tx := item.Value
+	if req.From != "" && tx.From() != req.From {
+		return false
+	}
+	if req.Execer != "" && string(tx.Execer) != req.Execer {
+		return false
+	}
+	if tx.Fee < req.MinFee || (req.MaxFee > 0 && tx.Fee > req.MaxFee) {
+		return false
+	}
+	age := now - item.EnterTime
+	if age < req.MinAge || (req.MaxAge > 0 && age > req.MaxAge) {
+		return false
+	}
+	return true
+}
+
+//queryTxs 按照排队策略的顺序遍历mempool，返回满足条件的交易，从offset开始最多返回count个
+func (mem *Mempool) queryTxs(req *types.ReqMempoolTxs) *types.ReplyMempoolTxs {
+	count := req.Count
+	if count <= 0 {
+		count = defaultMempoolTxsCount
+	}
+	if count > maxMempoolTxsCount {
+		count = maxMempoolTxsCount
+	}
+	now := types.Now().Unix()
+	reply := &types.ReplyMempoolTxs{}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	mem.cache.Walk(0, func(item *Item) bool {
+		if !matchMempoolTx(req, item, now) {
+			return true
+		}
+		reply.Total++
+		if reply.Total > req.Offset && int64(len(reply.Txs)) < count {
+			reply.Txs = append(reply.Txs, &types.MempoolTx{Tx: item.Value, EnterTime: item.EnterTime})
+		}
+		return true
+	})
+	return reply
+}
+
+//evictTxs 删除指定哈希的交易以及地址发送的所有交易，返回被删除的交易哈希
+func (mem *Mempool) evictTxs(req *types.ReqEvictMempoolTxs) *types.TxHashList {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	hashes := make([][]byte, 0, len(req.Hashes))
+	hashes = append(hashes, req.Hashes...)
+	if req.Addr != "" {
+		mem.cache.AccountTxIndex.WalkAccTxs(req.Addr, func(tx *types.Transaction) bool {
+			hashes = append(hashes, tx.Hash())
+			return true
+		})
+	}
+	evicted := &types.TxHashList{}
+	for _, hash := range hashes {
+		item, err := mem.cache.qcache.GetItem(string(hash))
+		if err != nil {
+			continue
+		}
+		mem.cache.Remove(string(hash))
+		mem.txEvents.emit(types.TxEventEvicted, item.Value, txEvictedByAdmin, 0, nil)
+		evicted.Hashes = append(evicted.Hashes, hash)
+	}
+	evicted.Count = int64(len(evicted.Hashes))
+	mlog.Info("evictTxs", "addr", req.Addr, "count", evicted.Count)
+	return evicted
+}
+
+//getStats 统计mempool中的交易：手续费率直方图，每个执行器的交易数目和字节数，以及最早的交易等待的时间
+//手续费率的区间按照最低手续费率的倍数划分：[0, min), [min, 2min), [2min, 4min) ...
+func (mem *Mempool) getStats() *types.MempoolStats {
+	base := mem.cfg.MinTxFeeRate
+	if base <= 0 {
+		base = 1
+	}
+	stats := &types.MempoolStats{}
+	var lower int64
+	for i, upper := 0, base; i < feeHistogramBuckets; i, upper = i+1, upper*2 {
+		bucket := &types.MempoolFeeBucket{MinFeeRate: lower, MaxFeeRate: upper}
+		if i == feeHistogramBuckets-1 {
+			bucket.MaxFeeRate = 0
+		}
+		stats.FeeHistogram = append(stats.FeeHistogram, bucket)
+		lower = upper
+	}
+	execs := make(map[string]*types.MempoolExecStat)
+	now := types.Now().Unix()
+	oldest := now
+
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	mem.cache.Walk(0, func(item *Item) bool {
+		tx := item.Value
+		size := int64(tx.Size())
+		stats.TxCount++
+		stats.TotalBytes += size
+		feeRate := tx.Fee * 1000 / size
+		for _, bucket := range stats.FeeHistogram {
+			if bucket.MaxFeeRate == 0 || feeRate < bucket.MaxFeeRate {
+				bucket.Count++
+				break
+			}
+		}
+		exec := execs[string(tx.Execer)]
+		if exec == nil {
+			exec = &types.MempoolExecStat{Execer: string(tx.Execer)}
+			execs[exec.Execer] = exec
+		}
+		exec.Count++
+		exec.Bytes += size
+		if item.EnterTime < oldest {
+			oldest = item.EnterTime
+		}
+		return true
+	})
+	for _, exec := range execs {
+		stats.Execs = append(stats.Execs, exec)
+	}
+	sort.Slice(stats.Execs, func(i, j int) bool {
+		return stats.Execs[i].Bytes > stats.Execs[j].Bytes
+	})
+	stats.OldestAge = now - oldest
+	return stats
+}
+
+// eventQueryMempoolTxs 按照条件查询mempool中的交易
+func (mem *Mempool) eventQueryMempoolTxs(msg *queue.Message) {
+	req, ok := msg.GetData().(*types.ReqMempoolTxs)
+	if !ok {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventQueryMempoolTxs, types.ErrInvalidParam))
+		return
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventQueryMempoolTxs, mem.queryTxs(req)))
+}
+
+// eventEvictMempoolTxs 从mempool中删除交易
+func (mem *Mempool) eventEvictMempoolTxs(msg *queue.Message) {
+	req, ok := msg.GetData().(*types.ReqEvictMempoolTxs)
+	if !ok || (len(req.Hashes) == 0 && req.Addr == "") {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventEvictMempoolTxs, types.ErrInvalidParam))
+		return
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventEvictMempoolTxs, mem.evictTxs(req)))
+}
+
+// eventGetMempoolStats 获取mempool中交易的统计
+func (mem *Mempool) eventGetMempoolStats(msg *queue.Message) {
+	msg.Reply(mem.client.NewMessage("rpc", types.EventGetMempoolStats, mem.getStats()))
+}
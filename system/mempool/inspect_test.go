@@ -0,0 +1,120 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendMempoolMsg(t *testing.T, client queue.Client, ty int64, data interface{}) interface{} {
+	msg := client.NewMessage("mempool", ty, data)
+	require.NoError(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.NoError(t, err)
+	return resp.GetData()
+}
+
+func TestQueryMempoolTxs(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	client := q.Client()
+
+	addr1, priv1 := genaddress()
+	_, priv2 := genaddress()
+	var txs []*types.Transaction
+	for i := 0; i < 5; i++ {
+		tx := createTx(priv1, toAddr, 10000)
+		tx.Fee = int64(i+1) * 1e6
+		tx.Sign(types.SECP256K1, priv1)
+		require.NoError(t, mem.PushTx(tx))
+		txs = append(txs, tx)
+	}
+	require.NoError(t, mem.PushTx(createTx(priv2, toAddr, 10000)))
+	mem.cache.Walk(0, func(item *Item) bool {
+		if string(item.Value.Hash()) == string(txs[0].Hash()) {
+			item.EnterTime -= 100
+		}
+		return true
+	})
+
+	//按照账户和手续费过滤，分页返回
+	req := &types.ReqMempoolTxs{From: addr1, MinFee: 2e6, MaxFee: 4e6, Count: 2}
+	reply := sendMempoolMsg(t, client, types.EventQueryMempoolTxs, req).(*types.ReplyMempoolTxs)
+	assert.Equal(t, int64(3), reply.Total)
+	require.Equal(t, 2, len(reply.Txs))
+	assert.Equal(t, txs[1].Hash(), reply.Txs[0].Tx.Hash())
+	assert.Equal(t, txs[2].Hash(), reply.Txs[1].Tx.Hash())
+	req.Offset = 2
+	reply = sendMempoolMsg(t, client, types.EventQueryMempoolTxs, req).(*types.ReplyMempoolTxs)
+	require.Equal(t, 1, len(reply.Txs))
+	assert.Equal(t, txs[3].Hash(), reply.Txs[0].Tx.Hash())
+
+	//按照执行器和等待时间过滤
+	reply = sendMempoolMsg(t, client, types.EventQueryMempoolTxs, &types.ReqMempoolTxs{Execer: "coins", MinAge: 50}).(*types.ReplyMempoolTxs)
+	require.Equal(t, 1, len(reply.Txs))
+	assert.Equal(t, txs[0].Hash(), reply.Txs[0].Tx.Hash())
+	reply = sendMempoolMsg(t, client, types.EventQueryMempoolTxs, &types.ReqMempoolTxs{Execer: "none"}).(*types.ReplyMempoolTxs)
+	assert.Equal(t, int64(0), reply.Total)
+
+	stats := sendMempoolMsg(t, client, types.EventGetMempoolStats, &types.ReqNil{}).(*types.MempoolStats)
+	assert.Equal(t, int64(6), stats.TxCount)
+	assert.Equal(t, mem.GetTotalCacheBytes(), stats.TotalBytes)
+	assert.True(t, stats.OldestAge >= 100)
+	require.Equal(t, 1, len(stats.Execs))
+	assert.Equal(t, "coins", stats.Execs[0].Execer)
+	assert.Equal(t, int64(6), stats.Execs[0].Count)
+	assert.Equal(t, stats.TotalBytes, stats.Execs[0].Bytes)
+	require.Equal(t, feeHistogramBuckets, len(stats.FeeHistogram))
+	var count int64
+	for _, bucket := range stats.FeeHistogram {
+		count += bucket.Count
+	}
+	assert.Equal(t, stats.TxCount, count)
+	assert.Equal(t, int64(0), stats.FeeHistogram[feeHistogramBuckets-1].MaxFeeRate)
+}
+
+func TestEvictMempoolTxs(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	client := q.Client()
+
+	addr1, priv1 := genaddress()
+	_, priv2 := genaddress()
+	tx1 := createTx(priv1, toAddr, 10000)
+	tx2 := createTx(priv1, toAddr, 10000)
+	tx3 := createTx(priv2, toAddr, 10000)
+	for _, tx := range []*types.Transaction{tx1, tx2, tx3} {
+		require.NoError(t, mem.PushTx(tx))
+	}
+	sendMempoolMsg(t, client, types.EventSubTxEvents, &types.ReqSubTxEvents{Name: "sub"})
+
+	msg := client.NewMessage("mempool", types.EventEvictMempoolTxs, &types.ReqEvictMempoolTxs{})
+	require.NoError(t, client.Send(msg, true))
+	_, err := client.Wait(msg)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//删除指定哈希的交易，不存在的交易忽略
+	evicted := sendMempoolMsg(t, client, types.EventEvictMempoolTxs, &types.ReqEvictMempoolTxs{Hashes: [][]byte{tx3.Hash(), []byte("none")}}).(*types.TxHashList)
+	assert.Equal(t, [][]byte{tx3.Hash()}, evicted.Hashes)
+	//删除地址的所有交易
+	evicted = sendMempoolMsg(t, client, types.EventEvictMempoolTxs, &types.ReqEvictMempoolTxs{Hashes: [][]byte{tx1.Hash()}, Addr: addr1}).(*types.TxHashList)
+	assert.Equal(t, int64(2), evicted.Count)
+	assert.Equal(t, 0, mem.Size())
+	assert.Equal(t, int64(0), mem.TxNumOfAccount(addr1))
+
+	events := getTxEvents(t, client, "sub")
+	require.Equal(t, 3, len(events))
+	for _, event := range events {
+		assert.Equal(t, int32(types.TxEventEvicted), event.Ty)
+		assert.Equal(t, txEvictedByAdmin, event.Reason)
+	}
+}
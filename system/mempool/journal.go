@@ -0,0 +1,193 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+var journalPrefix = []byte("mempool-journal-")
+
+//txJournal 将进入mempool的交易以及删除记录保存在磁盘上，重启之后重新加入mempool
+//key为交易哈希，value为 seq(8) + enterTime(8) + tx，seq用于按照交易进入mempool的顺序恢复
+type txJournal struct {
+	db  dbm.DB
+	seq int64
+}
+
+func newTxJournal(dir string) *txJournal {
+	return &txJournal{db: dbm.NewDB("mempool", "leveldb", dir, 16)}
+}
+
+func journalKey(hash []byte) []byte {
+	return append(append([]byte{}, journalPrefix...), hash...)
+}
+
+//add 记录进入mempool的交易，重复加入时覆盖之前的记录
+func (j *txJournal) add(item *Item) {
+	j.seq++
+	tx := types.Encode(item.Value)
+	value := make([]byte, 16, 16+len(tx))
+	binary.BigEndian.PutUint64(value, uint64(j.seq))
+	binary.BigEndian.PutUint64(value[8:], uint64(item.EnterTime))
+	value = append(value, tx...)
+	err := j.db.Set(journalKey(item.Value.Hash()), value)
+	if err != nil {
+		mlog.Error("journal add", "err", err)
+	}
+}
+
+//remove 删除交易的记录
+func (j *txJournal) remove(tx *types.Transaction) {
+	err := j.db.Delete(journalKey(tx.Hash()))
+	if err != nil {
+		mlog.Error("journal remove", "err", err)
+	}
+}
+
+//load 按照进入mempool的顺序返回记录的交易，无法解析的记录直接删除
+func (j *txJournal) load() []*Item {
+	type record struct {
+		seq  int64
+		item *Item
+	}
+	var records []*record
+	it := j.db.Iterator(journalPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		value := it.Value()
+		var tx types.Transaction
+		if len(value) < 16 || types.Decode(value[16:], &tx) != nil {
+			mlog.Error("journal load", "err", types.ErrDecode)
+			j.db.Delete(it.Key())
+			continue
+		}
+		item := &Item{Value: &tx, Priority: tx.Fee, EnterTime: int64(binary.BigEndian.Uint64(value[8:]))}
+		records = append(records, &record{seq: int64(binary.BigEndian.Uint64(value)), item: item})
+	}
+	sort.Slice(records, func(i, k int) bool { return records[i].seq < records[k].seq })
+	items := make([]*Item, len(records))
+	for i, r := range records {
+		items[i] = r.item
+		if r.seq > j.seq {
+			j.seq = r.seq
+		}
+	}
+	return items
+}
+
+func (j *txJournal) close() {
+	j.db.Close()
+}
+
+//replayRetryErrs 和mempool，账户或者节点当前状态相关的错误，之后仍然可能加入mempool，保留交易的记录
+var replayRetryErrs = map[string]bool{
+	types.ErrTxExist.Error():                    true,
+	types.ErrManyTx.Error():                     true,
+	types.ErrMemFull.Error():                    true,
+	types.ErrQuotaTxCount.Error():               true,
+	types.ErrQuotaTxBytes.Error():               true,
+	types.ErrQuotaTxRate.Error():                true,
+	types.ErrScheduledTxFull.Error():            true,
+	types.ErrTxNotMature.Error():                true,
+	types.ErrNoBalance.Error():                  true,
+	types.ErrBalanceLessThanTenTimesFee.Error(): true,
+	types.ErrHeaderNotSet.Error():               true,
+	types.ErrIsClosed.Error():                   true,
+}
+
+//isReplayPermanentErr 交易过期，已经上链或者无效时不会再加入mempool，可以删除交易的记录
+func isReplayPermanentErr(err error) bool {
+	if api.IsAPIEnvError(err) {
+		return false
+	}
+	//执行器检查交易返回的错误只保留了错误信息
+	return !replayRetryErrs[err.Error()]
+}
+
+//replayJournal 重新检查磁盘上记录的交易，按照原来的顺序加入mempool，过期，已经上链或者无效的交易从记录中删除
+func (mem *Mempool) replayJournal() {
+	//mempool关闭时不处理，避免把记录的交易当作无效交易删除
+	if mem.journal == nil || mem.isClose() || !mem.getSync() {
+		return
+	}
+	header := mem.GetHeader()
+	if header == nil {
+		lastHeader, err := mem.GetLastHeader()
+		if err != nil {
+			mlog.Error("replayJournal", "err", err)
+			return
+		}
+		header = lastHeader.(*queue.Message).Data.(*types.Header)
+		mem.setHeader(header)
+	}
+	items := mem.journal.load()
+	types.AssertConfig(mem.client)
+	cfg := mem.client.GetConfig()
+	var count int
+	for _, item := range items {
+		if mem.isClose() {
+			return
+		}
+		err := mem.replayItem(cfg, header, item)
+		if err != nil {
+			mlog.Debug("replayJournal", "hash", item.Value.Hash(), "err", err)
+			if isReplayPermanentErr(err) {
+				mem.journal.remove(item.Value)
+			}
+			continue
+		}
+		count++
+	}
+	mlog.Info("replayJournal", "total", len(items), "replayed", count)
+}
+
+func (mem *Mempool) replayItem(cfg *types.Chain33Config, header *types.Header, item *Item) error {
+	if isExpired(cfg, item, header.GetHeight(), header.GetBlockTime()) {
+		return types.ErrTxExpire
+	}
+	mem.proxyMtx.Lock()
+	exist := mem.cache.Exist(string(item.Value.Hash()))
+	mem.proxyMtx.Unlock()
+	if exist {
+		return types.ErrTxExist
+	}
+	msg := mem.checkTxs(mem.client.NewMessage("mempool", types.EventTx, item.Value))
+	if msg.Err() != nil {
+		return msg.Err()
+	}
+	msg = mem.checkSign(msg)
+	if msg.Err() != nil {
+		return msg.Err()
+	}
+	tx, err := mem.checkTxExec(msg.GetData().(types.TxGroup))
+	if err != nil {
+		return err
+	}
+	//还没有生效的交易重新加入等待区
+	if mem.isScheduled(tx) {
+		return mem.pushScheduledItem(&Item{Value: tx, Priority: tx.Fee, EnterTime: item.EnterTime}, false)
+	}
+	//保留交易原来进入mempool的时间，替换交易对应的原交易已经不在mempool中，直接加入
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	if !mem.cache.AccountTxIndex.CanPush(tx) {
+		return types.ErrManyTx
+	}
+	//重启之后交易的来源节点未知，只检查账户和执行器的配额，恢复的交易不计入每秒接收的交易数量
+	if mem.quota != nil {
+		err = mem.quota.check(tx, int64(tx.Size()), false)
+		if err != nil {
+			return err
+		}
+	}
+	return mem.cache.pushItem(&Item{Value: tx, Priority: tx.Fee, EnterTime: item.EnterTime})
+}
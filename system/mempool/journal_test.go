@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempooljournal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	journalCfg := func(quota *types.MempoolQuota) func(cfg *types.Config) {
+		return func(cfg *types.Config) {
+			cfg.Mempool.JournalPath = dir
+			cfg.Mempool.AccountQuota = quota
+		}
+	}
+
+	addr, priv := genaddress()
+	tx1 := createTx(priv, toAddr, 10000)
+	tx2 := createTx(priv, toAddr, 10000)
+	removed := createTx(priv, toAddr, 10000)
+	//区块高度为1时过期
+	expired := createTx(priv, toAddr, 10000)
+	expired.Expire = 1
+	expired.Sign(types.SECP256K1, priv)
+	//手续费不足
+	lowFee := createTx(priv, toAddr, 10000)
+	lowFee.Fee = 1
+	lowFee.Sign(types.SECP256K1, priv)
+
+	q, mem := initEnv(0, journalCfg(nil))
+	for _, tx := range []*types.Transaction{tx1, expired, tx2, lowFee, removed} {
+		require.NoError(t, mem.PushTx(tx))
+	}
+	mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{removed.Hash()}})
+	assert.Equal(t, 4, mem.Size())
+	mem.Close()
+	q.Close()
+
+	q, mem = initEnv(0, journalCfg(nil))
+	assert.Equal(t, 2, mem.Size())
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(addr))
+	assert.Equal(t, []*types.Transaction{tx1, tx2}, mem.getTxList(&types.TxHashList{Count: 10}))
+	mem.Close()
+	q.Close()
+
+	//无效的交易已经从记录中删除
+	q, mem = initEnv(0, journalCfg(nil))
+	assert.Equal(t, 2, len(mem.journal.load()))
+	assert.Equal(t, 2, mem.Size())
+	//交易已经在mempool中时保留记录
+	mem.replayJournal()
+	assert.Equal(t, 2, len(mem.journal.load()))
+	assert.Equal(t, 2, mem.Size())
+	mem.Close()
+	q.Close()
+
+	//超过配额的交易不加入mempool，但是保留记录
+	q, mem = initEnv(0, journalCfg(&types.MempoolQuota{MaxTxCount: 1}))
+	defer q.Close()
+	defer mem.Close()
+	assert.Equal(t, 1, mem.Size())
+	assert.Equal(t, []*types.Transaction{tx1}, mem.getTxList(&types.TxHashList{Count: 10}))
+	assert.Equal(t, 2, len(mem.journal.load()))
+}
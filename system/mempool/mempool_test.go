@@ -7,6 +7,7 @@ package mempool
 import (
 	"errors"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -27,6 +28,7 @@ import (
 	_ "github.com/33cn/chain33/system/store/init"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 //----------------------------- data for testing ---------------------------------
@@ -166,12 +168,20 @@ func initEnv3() (queue.Queue, queue.Module, queue.Module, *Mempool) {
 	return q, chain, s, mem
 }
 
-func initEnv(size int) (queue.Queue, *Mempool) {
+//initEnv 初始化mempool的测试环境，setup在创建之前修改配置，修改title时使用对应title的fork配置
+func initEnv(size int, setup ...func(cfg *types.Config)) (queue.Queue, *Mempool) {
 	if size == 0 {
 		size = 100
 	}
-	cfg := types.NewChain33Config(types.ReadFile("../../cmd/chain33/chain33.test.toml"))
+	str := types.ReadFile("../../cmd/chain33/chain33.test.toml")
+	setupCfg, _ := types.InitCfgString(str)
+	for _, f := range setup {
+		f(setupCfg)
+	}
+	str = strings.Replace(str, "Title=\"chain33\"", "Title=\""+setupCfg.Title+"\"", 1)
+	cfg := types.NewChain33Config(str)
 	mcfg := cfg.GetModuleConfig()
+	mcfg.Mempool = setupCfg.Mempool
 	var q = queue.New("channel")
 	q.SetConfig(cfg)
 	blockchainProcess(q)
@@ -1127,6 +1137,9 @@ func blockchainProcess(q queue.Queue) {
 					dup[string(hash)] = true
 				}
 				msg.Reply(client.NewMessage("consensus", types.EventTxHashListReply, &types.TxHashList{Hashes: hashlist}))
+			} else if msg.Ty == types.EventLocalGet {
+				keys := msg.Data.(*types.LocalDBGet)
+				msg.Reply(client.NewMessage("", types.EventLocalReplyValue, &types.LocalReplyValue{Values: make([][]byte, len(keys.Keys))}))
 			}
 		}
 	}()
@@ -1181,6 +1194,20 @@ func TestTx(t *testing.T) {
 	}
 }
 
+func TestPushItemRollback(t *testing.T) {
+	cache := newCache(1, 10, 10)
+	cache.SetQueueCache(NewSimpleQueue(SubConfig{10, 10000}))
+	tx1 := &types.Transaction{Execer: []byte("user.write"), Payload: types.Encode(transfer), Fee: 100000000, Expire: 1, To: toAddr}
+	tx2 := tx1.Clone()
+	tx2.Expire = 2
+	require.NoError(t, cache.Push(tx1))
+	//加入账户索引失败时，队列中也不能留下交易
+	assert.Equal(t, types.ErrManyTx, cache.pushItem(&Item{Value: tx2, Priority: tx2.Fee}))
+	assert.False(t, cache.Exist(string(tx2.Hash())))
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, 1, cache.TxNumOfAccount(tx1.From()))
+}
+
 func TestEventTxListByHash(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -1239,3 +1266,46 @@ func TestEventTxListByHash(t *testing.T) {
 		}
 	}
 }
+
+func TestReplaceTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	addr, priv := genaddress()
+	addr2, priv2 := genaddress()
+	oldTx := createTx(priv, toAddr, 10000)
+	assert.NoError(t, mem.PushTx(oldTx))
+
+	replaceTx := func(priv crypto.PrivKey, fee int64, hash []byte) *types.Transaction {
+		tx := createTx(priv, toAddr, 10000)
+		tx.Fee = fee
+		tx.ReplaceHash = hash
+		tx.Sign(types.SECP256K1, priv)
+		return tx
+	}
+	assert.Equal(t, types.ErrFromAddr, mem.PushTx(replaceTx(priv2, 2e6, oldTx.Hash())))
+	assert.Equal(t, types.ErrReplaceFeeTooLow, mem.PushTx(replaceTx(priv, 1e6+1e5-1, oldTx.Hash())))
+	assert.Equal(t, int64(1e6), mem.cache.TotalFee())
+
+	newTx := replaceTx(priv, 1e6+1e5, oldTx.Hash())
+	assert.NoError(t, mem.PushTx(newTx))
+	assert.False(t, mem.cache.Exist(string(oldTx.Hash())))
+	assert.True(t, mem.cache.Exist(string(newTx.Hash())))
+	assert.Equal(t, int64(1), mem.TxNumOfAccount(addr))
+	assert.Equal(t, newTx, mem.GetLatestTx()[0])
+	assert.Nil(t, mem.cache.GetSHashTxCache(types.CalcTxShortHash(oldTx.Hash())))
+	assert.Equal(t, int64(1e6+1e5), mem.cache.TotalFee())
+	assert.Equal(t, types.ErrTxExist, mem.PushTx(newTx))
+	//替换交易还在mempool中时，被替换的交易不能再加入
+	assert.Equal(t, types.ErrTxReplaced, mem.PushTx(oldTx))
+	assert.Equal(t, int64(1), mem.TxNumOfAccount(addr))
+
+	//mempool中没有被替换的交易时按普通交易加入
+	assert.NoError(t, mem.PushTx(replaceTx(priv2, 2e6, []byte("unknown"))))
+	assert.Equal(t, int64(1), mem.TxNumOfAccount(addr2))
+	assert.Equal(t, int64(3e6+1e5), mem.cache.TotalFee())
+
+	//替换交易从mempool中删除之后，mempool不再记录被替换的交易
+	require.NoError(t, mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{newTx.Hash()}}))
+	assert.NoError(t, mem.PushTx(oldTx))
+}
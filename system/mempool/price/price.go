@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+//估算手续费时默认参考的排队交易数目
+const defaultProperFeeTxCount = 1000
+
+func init() {
+	drivers.Reg("price", New)
+}
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	//估算手续费时参考的排队交易数目，一般设置为一个区块能打包的交易数目
+	ProperFeeTxCount int64 `json:"properFeeTxCount"`
+}
+
+//New 创建按照手续费率排序的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.ProperFeeTxCount == 0 {
+		subcfg.ProperFeeTxCount = defaultProperFeeTxCount
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
@@ -0,0 +1,253 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"container/heap"
+	"container/list"
+
+	"github.com/33cn/chain33/common/skiplist"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+//Queue 按照手续费率从高到低排序的队列，同一个账户的交易按照进入mempool的先后顺序打包
+//heads中只保存每个账户最早的一笔交易，交易进出队列时维护，遍历时不需要重建所有账户的排序
+//accTxs和mempool的AccountTxIndex中的交易相同，但是不能直接复用:
+//队列作为插件只能通过QueueCache接口访问，拿不到mempool的cache；队列已满时在Push中淘汰交易，
+//此时AccountTxIndex还没有更新；遍历时需要从队列元素O(1)找到同一账户的下一笔交易及其手续费率，
+//而AccountTxIndex中保存的是交易。两者都只在mempool cache的Push，Remove以及淘汰回调中修改，保持一致
+type Queue struct {
+	*skiplist.Queue
+	subConfig subConfig
+	heads     *skiplist.Queue
+	accTxs    map[string]*list.List
+	evict     func(item *drivers.Item)
+	seq       int64
+}
+
+//NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig: subcfg,
+		heads:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		accTxs:    make(map[string]*list.List),
+	}
+}
+
+//priceScore 交易的手续费率，score为每字节的手续费放大1000倍，与MinTxFeeRate的单位一致
+type priceScore struct {
+	*drivers.Item
+	hash  []byte
+	size  int64
+	score int64
+	seq   int64
+	from  string
+	elem  *list.Element
+}
+
+func (cache *Queue) newPriceScore(item *drivers.Item, hash []byte) *priceScore {
+	size := int64(types.NewTransactionCache(item.Value).Size())
+	if size == 0 {
+		size = 1
+	}
+	cache.seq++
+	return &priceScore{Item: item, hash: hash, size: size, score: item.Value.Fee * 1000 / size, seq: cache.seq, from: item.Value.From()}
+}
+
+//next 同一个账户的下一笔交易
+func (item *priceScore) next() *priceScore {
+	if e := item.elem.Next(); e != nil {
+		return e.Value.(*priceScore)
+	}
+	return nil
+}
+
+//before 手续费率高的优先，相同时先进入队列的优先
+func (item *priceScore) before(it *priceScore) bool {
+	if item.score != it.score {
+		return item.score > it.score
+	}
+	return item.seq < it.seq
+}
+
+//GetScore 手续费率
+func (item *priceScore) GetScore() int64 {
+	return item.score
+}
+
+//Hash 交易哈希
+func (item *priceScore) Hash() []byte {
+	return item.hash
+}
+
+//Compare 手续费率相同时先进入队列的优先
+func (item *priceScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*priceScore)
+	if item.seq < it.seq {
+		return skiplist.Big
+	}
+	if item.seq == it.seq {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+//ByteSize 交易大小
+func (item *priceScore) ByteSize() int64 {
+	return item.size
+}
+
+//SetEvictCallback 设置交易被淘汰时的回调
+func (cache *Queue) SetEvictCallback(cb func(item *drivers.Item)) {
+	cache.evict = cb
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*drivers.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*priceScore).Item, nil
+}
+
+// Push 把给定tx添加到队列；队列已满时淘汰手续费率最低的交易，新交易的手续费率不高于最低的交易时返回ErrMemFull
+func (cache *Queue) Push(item *drivers.Item) error {
+	hash := item.Value.Hash()
+	if cache.Exist(string(hash)) {
+		return types.ErrTxExist
+	}
+	score := cache.newPriceScore(item, hash)
+	if int64(cache.Size()) >= cache.subConfig.PoolCacheSize {
+		last := cache.Last()
+		if last == nil || score.score <= last.GetScore() {
+			return types.ErrMemFull
+		}
+		tail := last.(*priceScore)
+		err := cache.Remove(string(tail.hash))
+		if err != nil {
+			return err
+		}
+		if cache.evict != nil {
+			cache.evict(tail.Item)
+		}
+	}
+	cache.Insert(string(hash), score)
+	txs, ok := cache.accTxs[score.from]
+	if !ok {
+		txs = list.New()
+		cache.accTxs[score.from] = txs
+	}
+	score.elem = txs.PushBack(score)
+	if txs.Len() == 1 {
+		cache.heads.Insert(string(hash), score)
+	}
+	return nil
+}
+
+// Remove 删除数据，删除的是账户最早的交易时，账户的下一笔交易成为新的head
+func (cache *Queue) Remove(hash string) error {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return err
+	}
+	err = cache.Queue.Remove(hash)
+	if err != nil {
+		return err
+	}
+	score := item.(*priceScore)
+	txs := cache.accTxs[score.from]
+	if txs.Front() == score.elem {
+		err = cache.heads.Remove(hash)
+		if err != nil {
+			return err
+		}
+		if next := score.next(); next != nil {
+			cache.heads.Insert(string(next.hash), next)
+		}
+	}
+	txs.Remove(score.elem)
+	if txs.Len() == 0 {
+		delete(cache.accTxs, score.from)
+	}
+	return nil
+}
+
+// Walk 按照手续费率从高到低遍历队列，同一个账户的交易按照进入mempool的先后顺序遍历
+// 按顺序遍历各个账户最早的交易，已经遍历过的账户的下一笔交易放入堆中一起比较，堆的大小不超过遍历的交易数
+func (cache *Queue) Walk(count int, cb func(value *drivers.Item) bool) {
+	var nexts scoreHeap
+	i := 0
+	//visit 返回false时结束遍历
+	visit := func(item *priceScore) bool {
+		if !cb(item.Item) {
+			return false
+		}
+		i++
+		if i == count {
+			return false
+		}
+		if next := item.next(); next != nil {
+			heap.Push(&nexts, next)
+		}
+		return true
+	}
+	stop := false
+	cache.heads.Walk(0, func(value skiplist.Scorer) bool {
+		head := value.(*priceScore)
+		for nexts.Len() > 0 && nexts[0].before(head) {
+			if !visit(heap.Pop(&nexts).(*priceScore)) {
+				stop = true
+				return false
+			}
+		}
+		if !visit(head) {
+			stop = true
+			return false
+		}
+		return true
+	})
+	for !stop && nexts.Len() > 0 {
+		stop = !visit(heap.Pop(&nexts).(*priceScore))
+	}
+}
+
+// GetProperFee 获取合适的手续费率，排队的交易超过properFeeTxCount时，需要高于第properFeeTxCount笔交易的手续费率
+func (cache *Queue) GetProperFee() int64 {
+	count := cache.subConfig.ProperFeeTxCount
+	if int64(cache.Size()) < count {
+		return cache.subConfig.ProperFee
+	}
+	var feeRate int64
+	cache.Queue.Walk(int(count), func(value skiplist.Scorer) bool {
+		feeRate = value.GetScore()
+		return true
+	})
+	if feeRate+1 > cache.subConfig.ProperFee {
+		return feeRate + 1
+	}
+	return cache.subConfig.ProperFee
+}
+
+//scoreHeap 遍历时已经遍历过的账户的下一笔交易
+type scoreHeap []*priceScore
+
+func (h scoreHeap) Len() int { return len(h) }
+
+func (h scoreHeap) Less(i, j int) bool { return h[i].before(h[j]) }
+
+func (h scoreHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *scoreHeap) Push(x interface{}) { *h = append(*h, x.(*priceScore)) }
+
+func (h *scoreHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
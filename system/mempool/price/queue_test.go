@@ -0,0 +1,160 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = types.NewChain33Config(types.GetDefaultCfgstring())
+
+func newItem(priv crypto.PrivKey, fee int64) *drivers.Item {
+	tx := util.CreateNoneTx(cfg, priv)
+	tx.Fee = fee
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+}
+
+func walkItems(cache *Queue, count int) []*drivers.Item {
+	var items []*drivers.Item
+	cache.Walk(count, func(item *drivers.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+func TestNewMempool(t *testing.T) {
+	sub, _ := json.Marshal(&subConfig{PoolCacheSize: 2})
+	module := New(&types.Mempool{}, sub)
+	mem := module.(*drivers.Mempool)
+	mem.Close()
+}
+
+func TestQueueWalk(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	cache := NewQueue(subConfig{PoolCacheSize: 10, ProperFee: 100000, ProperFeeTxCount: 10})
+	a1 := newItem(priv1, 100000)
+	a2 := newItem(priv1, 10000000)
+	b1 := newItem(priv2, 1000000)
+	for _, item := range []*drivers.Item{a1, a2, b1} {
+		require.NoError(t, cache.Push(item))
+	}
+	assert.Equal(t, types.ErrTxExist, cache.Push(a1))
+	item, err := cache.GetItem(string(a2.Value.Hash()))
+	require.NoError(t, err)
+	assert.Equal(t, a2, item)
+
+	//同一个账户的交易按照先后顺序打包，手续费率高的a2排在a1之后
+	assert.Equal(t, []*drivers.Item{b1, a1, a2}, walkItems(cache, 0))
+	assert.Equal(t, []*drivers.Item{b1}, walkItems(cache, 1))
+	var i int
+	cache.Walk(0, func(item *drivers.Item) bool {
+		i++
+		return false
+	})
+	assert.Equal(t, 1, i)
+
+	require.NoError(t, cache.Remove(string(a1.Value.Hash())))
+	assert.Equal(t, []*drivers.Item{a2, b1}, walkItems(cache, 0))
+	assert.Equal(t, types.ErrNotFound, cache.Remove(string(a1.Value.Hash())))
+	assert.Equal(t, 2, cache.Size())
+}
+
+//TestQueueWalkHeads 每一步遍历的交易都是各个账户当前最早的交易中手续费率最高的
+func TestQueueWalkHeads(t *testing.T) {
+	cache := NewQueue(subConfig{PoolCacheSize: 100, ProperFee: 100000, ProperFeeTxCount: 10})
+	accTxs := make(map[string][]*drivers.Item)
+	var removed []*drivers.Item
+	for i := 0; i < 5; i++ {
+		addr, priv := util.Genaddress()
+		for j := 0; j < 4; j++ {
+			item := newItem(priv, int64(100000*(1+(i*7+j*3)%5)))
+			require.NoError(t, cache.Push(item))
+			accTxs[addr] = append(accTxs[addr], item)
+		}
+		//删除账户最早的交易以及中间的交易
+		removed = append(removed, accTxs[addr][0], accTxs[addr][2])
+	}
+	for _, item := range removed {
+		require.NoError(t, cache.Remove(string(item.Value.Hash())))
+	}
+	pending := make(map[string][]*drivers.Item)
+	for addr, items := range accTxs {
+		pending[addr] = []*drivers.Item{items[1], items[3]}
+	}
+
+	items := walkItems(cache, 0)
+	require.Equal(t, 10, len(items))
+	for _, item := range items {
+		from := item.Value.From()
+		require.True(t, len(pending[from]) > 0)
+		assert.Equal(t, pending[from][0], item)
+		score, err := cache.Queue.GetItem(string(item.Value.Hash()))
+		require.NoError(t, err)
+		for _, txs := range pending {
+			if len(txs) == 0 {
+				continue
+			}
+			head, err := cache.Queue.GetItem(string(txs[0].Value.Hash()))
+			require.NoError(t, err)
+			assert.True(t, score.GetScore() >= head.GetScore())
+		}
+		pending[from] = pending[from][1:]
+	}
+	assert.Equal(t, items[:3], walkItems(cache, 3))
+}
+
+func TestQueueEvict(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	cache := NewQueue(subConfig{PoolCacheSize: 2, ProperFee: 100000, ProperFeeTxCount: 2})
+	var evicted []*drivers.Item
+	cache.SetEvictCallback(func(item *drivers.Item) {
+		evicted = append(evicted, item)
+	})
+	a1 := newItem(priv1, 200000)
+	assert.Equal(t, int64(100000), cache.GetProperFee())
+	require.NoError(t, cache.Push(a1))
+	a2 := newItem(priv1, 300000)
+	require.NoError(t, cache.Push(a2))
+	//队列已满时返回第ProperFeeTxCount笔交易的手续费率
+	score, err := cache.Queue.GetItem(string(a1.Value.Hash()))
+	require.NoError(t, err)
+	assert.Equal(t, score.GetScore()+1, cache.GetProperFee())
+
+	//手续费率不高于最低的交易时无法进入队列
+	assert.Equal(t, types.ErrMemFull, cache.Push(newItem(priv2, 100000)))
+	b1 := newItem(priv2, 1000000)
+	require.NoError(t, cache.Push(b1))
+	assert.Equal(t, []*drivers.Item{a1}, evicted)
+	assert.False(t, cache.Exist(string(a1.Value.Hash())))
+	assert.Equal(t, []*drivers.Item{b1, a2}, walkItems(cache, 0))
+}
+
+func TestMempoolEvict(t *testing.T) {
+	addr1, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	sub, _ := json.Marshal(&subConfig{PoolCacheSize: 2})
+	mem := New(&types.Mempool{MinTxFeeRate: 100000}, sub).(*drivers.Mempool)
+	defer mem.Close()
+	require.NoError(t, mem.PushTx(newItem(priv1, 200000).Value))
+	require.NoError(t, mem.PushTx(newItem(priv1, 300000).Value))
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(addr1))
+
+	//淘汰的交易同时从账户索引中删除
+	require.NoError(t, mem.PushTx(newItem(priv2, 1000000).Value))
+	assert.Equal(t, 2, mem.Size())
+	assert.Equal(t, int64(1), mem.TxNumOfAccount(addr1))
+	assert.Equal(t, int64(300000), mem.GetAccTxs(&types.ReqAddrs{Addrs: []string{addr1}}).Txs[0].Tx.Fee)
+}
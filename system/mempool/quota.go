@@ -0,0 +1,220 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+//配额的维度
+const (
+	quotaAccount = iota
+	quotaExec
+	quotaPeer
+	quotaTypeCount
+)
+
+var quotaNames = [quotaTypeCount]string{"account", "exec", "peer"}
+
+//quotaUsage 单个账户，执行器或者节点的配额使用情况
+type quotaUsage struct {
+	count  int64
+	bytes  int64
+	second int64
+	rate   int64
+}
+
+//quotaTx mempool中交易占用的配额
+type quotaTx struct {
+	keys [quotaTypeCount]string
+	size int64
+}
+
+//txQuota 按照账户，执行器以及来源节点限制mempool中的交易数量，总字节数以及每秒接收的交易数量
+type txQuota struct {
+	mtx    sync.Mutex
+	limits [quotaTypeCount]*types.MempoolQuota
+	usages [quotaTypeCount]map[string]*quotaUsage
+	txs    map[string]*quotaTx
+	//交易进入mempool时记录来源节点
+	peers     *lru.Cache
+	lastPurge int64
+}
+
+//newTxQuota 没有配置任何配额时返回nil
+func newTxQuota(cfg *types.Mempool) *txQuota {
+	limits := [quotaTypeCount]*types.MempoolQuota{cfg.AccountQuota, cfg.ExecQuota, cfg.PeerQuota}
+	var enable bool
+	for _, limit := range limits {
+		if limit != nil {
+			enable = true
+		}
+	}
+	if !enable {
+		return nil
+	}
+	q := &txQuota{limits: limits, txs: make(map[string]*quotaTx)}
+	for i := range q.usages {
+		q.usages[i] = make(map[string]*quotaUsage)
+	}
+	q.peers, _ = lru.New(int(cfg.PoolCacheSize))
+	return q
+}
+
+func quotaKeys(tx *types.Transaction, peer string) [quotaTypeCount]string {
+	return [quotaTypeCount]string{tx.From(), string(tx.Execer), peer}
+}
+
+//setPeer 记录交易的来源节点
+func (q *txQuota) setPeer(tx *types.Transaction, peer string) {
+	if peer != "" {
+		q.peers.Add(string(tx.Hash()), peer)
+	}
+}
+
+func (q *txQuota) getPeer(hash string) string {
+	peer, ok := q.peers.Get(hash)
+	if !ok {
+		return ""
+	}
+	return peer.(string)
+}
+
+//check 检查交易是否超过mempool中的交易数量和字节数配额，交易加入mempool之后才占用配额
+//rate为true时同时检查每秒接收的交易数量，通过检查时在同一个锁中计入接收速率，之后加入mempool失败的交易也会计数
+func (q *txQuota) check(tx *types.Transaction, size int64, rate bool) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	now := types.Now().Unix()
+	if rate {
+		q.purge(now)
+	}
+	keys := quotaKeys(tx, q.getPeer(string(tx.Hash())))
+	for i, key := range keys {
+		limit := q.limits[i]
+		if limit == nil || key == "" {
+			continue
+		}
+		usage := q.usages[i][key]
+		if usage == nil {
+			usage = &quotaUsage{}
+		}
+		var err error
+		switch {
+		case limit.MaxTxCount > 0 && usage.count+1 > limit.MaxTxCount:
+			err = types.ErrQuotaTxCount
+		case limit.MaxTxBytes > 0 && usage.bytes+size > limit.MaxTxBytes:
+			err = types.ErrQuotaTxBytes
+		case rate && limit.MaxTxPerSecond > 0 && usage.second == now && usage.rate+1 > limit.MaxTxPerSecond:
+			err = types.ErrQuotaTxRate
+		}
+		if err != nil {
+			metrics.GetOrRegisterCounter("mempool/quota/"+quotaNames[i]+"/"+err.Error(), nil).Inc(1)
+			mlog.Debug("quota check", "type", quotaNames[i], "key", key, "err", err)
+			return err
+		}
+	}
+	if rate {
+		q.received(keys, now)
+	}
+	return nil
+}
+
+//received 计入每秒接收的交易数量，调用方需要持有锁
+func (q *txQuota) received(keys [quotaTypeCount]string, now int64) {
+	for i, key := range keys {
+		if q.limits[i] == nil || key == "" {
+			continue
+		}
+		usage := q.usages[i][key]
+		if usage == nil {
+			usage = &quotaUsage{}
+			q.usages[i][key] = usage
+		}
+		if usage.second != now {
+			usage.second = now
+			usage.rate = 0
+		}
+		usage.rate++
+	}
+}
+
+//purge 每秒清理一次没有交易的记录
+func (q *txQuota) purge(now int64) {
+	if now == q.lastPurge {
+		return
+	}
+	q.lastPurge = now
+	for i := range q.usages {
+		for key, usage := range q.usages[i] {
+			if usage.count == 0 && usage.second < now {
+				delete(q.usages[i], key)
+			}
+		}
+	}
+}
+
+//add 交易加入mempool时占用配额
+func (q *txQuota) add(tx *types.Transaction) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	hash := string(tx.Hash())
+	qtx := &quotaTx{keys: quotaKeys(tx, q.getPeer(hash)), size: int64(tx.Size())}
+	q.peers.Remove(hash)
+	q.txs[hash] = qtx
+	for i, key := range qtx.keys {
+		if q.limits[i] == nil || key == "" {
+			continue
+		}
+		usage := q.usages[i][key]
+		if usage == nil {
+			usage = &quotaUsage{}
+			q.usages[i][key] = usage
+		}
+		usage.count++
+		usage.bytes += qtx.size
+	}
+}
+
+//remove 交易从mempool中删除时释放配额
+func (q *txQuota) remove(tx *types.Transaction) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	hash := string(tx.Hash())
+	qtx, ok := q.txs[hash]
+	if !ok {
+		return
+	}
+	delete(q.txs, hash)
+	for i, key := range qtx.keys {
+		if q.limits[i] == nil || key == "" {
+			continue
+		}
+		usage := q.usages[i][key]
+		if usage == nil {
+			continue
+		}
+		usage.count--
+		usage.bytes -= qtx.size
+	}
+}
+
+//checkQuota 检查交易的来源是否超过配额以及接收速率的限制
+func (mem *Mempool) checkQuota(msg *queue.Message) *queue.Message {
+	if mem.quota == nil {
+		return msg
+	}
+	tx := msg.GetData().(types.TxGroup)
+	err := mem.quota.check(tx.Tx(), int64(types.Size(tx.Tx())), true)
+	if err != nil {
+		msg.Data = err
+	}
+	return msg
+}
@@ -0,0 +1,142 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendRecvTx(client queue.Client, tx *types.Transaction, peer string) error {
+	msg := client.NewMessage("mempool", types.EventTx, &types.P2PRecvTx{Tx: tx, Peer: peer})
+	err := client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	return checkReply(resp.GetData().(*types.Reply))
+}
+
+func TestNoQuota(t *testing.T) {
+	assert.Nil(t, newTxQuota(&types.Mempool{}))
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	assert.Nil(t, mem.quota)
+	_, priv := genaddress()
+	assert.NoError(t, sendRecvTx(q.Client(), createTx(priv, toAddr, 10000), "peer1"))
+	assert.Equal(t, 1, mem.Size())
+}
+
+func TestAccountQuota(t *testing.T) {
+	q, mem := initEnv(0, func(cfg *types.Config) {
+		cfg.Mempool.AccountQuota = &types.MempoolQuota{MaxTxCount: 2}
+	})
+	defer q.Close()
+	defer mem.Close()
+
+	addr, priv := genaddress()
+	tx1 := createTx(priv, toAddr, 10000)
+	require.NoError(t, mem.PushTx(tx1))
+	require.NoError(t, mem.PushTx(createTx(priv, toAddr, 10000)))
+	assert.Equal(t, types.ErrQuotaTxCount, mem.PushTx(createTx(priv, toAddr, 10000)))
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(addr))
+
+	//删除交易后释放配额
+	require.NoError(t, mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{tx1.Hash()}}))
+	tx3 := createTx(priv, toAddr, 10000)
+	require.NoError(t, mem.PushTx(tx3))
+
+	//其他账户不受影响
+	_, priv2 := genaddress()
+	require.NoError(t, mem.PushTx(createTx(priv2, toAddr, 10000)))
+	require.NoError(t, mem.PushTx(createTx(priv2, toAddr, 10000)))
+	usage := mem.quota.usages[quotaAccount][addr]
+	assert.Equal(t, int64(2), usage.count)
+	assert.True(t, usage.bytes > int64(tx3.Size()))
+
+	//超过字节数配额
+	mem.quota.limits[quotaAccount].MaxTxCount = 0
+	mem.quota.limits[quotaAccount].MaxTxBytes = usage.bytes
+	assert.Equal(t, types.ErrQuotaTxBytes, mem.PushTx(createTx(priv, toAddr, 10000)))
+}
+
+func TestExecQuotaRate(t *testing.T) {
+	q, mem := initEnv(0, func(cfg *types.Config) {
+		cfg.Mempool.ExecQuota = &types.MempoolQuota{MaxTxPerSecond: 2}
+	})
+	defer q.Close()
+	defer mem.Close()
+
+	addr, priv := genaddress()
+	//通过配额检查之后没有加入mempool的交易也计入接收速率，每秒最多2笔，连续5笔交易最多跨越一秒，至少有一笔被拒绝
+	var errs []error
+	for i := 0; i < 5; i++ {
+		msg := mem.checkTxs(mem.client.NewMessage("mempool", types.EventTx, createTx(priv, toAddr, 10000)))
+		errs = append(errs, mem.checkQuota(msg).Err())
+	}
+	assert.Contains(t, errs, types.ErrQuotaTxRate)
+	assert.Equal(t, 0, mem.Size())
+
+	//并发检查时计数和检查在同一个锁中，同一秒内通过检查的交易不超过2笔
+	var wg sync.WaitGroup
+	var passed int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx := createTx(priv, toAddr, 10000)
+			if mem.quota.check(tx, int64(tx.Size()), true) == nil {
+				atomic.AddInt32(&passed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.True(t, passed < 20)
+	assert.True(t, mem.quota.usages[quotaExec]["coins"].rate <= 2)
+
+	errs = nil
+	for i := 0; i < 5; i++ {
+		errs = append(errs, sendRecvTx(q.Client(), createTx(priv, toAddr, 10000), ""))
+	}
+	assert.Contains(t, errs, types.ErrQuotaTxRate)
+	var ok int
+	for _, err := range errs {
+		if err == nil {
+			ok++
+		}
+	}
+	assert.Equal(t, ok, mem.Size())
+	//没有配置限制的维度不记录
+	assert.Nil(t, mem.quota.usages[quotaAccount][addr])
+	assert.Nil(t, mem.quota.usages[quotaPeer][""])
+}
+
+func TestPeerQuota(t *testing.T) {
+	q, mem := initEnv(0, func(cfg *types.Config) {
+		cfg.Mempool.PeerQuota = &types.MempoolQuota{MaxTxCount: 1}
+	})
+	defer q.Close()
+	defer mem.Close()
+
+	client := q.Client()
+	_, priv := genaddress()
+	require.NoError(t, sendRecvTx(client, createTx(priv, toAddr, 10000), "peer1"))
+	assert.Equal(t, types.ErrQuotaTxCount, sendRecvTx(client, createTx(priv, toAddr, 10000), "peer1"))
+	require.NoError(t, sendRecvTx(client, createTx(priv, toAddr, 10000), "peer2"))
+	//本地提交的交易不受节点配额限制
+	require.NoError(t, mem.PushTx(createTx(priv, toAddr, 10000)))
+	assert.Equal(t, 3, mem.Size())
+	assert.Equal(t, types.ErrEmptyTx, sendRecvTx(client, nil, "peer1"))
+}
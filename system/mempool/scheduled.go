@@ -0,0 +1,185 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//scheduledTxs 还没有到达生效高度或者时间的交易的等待区，由mempool.proxyMtx保护
+type scheduledTxs struct {
+	max           int64
+	maxPerAccount int64
+	txs           map[string]*Item
+	accounts      map[string]int64
+}
+
+func newScheduledTxs(max, maxPerAccount int64) *scheduledTxs {
+	return &scheduledTxs{
+		max:           max,
+		maxPerAccount: maxPerAccount,
+		txs:           make(map[string]*Item),
+		accounts:      make(map[string]int64),
+	}
+}
+
+func (s *scheduledTxs) exist(hash string) bool {
+	_, ok := s.txs[hash]
+	return ok
+}
+
+func (s *scheduledTxs) add(item *Item) error {
+	hash := string(item.Value.Hash())
+	if s.exist(hash) {
+		return types.ErrTxExist
+	}
+	if int64(len(s.txs)) >= s.max {
+		return types.ErrScheduledTxFull
+	}
+	from := item.Value.From()
+	if s.accounts[from] >= s.maxPerAccount {
+		return types.ErrManyTx
+	}
+	s.txs[hash] = item
+	s.accounts[from]++
+	return nil
+}
+
+func (s *scheduledTxs) remove(hash string, item *Item) {
+	delete(s.txs, hash)
+	from := item.Value.From()
+	s.accounts[from]--
+	if s.accounts[from] <= 0 {
+		delete(s.accounts, from)
+	}
+}
+
+//release 从等待区中取出已经生效以及已经过期的交易，和mempool中的交易一样，超过mempoolExpiredInterval的交易也视为过期
+func (s *scheduledTxs) release(cfg *types.Chain33Config, height, blocktime int64) (mature, expired []*types.Transaction) {
+	for hash, item := range s.txs {
+		if isExpired(cfg, item, height, blocktime) {
+			expired = append(expired, item.Value)
+		} else if item.Value.IsMature(height, blocktime) {
+			mature = append(mature, item.Value)
+		} else {
+			continue
+		}
+		s.remove(hash, item)
+	}
+	sortScheduledTxs(mature)
+	return mature, expired
+}
+
+//list 按照生效的高度或者时间排序返回等待区中的交易
+func (s *scheduledTxs) list() []*types.Transaction {
+	txs := make([]*types.Transaction, 0, len(s.txs))
+	for _, item := range s.txs {
+		txs = append(txs, item.Value)
+	}
+	sortScheduledTxs(txs)
+	return txs
+}
+
+func sortScheduledTxs(txs []*types.Transaction) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].NotBefore != txs[j].NotBefore {
+			return txs[i].NotBefore < txs[j].NotBefore
+		}
+		return string(txs[i].Hash()) < string(txs[j].Hash())
+	})
+}
+
+//isScheduled 交易在下一个区块中还不能被打包，区块时间按照当前时间计算
+func (mem *Mempool) isScheduled(tx *types.Transaction) bool {
+	return !tx.IsMature(mem.GetHeader().GetHeight()+1, types.Now().Unix())
+}
+
+//checkNotBefore 交易的生效高度或者时间不能超过当前高度或者时间太多
+func (mem *Mempool) checkNotBefore(tx *types.Transaction, height, now int64) error {
+	txs := []*types.Transaction{tx}
+	if group, _ := tx.GetTxGroup(); group != nil {
+		txs = group.Txs
+	}
+	for _, tx := range txs {
+		if tx.NotBefore == 0 {
+			continue
+		}
+		if tx.NotBefore <= types.ExpireBound {
+			if tx.NotBefore-height > mem.cfg.MaxNotBeforeHeight {
+				return types.ErrTxNotBeforeTooFar
+			}
+		} else if tx.NotBefore-now > mem.cfg.MaxNotBeforeTime {
+			return types.ErrTxNotBeforeTooFar
+		}
+	}
+	return nil
+}
+
+//pushScheduledTx 未生效的交易加入等待区
+func (mem *Mempool) pushScheduledTx(tx *types.Transaction) error {
+	return mem.pushScheduledItem(&Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}, true)
+}
+
+//pushScheduledItem 交易加入等待区，journal为true时同时记录到磁盘，恢复的交易已经有记录
+func (mem *Mempool) pushScheduledItem(item *Item, journal bool) error {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	tx := item.Value
+	if mem.cache.Exist(string(tx.Hash())) {
+		return types.ErrTxExist
+	}
+	err := mem.checkNotBefore(tx, mem.header.GetHeight(), types.Now().Unix())
+	if err != nil {
+		return err
+	}
+	err = mem.scheduled.add(item)
+	if err != nil {
+		return err
+	}
+	if journal && mem.journal != nil {
+		mem.journal.add(item)
+	}
+	return nil
+}
+
+//releaseScheduledTxs 新的区块之后，把等待区中已经生效的交易加入mempool，删除已经过期的交易
+func (mem *Mempool) releaseScheduledTxs() {
+	types.AssertConfig(mem.client)
+	mem.proxyMtx.Lock()
+	height := mem.header.GetHeight()
+	mature, expired := mem.scheduled.release(mem.client.GetConfig(), height+1, types.Now().Unix())
+	mem.proxyMtx.Unlock()
+	for _, tx := range expired {
+		if mem.journal != nil {
+			mem.journal.remove(tx)
+		}
+		mem.txEvents.emit(types.TxEventExpired, tx, types.ErrTxExpire.Error(), height, nil)
+	}
+	//加入mempool之后覆盖原来的记录
+	for _, tx := range mature {
+		if err := mem.PushTx(tx); err != nil {
+			mlog.Error("releaseScheduledTxs", "hash", types.CalcTxShortHash(tx.Hash()), "err", err)
+			if mem.journal != nil {
+				mem.journal.remove(tx)
+			}
+			mem.txEvents.emit(types.TxEventRejected, tx, err.Error(), height, nil)
+		}
+	}
+}
+
+//getScheduledTxs 获取等待区中的交易
+func (mem *Mempool) getScheduledTxs() []*types.Transaction {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.scheduled.list()
+}
+
+// eventGetScheduledTxs 获取还没有生效的交易
+func (mem *Mempool) eventGetScheduledTxs(msg *queue.Message) {
+	msg.Reply(mem.client.NewMessage("rpc", types.EventReplyTxList, &types.ReplyTxList{Txs: mem.getScheduledTxs()}))
+}
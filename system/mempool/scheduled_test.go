@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//localTitle 使用local的fork配置，开启ForkTxNotBefore
+func localTitle(cfg *types.Config) {
+	cfg.Title = "local"
+}
+
+func createScheduledTx(notBefore int64) *types.Transaction {
+	_, priv := genaddress()
+	tx := createTx(priv, toAddr, 10000)
+	tx.NotBefore = notBefore
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestScheduledTxs(t *testing.T) {
+	q, mem := initEnv(0, localTitle)
+	defer q.Close()
+	defer mem.Close()
+	client := q.Client()
+
+	//当前高度为1，下一个区块可以打包生效高度为2的交易
+	byHeight := createScheduledTx(5)
+	byTime := createScheduledTx(types.Now().Unix() + 300)
+	mature := createScheduledTx(2)
+	for _, tx := range []*types.Transaction{byHeight, byTime, mature} {
+		reply := sendMempoolMsg(t, client, types.EventTx, tx).(*types.Reply)
+		require.True(t, reply.IsOk, string(reply.Msg))
+	}
+	assert.Equal(t, 1, mem.Size())
+	txs := sendMempoolMsg(t, client, types.EventGetScheduledTxs, &types.ReqNil{}).(*types.ReplyTxList).Txs
+	require.Equal(t, 2, len(txs))
+	assert.Equal(t, byHeight.Hash(), txs[0].Hash())
+	assert.Equal(t, byTime.Hash(), txs[1].Hash())
+	assert.Equal(t, types.ErrTxExist, mem.pushScheduledTx(byHeight))
+	assert.Equal(t, types.ErrTxExist, mem.checkTxMempool(byHeight))
+	//生效的高度或者时间太远
+	assert.Equal(t, types.ErrTxNotBeforeTooFar, mem.pushScheduledTx(createScheduledTx(102)))
+	assert.Equal(t, types.ErrTxNotBeforeTooFar, mem.pushScheduledTx(createScheduledTx(types.Now().Unix()+601)))
+
+	//高度4的区块之后，生效高度为5的交易加入mempool
+	blk := &types.Block{Height: 4, BlockTime: types.Now().Unix()}
+	require.NoError(t, client.Send(client.NewMessage("mempool", types.EventAddBlock, &types.BlockDetail{Block: blk}), false))
+	size := sendMempoolMsg(t, client, types.EventGetMempoolSize, nil).(*types.MempoolSize)
+	assert.Equal(t, int64(2), size.Size)
+	txs = sendMempoolMsg(t, client, types.EventGetScheduledTxs, &types.ReqNil{}).(*types.ReplyTxList).Txs
+	require.Equal(t, 1, len(txs))
+	assert.Equal(t, byTime.Hash(), txs[0].Hash())
+}
+
+func scheduledItem(tx *types.Transaction, enterTime int64) *Item {
+	return &Item{Value: tx, Priority: tx.Fee, EnterTime: enterTime}
+}
+
+func TestScheduledTxsRelease(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	now := types.Now().Unix()
+	s := newScheduledTxs(3, 1)
+	expire := createScheduledTx(10)
+	expire.Expire = 8
+	tx1 := createScheduledTx(10)
+	tx2 := createScheduledTx(20)
+	//没有设置过期时间的交易进入等待区超过mempoolExpiredInterval之后删除
+	evicted := createScheduledTx(20)
+	require.NoError(t, s.add(scheduledItem(expire, now)))
+	require.NoError(t, s.add(scheduledItem(tx1, now)))
+	require.NoError(t, s.add(scheduledItem(evicted, now-mempoolExpiredInterval)))
+	assert.Equal(t, types.ErrScheduledTxFull, s.add(scheduledItem(tx2, now)))
+
+	mature, expired := s.release(cfg, 5, 0)
+	assert.Equal(t, 0, len(mature))
+	require.Equal(t, 1, len(expired))
+	assert.Equal(t, evicted.Hash(), expired[0].Hash())
+	mature, expired = s.release(cfg, 10, 0)
+	require.Equal(t, 1, len(mature))
+	assert.Equal(t, tx1.Hash(), mature[0].Hash())
+	require.Equal(t, 1, len(expired))
+	assert.Equal(t, expire.Hash(), expired[0].Hash())
+	assert.Equal(t, 0, len(s.list()))
+	assert.Equal(t, 0, len(s.accounts))
+
+	//每个账户的交易数量限制
+	_, priv := genaddress()
+	tx3 := createTx(priv, toAddr, 10000)
+	tx3.NotBefore = 20
+	tx3.Sign(types.SECP256K1, priv)
+	tx4 := createTx(priv, toAddr, 10000)
+	tx4.NotBefore = 20
+	tx4.Sign(types.SECP256K1, priv)
+	require.NoError(t, s.add(scheduledItem(tx3, now)))
+	assert.Equal(t, types.ErrManyTx, s.add(scheduledItem(tx4, now)))
+}
+
+func TestScheduledTxsJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempoolscheduled")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	journalCfg := func(cfg *types.Config) {
+		localTitle(cfg)
+		cfg.Mempool.JournalPath = dir
+	}
+
+	q, mem := initEnv(0, journalCfg)
+	tx := createScheduledTx(5)
+	require.NoError(t, mem.pushScheduledTx(tx))
+	mem.Close()
+	q.Close()
+
+	//重启之后还没有生效的交易恢复到等待区
+	q, mem = initEnv(0, journalCfg)
+	defer q.Close()
+	defer mem.Close()
+	assert.Equal(t, 0, mem.Size())
+	txs := mem.getScheduledTxs()
+	require.Equal(t, 1, len(txs))
+	assert.Equal(t, tx.Hash(), txs[0].Hash())
+	assert.Equal(t, 1, len(mem.journal.load()))
+}
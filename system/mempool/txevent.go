@@ -0,0 +1,186 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	maxTxSubscriber   = 100             //最多的订阅数目
+	txEventBufferSize = 1024            //每个订阅缓存的事件数目，超过时丢弃新的事件
+	maxTxEventsFetch  = 100             //每次最多获取的事件数目
+	txEventFetchWait  = 2 * time.Second //没有事件时等待的时间
+)
+
+//txSubscriber 订阅交易状态变化事件的客户端
+type txSubscriber struct {
+	hashes    map[string]bool
+	addrs     map[string]bool
+	events    chan *types.TxLifecycleEvent
+	lastFetch int64
+}
+
+func (sub *txSubscriber) match(hash []byte, from, to string) bool {
+	if len(sub.hashes) == 0 && len(sub.addrs) == 0 {
+		return true
+	}
+	return sub.hashes[string(hash)] || sub.addrs[from] || sub.addrs[to]
+}
+
+//txEventHub 分发mempool中交易的状态变化事件：进入mempool，被拒绝，被淘汰，过期，被替换以及被打包
+type txEventHub struct {
+	mtx  sync.RWMutex
+	subs map[string]*txSubscriber
+}
+
+func newTxEventHub() *txEventHub {
+	return &txEventHub{subs: make(map[string]*txSubscriber)}
+}
+
+//subscribe 添加订阅，相同名字的订阅会被覆盖，长时间没有获取事件的订阅会被删除
+func (hub *txEventHub) subscribe(req *types.ReqSubTxEvents) error {
+	if req == nil || req.Name == "" {
+		return types.ErrInvalidParam
+	}
+	sub := &txSubscriber{
+		hashes:    make(map[string]bool),
+		addrs:     make(map[string]bool),
+		events:    make(chan *types.TxLifecycleEvent, txEventBufferSize),
+		lastFetch: types.Now().Unix(),
+	}
+	for _, hash := range req.Hashes {
+		sub.hashes[string(hash)] = true
+	}
+	for _, addr := range req.Addrs {
+		sub.addrs[addr] = true
+	}
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+	for name, s := range hub.subs {
+		if sub.lastFetch-s.lastFetch >= mempoolExpiredInterval {
+			delete(hub.subs, name)
+		}
+	}
+	if _, ok := hub.subs[req.Name]; !ok && len(hub.subs) >= maxTxSubscriber {
+		return types.ErrTooManySubscriber
+	}
+	hub.subs[req.Name] = sub
+	return nil
+}
+
+//unsubscribe 取消订阅
+func (hub *txEventHub) unsubscribe(name string) error {
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+	if _, ok := hub.subs[name]; !ok {
+		return types.ErrNotFound
+	}
+	delete(hub.subs, name)
+	return nil
+}
+
+//emit 把事件发送给所有匹配的订阅，订阅的缓存已满时丢弃
+func (hub *txEventHub) emit(ty int32, tx *types.Transaction, reason string, height int64, replacedBy []byte) {
+	if hub == nil {
+		return
+	}
+	hub.mtx.RLock()
+	defer hub.mtx.RUnlock()
+	if len(hub.subs) == 0 {
+		return
+	}
+	hash := tx.Hash()
+	from := tx.From()
+	var event *types.TxLifecycleEvent
+	for name, sub := range hub.subs {
+		if !sub.match(hash, from, tx.To) {
+			continue
+		}
+		if event == nil {
+			event = &types.TxLifecycleEvent{
+				Hash:       hash,
+				From:       from,
+				Ty:         ty,
+				Reason:     reason,
+				Height:     height,
+				ReplacedBy: replacedBy,
+				Time:       types.Now().Unix(),
+			}
+		}
+		select {
+		case sub.events <- event:
+		default:
+			mlog.Debug("txEventHub emit buffer full", "name", name, "ty", ty)
+		}
+	}
+}
+
+//fetch 获取订阅的事件，没有事件时等待timeout，超时返回空的列表
+func (hub *txEventHub) fetch(name string, timeout time.Duration) (*types.TxLifecycleEvents, error) {
+	hub.mtx.Lock()
+	sub, ok := hub.subs[name]
+	if ok {
+		sub.lastFetch = types.Now().Unix()
+	}
+	hub.mtx.Unlock()
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	events := &types.TxLifecycleEvents{}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case event := <-sub.events:
+		events.Events = append(events.Events, event)
+	case <-timer.C:
+		return events, nil
+	}
+	for len(events.Events) < maxTxEventsFetch {
+		select {
+		case event := <-sub.events:
+			events.Events = append(events.Events, event)
+		default:
+			return events, nil
+		}
+	}
+	return events, nil
+}
+
+// eventSubTxEvents 订阅交易的状态变化事件
+func (mem *Mempool) eventSubTxEvents(msg *queue.Message) {
+	err := mem.txEvents.subscribe(msg.GetData().(*types.ReqSubTxEvents))
+	msg.ReplyErr("EventSubTxEvents", err)
+}
+
+// eventGetTxEvents 获取订阅的事件，没有事件时会等待，需要在单独的goroutine中处理
+func (mem *Mempool) eventGetTxEvents(msg *queue.Message) {
+	events, err := mem.txEvents.fetch(msg.GetData().(*types.ReqString).GetData(), txEventFetchWait)
+	if err != nil {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventGetTxEvents, err))
+		return
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventGetTxEvents, events))
+}
+
+// eventUnSubTxEvents 取消订阅
+func (mem *Mempool) eventUnSubTxEvents(msg *queue.Message) {
+	err := mem.txEvents.unsubscribe(msg.GetData().(*types.ReqString).GetData())
+	msg.ReplyErr("EventUnSubTxEvents", err)
+}
+
+//checkWithEvent 交易检查失败时发送交易被拒绝的事件
+func (mem *Mempool) checkWithEvent(data *queue.Message, check func(*queue.Message) *queue.Message) *queue.Message {
+	tx, ok := data.GetData().(types.TxGroup)
+	data = check(data)
+	if ok && data.Err() != nil {
+		mem.txEvents.emit(types.TxEventRejected, tx.Tx(), data.Err().Error(), 0, nil)
+	}
+	return data
+}
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTxEvents(t *testing.T, client queue.Client, name string) []*types.TxLifecycleEvent {
+	msg := client.NewMessage("mempool", types.EventGetTxEvents, &types.ReqString{Data: name})
+	require.NoError(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.NoError(t, err)
+	return resp.GetData().(*types.TxLifecycleEvents).GetEvents()
+}
+
+func TestTxEvents(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	client := q.Client()
+
+	addr, priv := genaddress()
+	msg := client.NewMessage("mempool", types.EventSubTxEvents, &types.ReqSubTxEvents{Name: "sub", Addrs: []string{addr}})
+	require.NoError(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.NoError(t, err)
+	require.True(t, resp.GetData().(*types.Reply).GetIsOk())
+
+	//进入mempool以及被拒绝
+	tx1 := createTx(priv, toAddr, 10000)
+	msg = client.NewMessage("mempool", types.EventTx, tx1)
+	require.NoError(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	require.NoError(t, err)
+	lowFee := createTx(priv, toAddr, 10000)
+	lowFee.Fee = 1
+	lowFee.Sign(types.SECP256K1, priv)
+	msg = client.NewMessage("mempool", types.EventTx, lowFee)
+	require.NoError(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	require.NoError(t, err)
+	//其他账户的交易不会通知
+	_, priv2 := genaddress()
+	require.NoError(t, mem.PushTx(createTx(priv2, toAddr, 10000)))
+
+	events := getTxEvents(t, client, "sub")
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, tx1.Hash(), events[0].Hash)
+	assert.Equal(t, addr, events[0].From)
+	assert.Equal(t, int32(types.TxEventAccepted), events[0].Ty)
+	assert.Equal(t, lowFee.Hash(), events[1].Hash)
+	assert.Equal(t, int32(types.TxEventRejected), events[1].Ty)
+	assert.Equal(t, types.ErrTxFeeTooLow.Error(), events[1].Reason)
+
+	//被替换，被打包以及过期
+	tx2 := createTx(priv, toAddr, 10000)
+	tx2.Fee = 2e6
+	tx2.ReplaceHash = tx1.Hash()
+	tx2.Sign(types.SECP256K1, priv)
+	require.NoError(t, mem.PushTx(tx2))
+	mem.RemoveTxsOfBlock(&types.Block{Height: 10, Txs: []*types.Transaction{tx2}})
+	tx3 := createTx(priv, toAddr, 10000)
+	require.NoError(t, mem.PushTx(tx3))
+	mem.cache.qcache.Walk(0, func(item *Item) bool {
+		item.EnterTime -= mempoolExpiredInterval
+		return true
+	})
+	mem.removeExpired()
+	assert.Equal(t, 0, mem.Size())
+
+	events = getTxEvents(t, client, "sub")
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, int32(types.TxEventReplaced), events[0].Ty)
+	assert.Equal(t, tx1.Hash(), events[0].Hash)
+	assert.Equal(t, tx2.Hash(), events[0].ReplacedBy)
+	assert.Equal(t, int32(types.TxEventIncluded), events[1].Ty)
+	assert.Equal(t, int64(10), events[1].Height)
+	assert.Equal(t, int32(types.TxEventExpired), events[2].Ty)
+	assert.Equal(t, tx3.Hash(), events[2].Hash)
+
+	//没有事件时等待超时返回空的列表
+	beg := time.Now()
+	reply, err := mem.txEvents.fetch("sub", 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(reply.Events))
+	assert.True(t, time.Since(beg) >= 10*time.Millisecond)
+
+	msg = client.NewMessage("mempool", types.EventUnSubTxEvents, &types.ReqString{Data: "sub"})
+	require.NoError(t, client.Send(msg, true))
+	resp, err = client.Wait(msg)
+	require.NoError(t, err)
+	assert.True(t, resp.GetData().(*types.Reply).GetIsOk())
+	_, err = mem.txEvents.fetch("sub", time.Millisecond)
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestTxEventHubSubscribe(t *testing.T) {
+	hub := newTxEventHub()
+	assert.Equal(t, types.ErrInvalidParam, hub.subscribe(&types.ReqSubTxEvents{}))
+	for i := 0; i < maxTxSubscriber; i++ {
+		require.NoError(t, hub.subscribe(&types.ReqSubTxEvents{Name: fmt.Sprint(i)}))
+	}
+	assert.Equal(t, types.ErrTooManySubscriber, hub.subscribe(&types.ReqSubTxEvents{Name: "new"}))
+	//覆盖已经存在的订阅
+	require.NoError(t, hub.subscribe(&types.ReqSubTxEvents{Name: "0"}))
+	//长时间没有获取事件的订阅会被删除
+	hub.subs["1"].lastFetch -= mempoolExpiredInterval
+	require.NoError(t, hub.subscribe(&types.ReqSubTxEvents{Name: "new"}))
+	assert.Equal(t, maxTxSubscriber, len(hub.subs))
+	assert.Equal(t, types.ErrNotFound, hub.unsubscribe("1"))
+
+	//缓存已满时丢弃事件
+	_, priv := genaddress()
+	tx := createTx(priv, toAddr, 10000)
+	for i := 0; i < txEventBufferSize+1; i++ {
+		hub.emit(types.TxEventAccepted, tx, "", 0, nil)
+	}
+	assert.Equal(t, txEventBufferSize, len(hub.subs["new"].events))
+	events, err := hub.fetch("new", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, maxTxEventsFetch, len(events.Events))
+}
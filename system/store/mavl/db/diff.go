@@ -0,0 +1,150 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// 每个变化的key在两棵树中的每一层最多展开的节点数
+const diffNodesPerKey = 4
+
+// DiffKVPair 比较两个状态哈希对应的树，返回按照key排序的变化的key以及修改前后的value
+// 两棵树中hash相同的子树直接跳过，只展开不同的节点；oldRoot为空时表示空树
+// 变化的key超过maxCount(大于0时)返回ErrMaxCountPerTime，展开的节点数超过maxCount对应的节点预算时提前返回ErrMaxCountPerTime
+func DiffKVPair(db dbm.DB, oldRoot, newRoot []byte, treeCfg *TreeConfig, maxCount int) ([]*types.KeyValueDiff, error) {
+	oldTree := NewTree(db, true, treeCfg)
+	err := oldTree.Load(oldRoot)
+	if err != nil {
+		return nil, err
+	}
+	newTree := NewTree(db, true, treeCfg)
+	err = newTree.Load(newRoot)
+	if err != nil {
+		return nil, err
+	}
+	oldLevels := make(map[int32][]*Node)
+	newLevels := make(map[int32][]*Node)
+	var maxHeight int32
+	if oldTree.root != nil {
+		oldLevels[oldTree.root.height] = []*Node{oldTree.root}
+		maxHeight = oldTree.root.height
+	}
+	if newTree.root != nil {
+		newLevels[newTree.root.height] = []*Node{newTree.root}
+		if newTree.root.height > maxHeight {
+			maxHeight = newTree.root.height
+		}
+	}
+	//每个变化的key在两棵树中各自最多有一条从根到叶子的路径不同，再加上旋转影响的节点，
+	//展开的节点超过这个预算时变化的key一定超过maxCount，不用等到展开整棵树之后才返回
+	budget := -1
+	if maxCount > 0 {
+		budget = diffNodesPerKey * maxCount * (int(maxHeight) + 1)
+	}
+	//从高到低逐层处理，相同高度下两边hash相同的节点是同一棵子树，不再展开
+	for height := maxHeight; height > 0; height-- {
+		oldNodes, newNodes := pruneSameNodes(oldLevels[height], newLevels[height])
+		delete(oldLevels, height)
+		delete(newLevels, height)
+		err = expandNodes(oldTree, oldNodes, oldLevels, &budget)
+		if err != nil {
+			return nil, err
+		}
+		err = expandNodes(newTree, newNodes, newLevels, &budget)
+		if err != nil {
+			return nil, err
+		}
+	}
+	oldLeaves, newLeaves := pruneSameNodes(oldLevels[0], newLevels[0])
+	sort.Slice(oldLeaves, func(i, j int) bool { return bytes.Compare(oldLeaves[i].key, oldLeaves[j].key) < 0 })
+	sort.Slice(newLeaves, func(i, j int) bool { return bytes.Compare(newLeaves[i].key, newLeaves[j].key) < 0 })
+
+	var diffs []*types.KeyValueDiff
+	i, j := 0, 0
+	for i < len(oldLeaves) || j < len(newLeaves) {
+		var diff *types.KeyValueDiff
+		var cmp int
+		if i >= len(oldLeaves) {
+			cmp = 1
+		} else if j >= len(newLeaves) {
+			cmp = -1
+		} else {
+			cmp = bytes.Compare(oldLeaves[i].key, newLeaves[j].key)
+		}
+		switch {
+		case cmp < 0:
+			diff = &types.KeyValueDiff{Key: oldLeaves[i].key, Ty: types.StateDiffDelete, OldValue: oldLeaves[i].value}
+			i++
+		case cmp > 0:
+			diff = &types.KeyValueDiff{Key: newLeaves[j].key, Ty: types.StateDiffAdd, NewValue: newLeaves[j].value}
+			j++
+		default:
+			if !bytes.Equal(oldLeaves[i].value, newLeaves[j].value) {
+				diff = &types.KeyValueDiff{Key: newLeaves[j].key, Ty: types.StateDiffModify, OldValue: oldLeaves[i].value, NewValue: newLeaves[j].value}
+			}
+			i++
+			j++
+		}
+		if diff == nil {
+			continue
+		}
+		if maxCount > 0 && len(diffs) >= maxCount {
+			return nil, types.ErrMaxCountPerTime
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// pruneSameNodes 去掉两边hash相同的节点
+func pruneSameNodes(oldNodes, newNodes []*Node) ([]*Node, []*Node) {
+	if len(oldNodes) == 0 || len(newNodes) == 0 {
+		return oldNodes, newNodes
+	}
+	oldHashes := make(map[string]bool, len(oldNodes))
+	for _, node := range oldNodes {
+		oldHashes[string(node.hash)] = true
+	}
+	newHashes := make(map[string]bool, len(newNodes))
+	var newRemain []*Node
+	for _, node := range newNodes {
+		newHashes[string(node.hash)] = true
+		if !oldHashes[string(node.hash)] {
+			newRemain = append(newRemain, node)
+		}
+	}
+	var oldRemain []*Node
+	for _, node := range oldNodes {
+		if !newHashes[string(node.hash)] {
+			oldRemain = append(oldRemain, node)
+		}
+	}
+	return oldRemain, newRemain
+}
+
+// expandNodes 将内部节点的左右子节点按照高度放入levels中，budget不小于0时每展开一个节点减1，用完返回ErrMaxCountPerTime
+func expandNodes(t *Tree, nodes []*Node, levels map[int32][]*Node, budget *int) error {
+	for _, node := range nodes {
+		for _, hash := range [][]byte{node.leftHash, node.rightHash} {
+			if *budget == 0 {
+				return types.ErrMaxCountPerTime
+			}
+			if *budget > 0 {
+				*budget--
+			}
+			child, err := t.ndb.GetNode(t, hash)
+			if err != nil {
+				return err
+			}
+			levels[child.height] = append(levels[child.height], child)
+		}
+	}
+	return nil
+}
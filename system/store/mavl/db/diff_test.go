@@ -0,0 +1,82 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffKVPair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+
+	var kvs []*types.KeyValue
+	for i := 0; i < 200; i++ {
+		kvs = append(kvs, &types.KeyValue{Key: []byte(fmt.Sprintf("key%03d", i)), Value: []byte(fmt.Sprintf("value%d", i))})
+	}
+	oldRoot, err := SetKVPair(db, &types.StoreSet{KV: kvs, Height: 1}, true, nil)
+	require.NoError(t, err)
+
+	tree := NewTree(db, true, nil)
+	tree.SetBlockHeight(2)
+	require.NoError(t, tree.Load(oldRoot))
+	tree.Set([]byte("key010"), []byte("changed"))
+	tree.Set([]byte("key150"), []byte("value150"))
+	tree.Set([]byte("key500"), []byte("added"))
+	_, removed := tree.Remove([]byte("key100"))
+	require.True(t, removed)
+	newRoot := tree.Save()
+
+	diffs, err := DiffKVPair(db, oldRoot, newRoot, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, &types.KeyValueDiff{Key: []byte("key010"), Ty: types.StateDiffModify, OldValue: []byte("value10"), NewValue: []byte("changed")}, diffs[0])
+	assert.Equal(t, &types.KeyValueDiff{Key: []byte("key100"), Ty: types.StateDiffDelete, OldValue: []byte("value100")}, diffs[1])
+	assert.Equal(t, &types.KeyValueDiff{Key: []byte("key500"), Ty: types.StateDiffAdd, NewValue: []byte("added")}, diffs[2])
+
+	//反向比较
+	diffs, err = DiffKVPair(db, newRoot, oldRoot, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, int32(types.StateDiffAdd), diffs[1].Ty)
+	assert.Equal(t, int32(types.StateDiffDelete), diffs[2].Ty)
+
+	//空树与状态树比较，所有的key都是新增
+	diffs, err = DiffKVPair(db, nil, oldRoot, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, len(kvs), len(diffs))
+	diffs, err = DiffKVPair(db, oldRoot, oldRoot, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diffs))
+
+	_, err = DiffKVPair(db, nil, oldRoot, nil, 10)
+	assert.Equal(t, types.ErrMaxCountPerTime, err)
+	diffs, err = DiffKVPair(db, oldRoot, newRoot, nil, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(diffs))
+	_, err = DiffKVPair(db, oldRoot, newRoot, nil, 2)
+	assert.Equal(t, types.ErrMaxCountPerTime, err)
+
+	//展开的节点超过预算时提前返回，不用加载整棵树
+	budget := 3
+	levels := make(map[int32][]*Node)
+	tree = NewTree(db, true, nil)
+	require.NoError(t, tree.Load(oldRoot))
+	assert.Equal(t, types.ErrMaxCountPerTime, expandNodes(tree, []*Node{tree.root, tree.root}, levels, &budget))
+	assert.Equal(t, 0, budget)
+	assert.Equal(t, 3, len(levels[tree.root.height-1]))
+	_, err = DiffKVPair(db, oldRoot, []byte("unknown"), nil, 0)
+	assert.Equal(t, ErrNodeNotExist, err)
+}
@@ -0,0 +1,146 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// 快照同步时mavl树按节点hash分片传输，接收方逐个校验节点内容和hash是否一致，
+// 父节点中保存了子节点的hash，所以从区块头中的StateHash开始逐层同步就可以保证整棵树的正确性
+
+// GetSnapshotNodes 获取指定hash的mavl树节点原始数据，本地不存在的节点忽略
+func GetSnapshotNodes(db dbm.DB, hashes [][]byte) *types.SnapshotNodes {
+	nodes := &types.SnapshotNodes{}
+	for _, hash := range hashes {
+		data, err := db.Get(hash)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		nodes.Nodes = append(nodes.Nodes, &types.SnapshotNode{Hash: hash, Data: data})
+	}
+	return nodes
+}
+
+// VerifySnapshotNode 校验节点数据与hash是否一致，返回内部节点的子节点hash
+// 使能EnableMavlPrefix时非根节点的hash带有前缀，只比较hash的后缀部分
+func VerifySnapshotNode(node *types.SnapshotNode) (children [][]byte, err error) {
+	var storeNode types.StoreNode
+	err = proto.Unmarshal(node.GetData(), &storeNode)
+	if err != nil {
+		return nil, types.ErrSnapshotNode
+	}
+	var hash []byte
+	if storeNode.Height == 0 {
+		leaf := &types.LeafNode{Height: storeNode.Height, Key: storeNode.Key, Size: storeNode.Size, Value: storeNode.Value}
+		hash = leaf.Hash()
+	} else {
+		if len(storeNode.LeftHash) == 0 || len(storeNode.RightHash) == 0 {
+			return nil, types.ErrSnapshotNode
+		}
+		inner := &types.InnerNode{Height: storeNode.Height, Size: storeNode.Size, LeftHash: storeNode.LeftHash, RightHash: storeNode.RightHash}
+		hash = inner.Hash()
+		children = [][]byte{storeNode.LeftHash, storeNode.RightHash}
+	}
+	if !bytes.HasSuffix(node.GetHash(), hash) {
+		return nil, types.ErrSnapshotNode
+	}
+	return children, nil
+}
+
+// SetSnapshotNodes 校验并保存快照节点，返回本地还不存在的子节点hash以及新写入的节点hash
+// 本地已经存在的节点可能被其他的树共用，不重复写入，同步失败时也不能删除
+func SetSnapshotNodes(db dbm.DB, nodes *types.SnapshotNodes, sync bool) (*types.ReplySnapshotNodes, error) {
+	var children [][]byte
+	for _, node := range nodes.GetNodes() {
+		hashes, err := VerifySnapshotNode(node)
+		if err != nil {
+			treelog.Error("SetSnapshotNodes", "hash", node.GetHash(), "err", err)
+			return nil, err
+		}
+		children = append(children, hashes...)
+	}
+	reply := &types.ReplySnapshotNodes{}
+	batch := db.NewBatch(sync)
+	for _, node := range nodes.GetNodes() {
+		if data, err := db.Get(node.GetHash()); err == nil && len(data) > 0 {
+			continue
+		}
+		batch.Set(node.GetHash(), node.GetData())
+		reply.Saved = append(reply.Saved, node.GetHash())
+	}
+	err := batch.Write()
+	if err != nil {
+		return nil, err
+	}
+	for _, hash := range children {
+		data, err := db.Get(hash)
+		if err != nil || len(data) == 0 {
+			reply.Missing = append(reply.Missing, hash)
+		}
+	}
+	return reply, nil
+}
+
+// DelSnapshotNodes 快照同步失败时删除已经保存的快照节点
+func DelSnapshotNodes(db dbm.DB, hashes [][]byte, sync bool) error {
+	batch := db.NewBatch(sync)
+	for _, hash := range hashes {
+		batch.Delete(hash)
+	}
+	return batch.Write()
+}
+
+// VerifySnapshotTree 快照同步完成之后校验整棵树的结构
+// 节点hash中不包含内部节点的key，需要检查key的顺序以及height和size，保证按key查询的路径正确
+func VerifySnapshotTree(db dbm.DB, roothash []byte) error {
+	if len(roothash) == 0 || bytes.Equal(roothash, emptyRoot[:]) {
+		return nil
+	}
+	tree := NewTree(db, true, nil)
+	err := tree.Load(roothash)
+	if err != nil {
+		return err
+	}
+	_, _, err = verifySnapshotNode(tree, tree.root)
+	return err
+}
+
+// 返回子树中最小和最大的key
+func verifySnapshotNode(t *Tree, node *Node) (minKey, maxKey []byte, err error) {
+	if node.height == 0 {
+		if node.size != 1 {
+			return nil, nil, types.ErrSnapshotNode
+		}
+		return node.key, node.key, nil
+	}
+	left, err := t.ndb.GetNode(t, node.leftHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := t.ndb.GetNode(t, node.rightHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if node.height != maxInt32(left.height, right.height)+1 || node.size != left.size+right.size {
+		return nil, nil, types.ErrSnapshotNode
+	}
+	lmin, lmax, err := verifySnapshotNode(t, left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rmin, rmax, err := verifySnapshotNode(t, right)
+	if err != nil {
+		return nil, nil, err
+	}
+	if bytes.Compare(lmax, node.key) >= 0 || bytes.Compare(rmin, node.key) < 0 {
+		return nil, nil, types.ErrSnapshotNode
+	}
+	return lmin, rmax, nil
+}
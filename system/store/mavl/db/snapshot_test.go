@@ -0,0 +1,93 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotSync(t *testing.T) {
+	for _, prefix := range []bool{false, true} {
+		testSnapshotSync(t, &TreeConfig{EnableMavlPrefix: prefix})
+	}
+}
+
+func testSnapshotSync(t *testing.T, treeCfg *TreeConfig) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	srcdb := db.NewDB("src", "leveldb", dir, 100)
+	dstdb := db.NewDB("dst", "leveldb", dir, 100)
+	defer srcdb.Close()
+	defer dstdb.Close()
+
+	//构造多个高度的状态树
+	var hash []byte
+	for height := int64(0); height < 5; height++ {
+		var kvs []*types.KeyValue
+		for i := 0; i < 50; i++ {
+			kvs = append(kvs, &types.KeyValue{Key: []byte(fmt.Sprintf("key%d", i*7%50)), Value: []byte(fmt.Sprintf("value%d-%d", i, height))})
+		}
+		hash, err = SetKVPair(srcdb, &types.StoreSet{StateHash: hash, KV: kvs, Height: height}, true, treeCfg)
+		require.NoError(t, err)
+	}
+
+	//从roothash开始逐层同步节点
+	pending := [][]byte{hash}
+	count := 0
+	for len(pending) > 0 {
+		nodes := GetSnapshotNodes(srcdb, pending)
+		require.Equal(t, len(pending), len(nodes.Nodes))
+		reply, err := SetSnapshotNodes(dstdb, nodes, true)
+		require.NoError(t, err)
+		assert.Equal(t, len(nodes.Nodes), len(reply.Saved))
+		count += len(nodes.Nodes)
+		pending = reply.Missing
+	}
+	assert.Equal(t, 99, count)
+	require.NoError(t, VerifySnapshotTree(dstdb, hash))
+
+	values, err := GetKVPair(dstdb, &types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("key0"), []byte("key49")}}, treeCfg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value0-4"), values[0])
+	assert.Equal(t, []byte("value7-4"), values[1])
+
+	//本地已经存在的节点不重复写入
+	reply, err := SetSnapshotNodes(dstdb, GetSnapshotNodes(srcdb, [][]byte{hash}), true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(reply.Saved))
+
+	//节点数据被篡改
+	node := GetSnapshotNodes(srcdb, [][]byte{hash}).Nodes[0]
+	var storeNode types.StoreNode
+	require.NoError(t, types.Decode(node.Data, &storeNode))
+	storeNode.Size++
+	bad := &types.SnapshotNode{Hash: hash, Data: types.Encode(&storeNode)}
+	_, err = SetSnapshotNodes(dstdb, &types.SnapshotNodes{Nodes: []*types.SnapshotNode{bad}}, true)
+	assert.Equal(t, types.ErrSnapshotNode, err)
+	_, err = VerifySnapshotNode(&types.SnapshotNode{Hash: hash, Data: []byte("bad")})
+	assert.Equal(t, types.ErrSnapshotNode, err)
+
+	//内部节点的key不在hash中，需要校验树的结构
+	storeNode.Size--
+	storeNode.Key = []byte("zzz")
+	keyChanged := &types.SnapshotNode{Hash: hash, Data: types.Encode(&storeNode)}
+	//同步失败时删除已经保存的节点
+	require.NoError(t, DelSnapshotNodes(dstdb, [][]byte{hash}, true))
+	assert.Equal(t, 0, len(GetSnapshotNodes(dstdb, [][]byte{hash}).Nodes))
+	reply, err = SetSnapshotNodes(dstdb, &types.SnapshotNodes{Nodes: []*types.SnapshotNode{keyChanged}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(reply.Saved))
+	assert.Equal(t, types.ErrSnapshotNode, VerifySnapshotTree(dstdb, hash))
+}
@@ -19,6 +19,9 @@ import (
 
 var mlog = log.New("module", "mavl")
 
+//一次状态差异查询最多返回的key数目
+const maxStateDiffCount = 100000
+
 // SetLogLevel set log level
 func SetLogLevel(level string) {
 	clog.SetLogLevel(level)
@@ -219,12 +222,87 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, mavls.treeCfg, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理快照同步以及获取状态证明相关的消息，其他消息不支持
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
-	msg.ReplyErr("Store", types.ErrActionNotSupport)
+	client := mavls.GetQueueClient()
+	switch msg.Ty {
+	case types.EventStoreGetSnapshotNodes:
+		req := msg.GetData().(*types.ReqHashes)
+		msg.Reply(client.NewMessage("", types.EventStoreGetSnapshotNodes, mavl.GetSnapshotNodes(mavls.GetDB(), req.Hashes)))
+	case types.EventStoreSetSnapshotNodes:
+		req := msg.GetData().(*types.SnapshotNodes)
+		reply, err := mavl.SetSnapshotNodes(mavls.GetDB(), req, true)
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreSetSnapshotNodes, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreSetSnapshotNodes, reply))
+	case types.EventStoreDelSnapshotNodes:
+		req := msg.GetData().(*types.ReqHashes)
+		err := mavl.DelSnapshotNodes(mavls.GetDB(), req.Hashes, true)
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreDelSnapshotNodes, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreDelSnapshotNodes, &types.Reply{IsOk: true}))
+	case types.EventStoreVerifySnapshot:
+		req := msg.GetData().(*types.ReqHash)
+		err := mavl.VerifySnapshotTree(mavls.GetDB(), req.Hash)
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreVerifySnapshot, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreVerifySnapshot, &types.Reply{IsOk: true}))
+	case types.EventStoreGetProof:
+		req := msg.GetData().(*types.StoreGet)
+		proof, err := mavls.getProof(req)
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreGetProof, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreGetProof, proof))
+	case types.EventStoreDiff:
+		req := msg.GetData().(*types.ReqStateDiff)
+		diff, err := mavls.getStateDiff(req)
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreDiff, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreDiff, diff))
+	default:
+		msg.ReplyErr("Store", types.ErrActionNotSupport)
+	}
+}
+
+//getProof 获取指定状态树中单个key的value以及证明
+func (mavls *Store) getProof(req *types.StoreGet) (*types.StateProof, error) {
+	if len(req.Keys) != 1 {
+		return nil, types.ErrInvalidParam
+	}
+	tree := mavl.NewTree(mavls.GetDB(), true, mavls.treeCfg)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	value, proof, exists := tree.Proof(req.Keys[0])
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+	return &types.StateProof{StateHash: req.StateHash, Key: req.Keys[0], Value: value, Proof: proof}, nil
+}
+
+func (mavls *Store) getStateDiff(req *types.ReqStateDiff) (*types.StateDiff, error) {
+	if len(req.NewStateHash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	items, err := mavl.DiffKVPair(mavls.GetDB(), req.OldStateHash, req.NewStateHash, mavls.treeCfg, maxStateDiffCount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.StateDiff{OldStateHash: req.OldStateHash, NewStateHash: req.NewStateHash, Items: items}, nil
 }
 
 // Del ...
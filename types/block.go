@@ -255,6 +255,26 @@ func (header *Header) Size() int {
 	return Size(header)
 }
 
+// CalcHash 通过header中的字段重新计算区块hash，和Block.Hash的计算方式一致
+func (header *Header) CalcHash(cfg *Chain33Config) []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	data, err := proto.Marshal(head)
+	if err != nil {
+		panic(err)
+	}
+	return common.Sha256(data)
+}
+
 func (paraTxDetail *ParaTxDetail) Size() int {
 	return Size(paraTxDetail)
 }
@@ -52,7 +52,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{0}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{0}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Header.Unmarshal(m, b)
@@ -165,7 +165,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{1}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{1}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -273,7 +273,7 @@ func (m *Blocks) Reset()         { *m = Blocks{} }
 func (m *Blocks) String() string { return proto.CompactTextString(m) }
 func (*Blocks) ProtoMessage()    {}
 func (*Blocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{2}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{2}
 }
 func (m *Blocks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Blocks.Unmarshal(m, b)
@@ -301,13 +301,18 @@ func (m *Blocks) GetItems() []*Block {
 }
 
 type BlockSeqCB struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
-	Encode               string   `protobuf:"bytes,3,opt,name=encode,proto3" json:"encode,omitempty"`
-	IsHeader             bool     `protobuf:"varint,4,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
-	LastSequence         int64    `protobuf:"varint,5,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"`
-	LastHeight           int64    `protobuf:"varint,6,opt,name=lastHeight,proto3" json:"lastHeight,omitempty"`
-	LastBlockHash        string   `protobuf:"bytes,7,opt,name=lastBlockHash,proto3" json:"lastBlockHash,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL           string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Encode        string `protobuf:"bytes,3,opt,name=encode,proto3" json:"encode,omitempty"`
+	IsHeader      bool   `protobuf:"varint,4,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
+	LastSequence  int64  `protobuf:"varint,5,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"`
+	LastHeight    int64  `protobuf:"varint,6,opt,name=lastHeight,proto3" json:"lastHeight,omitempty"`
+	LastBlockHash string `protobuf:"bytes,7,opt,name=lastBlockHash,proto3" json:"lastBlockHash,omitempty"`
+	// 过滤条件，设置之后只推送符合条件的交易以及回执和merkle证明
+	// 不同的过滤条件之间是与的关系，同一个过滤条件的多个值之间是或的关系
+	Execers              []string `protobuf:"bytes,8,rep,name=execers,proto3" json:"execers,omitempty"`
+	Addrs                []string `protobuf:"bytes,9,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	LogTypes             []int32  `protobuf:"varint,10,rep,packed,name=logTypes,proto3" json:"logTypes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -317,7 +322,7 @@ func (m *BlockSeqCB) Reset()         { *m = BlockSeqCB{} }
 func (m *BlockSeqCB) String() string { return proto.CompactTextString(m) }
 func (*BlockSeqCB) ProtoMessage()    {}
 func (*BlockSeqCB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{3}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{3}
 }
 func (m *BlockSeqCB) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSeqCB.Unmarshal(m, b)
@@ -386,6 +391,27 @@ func (m *BlockSeqCB) GetLastBlockHash() string {
 	return ""
 }
 
+func (m *BlockSeqCB) GetExecers() []string {
+	if m != nil {
+		return m.Execers
+	}
+	return nil
+}
+
+func (m *BlockSeqCB) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *BlockSeqCB) GetLogTypes() []int32 {
+	if m != nil {
+		return m.LogTypes
+	}
+	return nil
+}
+
 type BlockSeqCBs struct {
 	Items                []*BlockSeqCB `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -397,7 +423,7 @@ func (m *BlockSeqCBs) Reset()         { *m = BlockSeqCBs{} }
 func (m *BlockSeqCBs) String() string { return proto.CompactTextString(m) }
 func (*BlockSeqCBs) ProtoMessage()    {}
 func (*BlockSeqCBs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{4}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{4}
 }
 func (m *BlockSeqCBs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSeqCBs.Unmarshal(m, b)
@@ -424,20 +450,124 @@ func (m *BlockSeqCBs) GetItems() []*BlockSeqCB {
 	return nil
 }
 
+// 通过grpc stream推送的数据，data为按照callback的encode编码之后的BlockSeqs或者HeaderSeqs
+type PushSeqData struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushSeqData) Reset()         { *m = PushSeqData{} }
+func (m *PushSeqData) String() string { return proto.CompactTextString(m) }
+func (*PushSeqData) ProtoMessage()    {}
+func (*PushSeqData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{5}
+}
+func (m *PushSeqData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushSeqData.Unmarshal(m, b)
+}
+func (m *PushSeqData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushSeqData.Marshal(b, m, deterministic)
+}
+func (dst *PushSeqData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushSeqData.Merge(dst, src)
+}
+func (m *PushSeqData) XXX_Size() int {
+	return xxx_messageInfo_PushSeqData.Size(m)
+}
+func (m *PushSeqData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushSeqData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushSeqData proto.InternalMessageInfo
+
+func (m *PushSeqData) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PushSeqData) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PushSeqData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// 订阅方处理完推送的数据之后确认最新的seq
+type PushSeqAck struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushSeqAck) Reset()         { *m = PushSeqAck{} }
+func (m *PushSeqAck) String() string { return proto.CompactTextString(m) }
+func (*PushSeqAck) ProtoMessage()    {}
+func (*PushSeqAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{6}
+}
+func (m *PushSeqAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushSeqAck.Unmarshal(m, b)
+}
+func (m *PushSeqAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushSeqAck.Marshal(b, m, deterministic)
+}
+func (dst *PushSeqAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushSeqAck.Merge(dst, src)
+}
+func (m *PushSeqAck) XXX_Size() int {
+	return xxx_messageInfo_PushSeqAck.Size(m)
+}
+func (m *PushSeqAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushSeqAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushSeqAck proto.InternalMessageInfo
+
+func (m *PushSeqAck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PushSeqAck) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type BlockSeq struct {
-	Num                  int64          `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
-	Seq                  *BlockSequence `protobuf:"bytes,2,opt,name=seq,proto3" json:"seq,omitempty"`
-	Detail               *BlockDetail   `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Num    int64          `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Seq    *BlockSequence `protobuf:"bytes,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Detail *BlockDetail   `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// 区块回滚开始的seq附带本次链重组的信息
+	Reorg                *ReorgEvent `protobuf:"bytes,4,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *BlockSeq) Reset()         { *m = BlockSeq{} }
 func (m *BlockSeq) String() string { return proto.CompactTextString(m) }
 func (*BlockSeq) ProtoMessage()    {}
 func (*BlockSeq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{5}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{7}
 }
 func (m *BlockSeq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSeq.Unmarshal(m, b)
@@ -478,6 +608,13 @@ func (m *BlockSeq) GetDetail() *BlockDetail {
 	return nil
 }
 
+func (m *BlockSeq) GetReorg() *ReorgEvent {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
 type BlockSeqs struct {
 	Seqs                 []*BlockSeq `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -489,7 +626,7 @@ func (m *BlockSeqs) Reset()         { *m = BlockSeqs{} }
 func (m *BlockSeqs) String() string { return proto.CompactTextString(m) }
 func (*BlockSeqs) ProtoMessage()    {}
 func (*BlockSeqs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{6}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{8}
 }
 func (m *BlockSeqs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSeqs.Unmarshal(m, b)
@@ -529,7 +666,7 @@ func (m *BlockPid) Reset()         { *m = BlockPid{} }
 func (m *BlockPid) String() string { return proto.CompactTextString(m) }
 func (*BlockPid) ProtoMessage()    {}
 func (*BlockPid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{7}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{9}
 }
 func (m *BlockPid) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockPid.Unmarshal(m, b)
@@ -575,7 +712,7 @@ func (m *BlockDetails) Reset()         { *m = BlockDetails{} }
 func (m *BlockDetails) String() string { return proto.CompactTextString(m) }
 func (*BlockDetails) ProtoMessage()    {}
 func (*BlockDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{8}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{10}
 }
 func (m *BlockDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockDetails.Unmarshal(m, b)
@@ -614,7 +751,7 @@ func (m *Headers) Reset()         { *m = Headers{} }
 func (m *Headers) String() string { return proto.CompactTextString(m) }
 func (*Headers) ProtoMessage()    {}
 func (*Headers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{9}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{11}
 }
 func (m *Headers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Headers.Unmarshal(m, b)
@@ -653,7 +790,7 @@ func (m *HeadersPid) Reset()         { *m = HeadersPid{} }
 func (m *HeadersPid) String() string { return proto.CompactTextString(m) }
 func (*HeadersPid) ProtoMessage()    {}
 func (*HeadersPid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{10}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{12}
 }
 func (m *HeadersPid) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeadersPid.Unmarshal(m, b)
@@ -704,7 +841,7 @@ func (m *BlockOverview) Reset()         { *m = BlockOverview{} }
 func (m *BlockOverview) String() string { return proto.CompactTextString(m) }
 func (*BlockOverview) ProtoMessage()    {}
 func (*BlockOverview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{11}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{13}
 }
 func (m *BlockOverview) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockOverview.Unmarshal(m, b)
@@ -762,7 +899,7 @@ func (m *BlockDetail) Reset()         { *m = BlockDetail{} }
 func (m *BlockDetail) String() string { return proto.CompactTextString(m) }
 func (*BlockDetail) ProtoMessage()    {}
 func (*BlockDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{12}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{14}
 }
 func (m *BlockDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockDetail.Unmarshal(m, b)
@@ -821,7 +958,7 @@ func (m *Receipts) Reset()         { *m = Receipts{} }
 func (m *Receipts) String() string { return proto.CompactTextString(m) }
 func (*Receipts) ProtoMessage()    {}
 func (*Receipts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{13}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{15}
 }
 func (m *Receipts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Receipts.Unmarshal(m, b)
@@ -859,7 +996,7 @@ func (m *ReceiptCheckTxList) Reset()         { *m = ReceiptCheckTxList{} }
 func (m *ReceiptCheckTxList) String() string { return proto.CompactTextString(m) }
 func (*ReceiptCheckTxList) ProtoMessage()    {}
 func (*ReceiptCheckTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{14}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{16}
 }
 func (m *ReceiptCheckTxList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptCheckTxList.Unmarshal(m, b)
@@ -903,7 +1040,7 @@ func (m *ChainStatus) Reset()         { *m = ChainStatus{} }
 func (m *ChainStatus) String() string { return proto.CompactTextString(m) }
 func (*ChainStatus) ProtoMessage()    {}
 func (*ChainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{15}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{17}
 }
 func (m *ChainStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainStatus.Unmarshal(m, b)
@@ -963,7 +1100,7 @@ func (m *ReqBlocks) Reset()         { *m = ReqBlocks{} }
 func (m *ReqBlocks) String() string { return proto.CompactTextString(m) }
 func (*ReqBlocks) ProtoMessage()    {}
 func (*ReqBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{16}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{18}
 }
 func (m *ReqBlocks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqBlocks.Unmarshal(m, b)
@@ -1022,7 +1159,7 @@ func (m *MempoolSize) Reset()         { *m = MempoolSize{} }
 func (m *MempoolSize) String() string { return proto.CompactTextString(m) }
 func (*MempoolSize) ProtoMessage()    {}
 func (*MempoolSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{17}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{19}
 }
 func (m *MempoolSize) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MempoolSize.Unmarshal(m, b)
@@ -1060,7 +1197,7 @@ func (m *ReplyBlockHeight) Reset()         { *m = ReplyBlockHeight{} }
 func (m *ReplyBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ReplyBlockHeight) ProtoMessage()    {}
 func (*ReplyBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{18}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{20}
 }
 func (m *ReplyBlockHeight) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyBlockHeight.Unmarshal(m, b)
@@ -1110,7 +1247,7 @@ func (m *BlockBody) Reset()         { *m = BlockBody{} }
 func (m *BlockBody) String() string { return proto.CompactTextString(m) }
 func (*BlockBody) ProtoMessage()    {}
 func (*BlockBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{19}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{21}
 }
 func (m *BlockBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBody.Unmarshal(m, b)
@@ -1189,7 +1326,7 @@ func (m *BlockReceipt) Reset()         { *m = BlockReceipt{} }
 func (m *BlockReceipt) String() string { return proto.CompactTextString(m) }
 func (*BlockReceipt) ProtoMessage()    {}
 func (*BlockReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{20}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{22}
 }
 func (m *BlockReceipt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockReceipt.Unmarshal(m, b)
@@ -1242,7 +1379,7 @@ func (m *IsCaughtUp) Reset()         { *m = IsCaughtUp{} }
 func (m *IsCaughtUp) String() string { return proto.CompactTextString(m) }
 func (*IsCaughtUp) ProtoMessage()    {}
 func (*IsCaughtUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{21}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{23}
 }
 func (m *IsCaughtUp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsCaughtUp.Unmarshal(m, b)
@@ -1281,7 +1418,7 @@ func (m *IsNtpClockSync) Reset()         { *m = IsNtpClockSync{} }
 func (m *IsNtpClockSync) String() string { return proto.CompactTextString(m) }
 func (*IsNtpClockSync) ProtoMessage()    {}
 func (*IsNtpClockSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{22}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{24}
 }
 func (m *IsNtpClockSync) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsNtpClockSync.Unmarshal(m, b)
@@ -1314,7 +1451,9 @@ type ChainExecutor struct {
 	StateHash []byte `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
 	Param     []byte `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
 	// 扩展字段，用于额外的用途
-	Extra                []byte   `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	Extra []byte `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	// 查询的区块高度, 大于0并且stateHash为空时在该高度的状态上查询
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1324,7 +1463,7 @@ func (m *ChainExecutor) Reset()         { *m = ChainExecutor{} }
 func (m *ChainExecutor) String() string { return proto.CompactTextString(m) }
 func (*ChainExecutor) ProtoMessage()    {}
 func (*ChainExecutor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{23}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{25}
 }
 func (m *ChainExecutor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainExecutor.Unmarshal(m, b)
@@ -1379,6 +1518,13 @@ func (m *ChainExecutor) GetExtra() []byte {
 	return nil
 }
 
+func (m *ChainExecutor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 //  通过block hash记录block的操作类型及add/del：1/2
 type BlockSequence struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
@@ -1392,7 +1538,7 @@ func (m *BlockSequence) Reset()         { *m = BlockSequence{} }
 func (m *BlockSequence) String() string { return proto.CompactTextString(m) }
 func (*BlockSequence) ProtoMessage()    {}
 func (*BlockSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{24}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{26}
 }
 func (m *BlockSequence) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSequence.Unmarshal(m, b)
@@ -1438,7 +1584,7 @@ func (m *BlockSequences) Reset()         { *m = BlockSequences{} }
 func (m *BlockSequences) String() string { return proto.CompactTextString(m) }
 func (*BlockSequences) ProtoMessage()    {}
 func (*BlockSequences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{25}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{27}
 }
 func (m *BlockSequences) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSequences.Unmarshal(m, b)
@@ -1480,7 +1626,7 @@ func (m *Sequence) Reset()         { *m = Sequence{} }
 func (m *Sequence) String() string { return proto.CompactTextString(m) }
 func (*Sequence) ProtoMessage()    {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{26}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{28}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sequence.Unmarshal(m, b)
@@ -1542,7 +1688,7 @@ func (m *ReplyAddSeqCallback) Reset()         { *m = ReplyAddSeqCallback{} }
 func (m *ReplyAddSeqCallback) String() string { return proto.CompactTextString(m) }
 func (*ReplyAddSeqCallback) ProtoMessage()    {}
 func (*ReplyAddSeqCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{27}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{29}
 }
 func (m *ReplyAddSeqCallback) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyAddSeqCallback.Unmarshal(m, b)
@@ -1600,7 +1746,7 @@ func (m *ParaChainBlockDetail) Reset()         { *m = ParaChainBlockDetail{} }
 func (m *ParaChainBlockDetail) String() string { return proto.CompactTextString(m) }
 func (*ParaChainBlockDetail) ProtoMessage()    {}
 func (*ParaChainBlockDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{28}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{30}
 }
 func (m *ParaChainBlockDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaChainBlockDetail.Unmarshal(m, b)
@@ -1653,7 +1799,7 @@ func (m *ParaTxDetails) Reset()         { *m = ParaTxDetails{} }
 func (m *ParaTxDetails) String() string { return proto.CompactTextString(m) }
 func (*ParaTxDetails) ProtoMessage()    {}
 func (*ParaTxDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{29}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{31}
 }
 func (m *ParaTxDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaTxDetails.Unmarshal(m, b)
@@ -1702,7 +1848,7 @@ func (m *ParaTxDetail) Reset()         { *m = ParaTxDetail{} }
 func (m *ParaTxDetail) String() string { return proto.CompactTextString(m) }
 func (*ParaTxDetail) ProtoMessage()    {}
 func (*ParaTxDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{30}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{32}
 }
 func (m *ParaTxDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaTxDetail.Unmarshal(m, b)
@@ -1783,7 +1929,7 @@ func (m *TxDetail) Reset()         { *m = TxDetail{} }
 func (m *TxDetail) String() string { return proto.CompactTextString(m) }
 func (*TxDetail) ProtoMessage()    {}
 func (*TxDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{31}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{33}
 }
 func (m *TxDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxDetail.Unmarshal(m, b)
@@ -1846,7 +1992,7 @@ func (m *ReqParaTxByTitle) Reset()         { *m = ReqParaTxByTitle{} }
 func (m *ReqParaTxByTitle) String() string { return proto.CompactTextString(m) }
 func (*ReqParaTxByTitle) ProtoMessage()    {}
 func (*ReqParaTxByTitle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{32}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{34}
 }
 func (m *ReqParaTxByTitle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqParaTxByTitle.Unmarshal(m, b)
@@ -1909,7 +2055,7 @@ func (m *FileHeader) Reset()         { *m = FileHeader{} }
 func (m *FileHeader) String() string { return proto.CompactTextString(m) }
 func (*FileHeader) ProtoMessage()    {}
 func (*FileHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{33}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{35}
 }
 func (m *FileHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileHeader.Unmarshal(m, b)
@@ -1970,7 +2116,7 @@ func (m *EndBlock) Reset()         { *m = EndBlock{} }
 func (m *EndBlock) String() string { return proto.CompactTextString(m) }
 func (*EndBlock) ProtoMessage()    {}
 func (*EndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{34}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{36}
 }
 func (m *EndBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndBlock.Unmarshal(m, b)
@@ -2004,11 +2150,170 @@ func (m *EndBlock) GetHash() []byte {
 	return nil
 }
 
+// 重建索引的进度，elapsed单位为秒
+type ReindexProgress struct {
+	Running              bool            `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Version              string          `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Total                int64           `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Done                 int64           `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Workers              int32           `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
+	Elapsed              int64           `protobuf:"varint,6,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	Shards               []*ReindexShard `protobuf:"bytes,7,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReindexProgress) Reset()         { *m = ReindexProgress{} }
+func (m *ReindexProgress) String() string { return proto.CompactTextString(m) }
+func (*ReindexProgress) ProtoMessage()    {}
+func (*ReindexProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{37}
+}
+func (m *ReindexProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReindexProgress.Unmarshal(m, b)
+}
+func (m *ReindexProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReindexProgress.Marshal(b, m, deterministic)
+}
+func (dst *ReindexProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReindexProgress.Merge(dst, src)
+}
+func (m *ReindexProgress) XXX_Size() int {
+	return xxx_messageInfo_ReindexProgress.Size(m)
+}
+func (m *ReindexProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReindexProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReindexProgress proto.InternalMessageInfo
+
+func (m *ReindexProgress) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *ReindexProgress) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ReindexProgress) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ReindexProgress) GetDone() int64 {
+	if m != nil {
+		return m.Done
+	}
+	return 0
+}
+
+func (m *ReindexProgress) GetWorkers() int32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *ReindexProgress) GetElapsed() int64 {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+func (m *ReindexProgress) GetShards() []*ReindexShard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// 流式归档文件的文件头，compress为区块数据块的压缩方式
+type ArchiveHeader struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TestNet              bool     `protobuf:"varint,3,opt,name=testNet,proto3" json:"testNet,omitempty"`
+	Compress             string   `protobuf:"bytes,4,opt,name=compress,proto3" json:"compress,omitempty"`
+	StartHeight          int64    `protobuf:"varint,5,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveHeader) Reset()         { *m = ArchiveHeader{} }
+func (m *ArchiveHeader) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeader) ProtoMessage()    {}
+func (*ArchiveHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{38}
+}
+func (m *ArchiveHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveHeader.Unmarshal(m, b)
+}
+func (m *ArchiveHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveHeader.Marshal(b, m, deterministic)
+}
+func (dst *ArchiveHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeader.Merge(dst, src)
+}
+func (m *ArchiveHeader) XXX_Size() int {
+	return xxx_messageInfo_ArchiveHeader.Size(m)
+}
+func (m *ArchiveHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeader proto.InternalMessageInfo
+
+func (m *ArchiveHeader) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetTestNet() bool {
+	if m != nil {
+		return m.TestNet
+	}
+	return false
+}
+
+func (m *ArchiveHeader) GetCompress() string {
+	if m != nil {
+		return m.Compress
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 // 通过seq获取区块的header信息
 type HeaderSeq struct {
 	Num                  int64          `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
 	Seq                  *BlockSequence `protobuf:"bytes,2,opt,name=seq,proto3" json:"seq,omitempty"`
 	Header               *Header        `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Reorg                *ReorgEvent    `protobuf:"bytes,4,opt,name=reorg,proto3" json:"reorg,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2018,7 +2323,7 @@ func (m *HeaderSeq) Reset()         { *m = HeaderSeq{} }
 func (m *HeaderSeq) String() string { return proto.CompactTextString(m) }
 func (*HeaderSeq) ProtoMessage()    {}
 func (*HeaderSeq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{35}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{39}
 }
 func (m *HeaderSeq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderSeq.Unmarshal(m, b)
@@ -2059,6 +2364,13 @@ func (m *HeaderSeq) GetHeader() *Header {
 	return nil
 }
 
+func (m *HeaderSeq) GetReorg() *ReorgEvent {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
 // 批量推送区块的header信息
 type HeaderSeqs struct {
 	Seqs                 []*HeaderSeq `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty"`
@@ -2071,7 +2383,7 @@ func (m *HeaderSeqs) Reset()         { *m = HeaderSeqs{} }
 func (m *HeaderSeqs) String() string { return proto.CompactTextString(m) }
 func (*HeaderSeqs) ProtoMessage()    {}
 func (*HeaderSeqs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{36}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{40}
 }
 func (m *HeaderSeqs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderSeqs.Unmarshal(m, b)
@@ -2098,6 +2410,115 @@ func (m *HeaderSeqs) GetSeqs() []*HeaderSeq {
 	return nil
 }
 
+// 按照过滤条件推送区块中符合条件的交易，没有符合条件的交易时txs为空，保证seq连续
+type FilterSeq struct {
+	Num                  int64                `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Seq                  *BlockSequence       `protobuf:"bytes,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Header               *Header              `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Txs                  []*TransactionDetail `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	Reorg                *ReorgEvent          `protobuf:"bytes,5,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FilterSeq) Reset()         { *m = FilterSeq{} }
+func (m *FilterSeq) String() string { return proto.CompactTextString(m) }
+func (*FilterSeq) ProtoMessage()    {}
+func (*FilterSeq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{41}
+}
+func (m *FilterSeq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterSeq.Unmarshal(m, b)
+}
+func (m *FilterSeq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterSeq.Marshal(b, m, deterministic)
+}
+func (dst *FilterSeq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterSeq.Merge(dst, src)
+}
+func (m *FilterSeq) XXX_Size() int {
+	return xxx_messageInfo_FilterSeq.Size(m)
+}
+func (m *FilterSeq) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterSeq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterSeq proto.InternalMessageInfo
+
+func (m *FilterSeq) GetNum() int64 {
+	if m != nil {
+		return m.Num
+	}
+	return 0
+}
+
+func (m *FilterSeq) GetSeq() *BlockSequence {
+	if m != nil {
+		return m.Seq
+	}
+	return nil
+}
+
+func (m *FilterSeq) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *FilterSeq) GetTxs() []*TransactionDetail {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *FilterSeq) GetReorg() *ReorgEvent {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
+type FilterSeqs struct {
+	Seqs                 []*FilterSeq `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FilterSeqs) Reset()         { *m = FilterSeqs{} }
+func (m *FilterSeqs) String() string { return proto.CompactTextString(m) }
+func (*FilterSeqs) ProtoMessage()    {}
+func (*FilterSeqs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{42}
+}
+func (m *FilterSeqs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterSeqs.Unmarshal(m, b)
+}
+func (m *FilterSeqs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterSeqs.Marshal(b, m, deterministic)
+}
+func (dst *FilterSeqs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterSeqs.Merge(dst, src)
+}
+func (m *FilterSeqs) XXX_Size() int {
+	return xxx_messageInfo_FilterSeqs.Size(m)
+}
+func (m *FilterSeqs) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterSeqs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterSeqs proto.InternalMessageInfo
+
+func (m *FilterSeqs) GetSeqs() []*FilterSeq {
+	if m != nil {
+		return m.Seqs
+	}
+	return nil
+}
+
 // 记录本平行链所在区块的信息以及子根hash值
 // childHash:平行链子roothash值
 // startIndex:此平行链的第一笔交易的index索引值
@@ -2120,7 +2541,7 @@ func (m *HeightPara) Reset()         { *m = HeightPara{} }
 func (m *HeightPara) String() string { return proto.CompactTextString(m) }
 func (*HeightPara) ProtoMessage()    {}
 func (*HeightPara) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{37}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{43}
 }
 func (m *HeightPara) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeightPara.Unmarshal(m, b)
@@ -2200,7 +2621,7 @@ func (m *HeightParas) Reset()         { *m = HeightParas{} }
 func (m *HeightParas) String() string { return proto.CompactTextString(m) }
 func (*HeightParas) ProtoMessage()    {}
 func (*HeightParas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{38}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{44}
 }
 func (m *HeightParas) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeightParas.Unmarshal(m, b)
@@ -2246,7 +2667,7 @@ func (m *ChildChain) Reset()         { *m = ChildChain{} }
 func (m *ChildChain) String() string { return proto.CompactTextString(m) }
 func (*ChildChain) ProtoMessage()    {}
 func (*ChildChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{39}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{45}
 }
 func (m *ChildChain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChildChain.Unmarshal(m, b)
@@ -2309,7 +2730,7 @@ func (m *ReqHeightByTitle) Reset()         { *m = ReqHeightByTitle{} }
 func (m *ReqHeightByTitle) String() string { return proto.CompactTextString(m) }
 func (*ReqHeightByTitle) ProtoMessage()    {}
 func (*ReqHeightByTitle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{40}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{46}
 }
 func (m *ReqHeightByTitle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqHeightByTitle.Unmarshal(m, b)
@@ -2369,7 +2790,7 @@ func (m *ReplyHeightByTitle) Reset()         { *m = ReplyHeightByTitle{} }
 func (m *ReplyHeightByTitle) String() string { return proto.CompactTextString(m) }
 func (*ReplyHeightByTitle) ProtoMessage()    {}
 func (*ReplyHeightByTitle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{41}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{47}
 }
 func (m *ReplyHeightByTitle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyHeightByTitle.Unmarshal(m, b)
@@ -2416,7 +2837,7 @@ func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
 func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockInfo) ProtoMessage()    {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{42}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{48}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
@@ -2463,7 +2884,7 @@ func (m *ReqParaTxByHeight) Reset()         { *m = ReqParaTxByHeight{} }
 func (m *ReqParaTxByHeight) String() string { return proto.CompactTextString(m) }
 func (*ReqParaTxByHeight) ProtoMessage()    {}
 func (*ReqParaTxByHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{43}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{49}
 }
 func (m *ReqParaTxByHeight) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqParaTxByHeight.Unmarshal(m, b)
@@ -2510,7 +2931,7 @@ func (m *CmpBlock) Reset()         { *m = CmpBlock{} }
 func (m *CmpBlock) String() string { return proto.CompactTextString(m) }
 func (*CmpBlock) ProtoMessage()    {}
 func (*CmpBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_e45f7cea807c6c75, []int{44}
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{50}
 }
 func (m *CmpBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CmpBlock.Unmarshal(m, b)
@@ -2544,15 +2965,913 @@ func (m *CmpBlock) GetCmpHash() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Header)(nil), "types.Header")
-	proto.RegisterType((*Block)(nil), "types.Block")
-	proto.RegisterType((*Blocks)(nil), "types.Blocks")
-	proto.RegisterType((*BlockSeqCB)(nil), "types.BlockSeqCB")
-	proto.RegisterType((*BlockSeqCBs)(nil), "types.BlockSeqCBs")
-	proto.RegisterType((*BlockSeq)(nil), "types.BlockSeq")
-	proto.RegisterType((*BlockSeqs)(nil), "types.BlockSeqs")
-	proto.RegisterType((*BlockPid)(nil), "types.BlockPid")
+// 快照同步请求，区块头、检查点区块以及mavl状态树节点均按分片获取
+// height:快照检查点高度
+// startHeight,endHeight:请求的区块头区间，endHeight为0时不请求区块头
+// withBlock:是否返回检查点区块
+// hashes:请求的mavl树节点hash
+// pid:请求的对端节点
+type ReqSnapshotChunk struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StartHeight          int64    `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64    `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	WithBlock            bool     `protobuf:"varint,4,opt,name=withBlock,proto3" json:"withBlock,omitempty"`
+	Hashes               [][]byte `protobuf:"bytes,5,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Pid                  string   `protobuf:"bytes,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSnapshotChunk) Reset()         { *m = ReqSnapshotChunk{} }
+func (m *ReqSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*ReqSnapshotChunk) ProtoMessage()    {}
+func (*ReqSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{51}
+}
+func (m *ReqSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSnapshotChunk.Unmarshal(m, b)
+}
+func (m *ReqSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSnapshotChunk.Marshal(b, m, deterministic)
+}
+func (dst *ReqSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSnapshotChunk.Merge(dst, src)
+}
+func (m *ReqSnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_ReqSnapshotChunk.Size(m)
+}
+func (m *ReqSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSnapshotChunk proto.InternalMessageInfo
+
+func (m *ReqSnapshotChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqSnapshotChunk) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReqSnapshotChunk) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ReqSnapshotChunk) GetWithBlock() bool {
+	if m != nil {
+		return m.WithBlock
+	}
+	return false
+}
+
+func (m *ReqSnapshotChunk) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *ReqSnapshotChunk) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+// mavl树节点，data为节点序列化之后的StoreNode
+type SnapshotNode struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotNode) Reset()         { *m = SnapshotNode{} }
+func (m *SnapshotNode) String() string { return proto.CompactTextString(m) }
+func (*SnapshotNode) ProtoMessage()    {}
+func (*SnapshotNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{52}
+}
+func (m *SnapshotNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotNode.Unmarshal(m, b)
+}
+func (m *SnapshotNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotNode.Marshal(b, m, deterministic)
+}
+func (dst *SnapshotNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotNode.Merge(dst, src)
+}
+func (m *SnapshotNode) XXX_Size() int {
+	return xxx_messageInfo_SnapshotNode.Size(m)
+}
+func (m *SnapshotNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotNode proto.InternalMessageInfo
+
+func (m *SnapshotNode) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SnapshotNode) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SnapshotNodes struct {
+	Nodes                []*SnapshotNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SnapshotNodes) Reset()         { *m = SnapshotNodes{} }
+func (m *SnapshotNodes) String() string { return proto.CompactTextString(m) }
+func (*SnapshotNodes) ProtoMessage()    {}
+func (*SnapshotNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{53}
+}
+func (m *SnapshotNodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotNodes.Unmarshal(m, b)
+}
+func (m *SnapshotNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotNodes.Marshal(b, m, deterministic)
+}
+func (dst *SnapshotNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotNodes.Merge(dst, src)
+}
+func (m *SnapshotNodes) XXX_Size() int {
+	return xxx_messageInfo_SnapshotNodes.Size(m)
+}
+func (m *SnapshotNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotNodes proto.InternalMessageInfo
+
+func (m *SnapshotNodes) GetNodes() []*SnapshotNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// 快照分片
+type SnapshotChunk struct {
+	Height               int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Headers              []*Header       `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	Block                *Block          `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Nodes                []*SnapshotNode `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SnapshotChunk) Reset()         { *m = SnapshotChunk{} }
+func (m *SnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChunk) ProtoMessage()    {}
+func (*SnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{54}
+}
+func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotChunk.Unmarshal(m, b)
+}
+func (m *SnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotChunk.Marshal(b, m, deterministic)
+}
+func (dst *SnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunk.Merge(dst, src)
+}
+func (m *SnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_SnapshotChunk.Size(m)
+}
+func (m *SnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChunk proto.InternalMessageInfo
+
+func (m *SnapshotChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotChunk) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *SnapshotChunk) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *SnapshotChunk) GetNodes() []*SnapshotNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// 保存快照节点之后返回本地还不存在的子节点hash
+type ReplySnapshotNodes struct {
+	Missing              [][]byte `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
+	Saved                [][]byte `protobuf:"bytes,2,rep,name=saved,proto3" json:"saved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplySnapshotNodes) Reset()         { *m = ReplySnapshotNodes{} }
+func (m *ReplySnapshotNodes) String() string { return proto.CompactTextString(m) }
+func (*ReplySnapshotNodes) ProtoMessage()    {}
+func (*ReplySnapshotNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{55}
+}
+func (m *ReplySnapshotNodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySnapshotNodes.Unmarshal(m, b)
+}
+func (m *ReplySnapshotNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySnapshotNodes.Marshal(b, m, deterministic)
+}
+func (dst *ReplySnapshotNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySnapshotNodes.Merge(dst, src)
+}
+func (m *ReplySnapshotNodes) XXX_Size() int {
+	return xxx_messageInfo_ReplySnapshotNodes.Size(m)
+}
+func (m *ReplySnapshotNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySnapshotNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySnapshotNodes proto.InternalMessageInfo
+
+func (m *ReplySnapshotNodes) GetMissing() [][]byte {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *ReplySnapshotNodes) GetSaved() [][]byte {
+	if m != nil {
+		return m.Saved
+	}
+	return nil
+}
+
+// 获取指定高度状态树中key的证明
+type ReqStateProof struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStateProof) Reset()         { *m = ReqStateProof{} }
+func (m *ReqStateProof) String() string { return proto.CompactTextString(m) }
+func (*ReqStateProof) ProtoMessage()    {}
+func (*ReqStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{56}
+}
+func (m *ReqStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStateProof.Unmarshal(m, b)
+}
+func (m *ReqStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStateProof.Marshal(b, m, deterministic)
+}
+func (dst *ReqStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStateProof.Merge(dst, src)
+}
+func (m *ReqStateProof) XXX_Size() int {
+	return xxx_messageInfo_ReqStateProof.Size(m)
+}
+func (m *ReqStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStateProof proto.InternalMessageInfo
+
+func (m *ReqStateProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqStateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// 状态证明，proof为序列化之后的MAVLProof
+type StateProof struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash            []byte   `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Proof                []byte   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{57}
+}
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (dst *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(dst, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// 状态树中变化的key，ty为新增、修改或者删除，新增时oldValue为空，删除时newValue为空
+type KeyValueDiff struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ty                   int32    `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	OldValue             []byte   `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue             []byte   `protobuf:"bytes,4,opt,name=newValue,proto3" json:"newValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyValueDiff) Reset()         { *m = KeyValueDiff{} }
+func (m *KeyValueDiff) String() string { return proto.CompactTextString(m) }
+func (*KeyValueDiff) ProtoMessage()    {}
+func (*KeyValueDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{58}
+}
+func (m *KeyValueDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyValueDiff.Unmarshal(m, b)
+}
+func (m *KeyValueDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyValueDiff.Marshal(b, m, deterministic)
+}
+func (dst *KeyValueDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValueDiff.Merge(dst, src)
+}
+func (m *KeyValueDiff) XXX_Size() int {
+	return xxx_messageInfo_KeyValueDiff.Size(m)
+}
+func (m *KeyValueDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValueDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValueDiff proto.InternalMessageInfo
+
+func (m *KeyValueDiff) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyValueDiff) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *KeyValueDiff) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
+	}
+	return nil
+}
+
+func (m *KeyValueDiff) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
+	}
+	return nil
+}
+
+// 获取区块修改的状态，hash不为空时按照hash查找区块，否则按照高度查找
+type ReqBlockStateDiff struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBlockStateDiff) Reset()         { *m = ReqBlockStateDiff{} }
+func (m *ReqBlockStateDiff) String() string { return proto.CompactTextString(m) }
+func (*ReqBlockStateDiff) ProtoMessage()    {}
+func (*ReqBlockStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{59}
+}
+func (m *ReqBlockStateDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBlockStateDiff.Unmarshal(m, b)
+}
+func (m *ReqBlockStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBlockStateDiff.Marshal(b, m, deterministic)
+}
+func (dst *ReqBlockStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBlockStateDiff.Merge(dst, src)
+}
+func (m *ReqBlockStateDiff) XXX_Size() int {
+	return xxx_messageInfo_ReqBlockStateDiff.Size(m)
+}
+func (m *ReqBlockStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBlockStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBlockStateDiff proto.InternalMessageInfo
+
+func (m *ReqBlockStateDiff) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqBlockStateDiff) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// 获取两个状态哈希之间的差异
+type ReqStateDiff struct {
+	OldStateHash         []byte   `protobuf:"bytes,1,opt,name=oldStateHash,proto3" json:"oldStateHash,omitempty"`
+	NewStateHash         []byte   `protobuf:"bytes,2,opt,name=newStateHash,proto3" json:"newStateHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStateDiff) Reset()         { *m = ReqStateDiff{} }
+func (m *ReqStateDiff) String() string { return proto.CompactTextString(m) }
+func (*ReqStateDiff) ProtoMessage()    {}
+func (*ReqStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{60}
+}
+func (m *ReqStateDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStateDiff.Unmarshal(m, b)
+}
+func (m *ReqStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStateDiff.Marshal(b, m, deterministic)
+}
+func (dst *ReqStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStateDiff.Merge(dst, src)
+}
+func (m *ReqStateDiff) XXX_Size() int {
+	return xxx_messageInfo_ReqStateDiff.Size(m)
+}
+func (m *ReqStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStateDiff proto.InternalMessageInfo
+
+func (m *ReqStateDiff) GetOldStateHash() []byte {
+	if m != nil {
+		return m.OldStateHash
+	}
+	return nil
+}
+
+func (m *ReqStateDiff) GetNewStateHash() []byte {
+	if m != nil {
+		return m.NewStateHash
+	}
+	return nil
+}
+
+// 状态树的差异，items按照key排序，按照状态哈希查询时height和blockHash为空
+type StateDiff struct {
+	Height               int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash            []byte          `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	OldStateHash         []byte          `protobuf:"bytes,3,opt,name=oldStateHash,proto3" json:"oldStateHash,omitempty"`
+	NewStateHash         []byte          `protobuf:"bytes,4,opt,name=newStateHash,proto3" json:"newStateHash,omitempty"`
+	Items                []*KeyValueDiff `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{61}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateDiff.Unmarshal(m, b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateDiff.Marshal(b, m, deterministic)
+}
+func (dst *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(dst, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return xxx_messageInfo_StateDiff.Size(m)
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StateDiff) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *StateDiff) GetOldStateHash() []byte {
+	if m != nil {
+		return m.OldStateHash
+	}
+	return nil
+}
+
+func (m *StateDiff) GetNewStateHash() []byte {
+	if m != nil {
+		return m.NewStateHash
+	}
+	return nil
+}
+
+func (m *StateDiff) GetItems() []*KeyValueDiff {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// 链重组事件
+// index:重组事件的序号，从1开始递增
+// forkHeight,forkHash:分叉点区块
+// detachedHashes:从主链回滚的区块，从高到低排列
+// attachedHashes:加入主链的区块，从低到高排列
+// depth:回滚的区块数
+// sequence:第一个回滚区块对应的seq，没有开启isRecordBlockSequence时为-1
+type ReorgEvent struct {
+	Index                int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ForkHeight           int64    `protobuf:"varint,2,opt,name=forkHeight,proto3" json:"forkHeight,omitempty"`
+	ForkHash             []byte   `protobuf:"bytes,3,opt,name=forkHash,proto3" json:"forkHash,omitempty"`
+	DetachedHashes       [][]byte `protobuf:"bytes,4,rep,name=detachedHashes,proto3" json:"detachedHashes,omitempty"`
+	AttachedHashes       [][]byte `protobuf:"bytes,5,rep,name=attachedHashes,proto3" json:"attachedHashes,omitempty"`
+	Depth                int64    `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	Time                 int64    `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+	Sequence             int64    `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgEvent) Reset()         { *m = ReorgEvent{} }
+func (m *ReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ReorgEvent) ProtoMessage()    {}
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{62}
+}
+func (m *ReorgEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgEvent.Unmarshal(m, b)
+}
+func (m *ReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgEvent.Marshal(b, m, deterministic)
+}
+func (dst *ReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgEvent.Merge(dst, src)
+}
+func (m *ReorgEvent) XXX_Size() int {
+	return xxx_messageInfo_ReorgEvent.Size(m)
+}
+func (m *ReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgEvent proto.InternalMessageInfo
+
+func (m *ReorgEvent) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetForkHeight() int64 {
+	if m != nil {
+		return m.ForkHeight
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetForkHash() []byte {
+	if m != nil {
+		return m.ForkHash
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetDetachedHashes() [][]byte {
+	if m != nil {
+		return m.DetachedHashes
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetAttachedHashes() [][]byte {
+	if m != nil {
+		return m.AttachedHashes
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// 获取最近的count个链重组事件
+type ReqReorgEvents struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqReorgEvents) Reset()         { *m = ReqReorgEvents{} }
+func (m *ReqReorgEvents) String() string { return proto.CompactTextString(m) }
+func (*ReqReorgEvents) ProtoMessage()    {}
+func (*ReqReorgEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{63}
+}
+func (m *ReqReorgEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqReorgEvents.Unmarshal(m, b)
+}
+func (m *ReqReorgEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqReorgEvents.Marshal(b, m, deterministic)
+}
+func (dst *ReqReorgEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqReorgEvents.Merge(dst, src)
+}
+func (m *ReqReorgEvents) XXX_Size() int {
+	return xxx_messageInfo_ReqReorgEvents.Size(m)
+}
+func (m *ReqReorgEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqReorgEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqReorgEvents proto.InternalMessageInfo
+
+func (m *ReqReorgEvents) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReorgEvents struct {
+	Items                []*ReorgEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReorgEvents) Reset()         { *m = ReorgEvents{} }
+func (m *ReorgEvents) String() string { return proto.CompactTextString(m) }
+func (*ReorgEvents) ProtoMessage()    {}
+func (*ReorgEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{64}
+}
+func (m *ReorgEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgEvents.Unmarshal(m, b)
+}
+func (m *ReorgEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgEvents.Marshal(b, m, deterministic)
+}
+func (dst *ReorgEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgEvents.Merge(dst, src)
+}
+func (m *ReorgEvents) XXX_Size() int {
+	return xxx_messageInfo_ReorgEvents.Size(m)
+}
+func (m *ReorgEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgEvents proto.InternalMessageInfo
+
+func (m *ReorgEvents) GetItems() []*ReorgEvent {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// 检查点，运行时添加的检查点需要对Checkpoint{height,hash}签名
+type ChainCheckpoint struct {
+	Height               int64      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 []byte     `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Signature            *Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ChainCheckpoint) Reset()         { *m = ChainCheckpoint{} }
+func (m *ChainCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ChainCheckpoint) ProtoMessage()    {}
+func (*ChainCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{65}
+}
+func (m *ChainCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainCheckpoint.Unmarshal(m, b)
+}
+func (m *ChainCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainCheckpoint.Marshal(b, m, deterministic)
+}
+func (dst *ChainCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainCheckpoint.Merge(dst, src)
+}
+func (m *ChainCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_ChainCheckpoint.Size(m)
+}
+func (m *ChainCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainCheckpoint proto.InternalMessageInfo
+
+func (m *ChainCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ChainCheckpoint) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ChainCheckpoint) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type ChainCheckpoints struct {
+	Items                []*ChainCheckpoint `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChainCheckpoints) Reset()         { *m = ChainCheckpoints{} }
+func (m *ChainCheckpoints) String() string { return proto.CompactTextString(m) }
+func (*ChainCheckpoints) ProtoMessage()    {}
+func (*ChainCheckpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4ceb609e7ebae636, []int{66}
+}
+func (m *ChainCheckpoints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainCheckpoints.Unmarshal(m, b)
+}
+func (m *ChainCheckpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainCheckpoints.Marshal(b, m, deterministic)
+}
+func (dst *ChainCheckpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainCheckpoints.Merge(dst, src)
+}
+func (m *ChainCheckpoints) XXX_Size() int {
+	return xxx_messageInfo_ChainCheckpoints.Size(m)
+}
+func (m *ChainCheckpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainCheckpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainCheckpoints proto.InternalMessageInfo
+
+func (m *ChainCheckpoints) GetItems() []*ChainCheckpoint {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Header)(nil), "types.Header")
+	proto.RegisterType((*Block)(nil), "types.Block")
+	proto.RegisterType((*Blocks)(nil), "types.Blocks")
+	proto.RegisterType((*BlockSeqCB)(nil), "types.BlockSeqCB")
+	proto.RegisterType((*BlockSeqCBs)(nil), "types.BlockSeqCBs")
+	proto.RegisterType((*PushSeqData)(nil), "types.PushSeqData")
+	proto.RegisterType((*PushSeqAck)(nil), "types.PushSeqAck")
+	proto.RegisterType((*BlockSeq)(nil), "types.BlockSeq")
+	proto.RegisterType((*BlockSeqs)(nil), "types.BlockSeqs")
+	proto.RegisterType((*BlockPid)(nil), "types.BlockPid")
 	proto.RegisterType((*BlockDetails)(nil), "types.BlockDetails")
 	proto.RegisterType((*Headers)(nil), "types.Headers")
 	proto.RegisterType((*HeadersPid)(nil), "types.HeadersPid")
@@ -2580,8 +3899,12 @@ func init() {
 	proto.RegisterType((*ReqParaTxByTitle)(nil), "types.ReqParaTxByTitle")
 	proto.RegisterType((*FileHeader)(nil), "types.FileHeader")
 	proto.RegisterType((*EndBlock)(nil), "types.EndBlock")
+	proto.RegisterType((*ReindexProgress)(nil), "types.ReindexProgress")
+	proto.RegisterType((*ArchiveHeader)(nil), "types.ArchiveHeader")
 	proto.RegisterType((*HeaderSeq)(nil), "types.HeaderSeq")
 	proto.RegisterType((*HeaderSeqs)(nil), "types.HeaderSeqs")
+	proto.RegisterType((*FilterSeq)(nil), "types.FilterSeq")
+	proto.RegisterType((*FilterSeqs)(nil), "types.FilterSeqs")
 	proto.RegisterType((*HeightPara)(nil), "types.HeightPara")
 	proto.RegisterType((*HeightParas)(nil), "types.HeightParas")
 	proto.RegisterType((*ChildChain)(nil), "types.ChildChain")
@@ -2590,112 +3913,174 @@ func init() {
 	proto.RegisterType((*BlockInfo)(nil), "types.BlockInfo")
 	proto.RegisterType((*ReqParaTxByHeight)(nil), "types.ReqParaTxByHeight")
 	proto.RegisterType((*CmpBlock)(nil), "types.CmpBlock")
-}
-
-func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_e45f7cea807c6c75) }
-
-var fileDescriptor_blockchain_e45f7cea807c6c75 = []byte{
-	// 1630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6e, 0x1c, 0xc5,
-	0x13, 0xd7, 0xec, 0xec, 0x67, 0xad, 0xd7, 0x7f, 0x67, 0x62, 0xfd, 0xb5, 0xb2, 0x20, 0xd9, 0x34,
-	0x21, 0x2c, 0x51, 0x70, 0x90, 0x8d, 0x92, 0x08, 0x21, 0x01, 0xde, 0x04, 0xd9, 0x4a, 0x48, 0xc2,
-	0xd8, 0xc9, 0x81, 0x13, 0xe3, 0xd9, 0xb6, 0x77, 0xf0, 0xee, 0xcc, 0x78, 0xba, 0xd7, 0xec, 0xe6,
-	0xc4, 0x19, 0x89, 0x17, 0x41, 0x3c, 0x06, 0x17, 0x2e, 0x88, 0x37, 0xe0, 0x55, 0x50, 0x55, 0x77,
-	0xcf, 0xf4, 0xac, 0xd7, 0x26, 0x16, 0x27, 0x6e, 0x5d, 0xd5, 0x1f, 0xf5, 0xab, 0x5f, 0x55, 0x57,
-	0xf5, 0x0c, 0xac, 0x1d, 0x8e, 0x93, 0xf0, 0x24, 0x1c, 0x05, 0x51, 0xbc, 0x99, 0x66, 0x89, 0x4c,
-	0xbc, 0x9a, 0x9c, 0xa7, 0x5c, 0x6c, 0x5c, 0x93, 0x59, 0x10, 0x8b, 0x20, 0x94, 0x51, 0xa2, 0x67,
-	0x36, 0x56, 0xc2, 0x64, 0x32, 0x31, 0x12, 0xfb, 0xb5, 0x02, 0xf5, 0x5d, 0x1e, 0x0c, 0x79, 0xe6,
-	0x75, 0xa1, 0x71, 0xc6, 0x33, 0x11, 0x25, 0x71, 0xd7, 0xe9, 0x39, 0x7d, 0xd7, 0x37, 0xa2, 0x77,
-	0x03, 0x20, 0x0d, 0x32, 0x1e, 0xcb, 0xdd, 0x40, 0x8c, 0xba, 0x95, 0x9e, 0xd3, 0x5f, 0xf1, 0x2d,
-	0x8d, 0xf7, 0x7f, 0xa8, 0xcb, 0x19, 0xcd, 0xb9, 0x34, 0xa7, 0x25, 0xef, 0x1d, 0x68, 0x09, 0x19,
-	0x48, 0x4e, 0x53, 0x55, 0x9a, 0x2a, 0x14, 0xb8, 0x6b, 0xc4, 0xa3, 0xe3, 0x91, 0xec, 0xd6, 0xc8,
-	0x9c, 0x96, 0x70, 0x17, 0xb9, 0x73, 0x10, 0x4d, 0x78, 0xb7, 0x4e, 0x53, 0x85, 0x02, 0x51, 0xca,
-	0xd9, 0x20, 0x99, 0xc6, 0xb2, 0xdb, 0x52, 0x28, 0xb5, 0xe8, 0x79, 0x50, 0x1d, 0xa1, 0x21, 0x20,
-	0x43, 0x34, 0x46, 0xe4, 0xc3, 0xe8, 0xe8, 0x28, 0x0a, 0xa7, 0x63, 0x39, 0xef, 0xb6, 0x7b, 0x4e,
-	0xbf, 0xe3, 0x5b, 0x1a, 0x6f, 0x13, 0x5a, 0x22, 0x3a, 0x8e, 0x03, 0x39, 0xcd, 0x78, 0xb7, 0xd9,
-	0x73, 0xfa, 0xed, 0xad, 0xb5, 0x4d, 0xa2, 0x6e, 0x73, 0xdf, 0xe8, 0xfd, 0x62, 0x09, 0xfb, 0xab,
-	0x02, 0xb5, 0x1d, 0xc4, 0xf2, 0x1f, 0x61, 0xeb, 0x9f, 0xfc, 0xdf, 0x80, 0xe6, 0x24, 0x88, 0x62,
-	0x32, 0xb9, 0x42, 0x26, 0x73, 0x19, 0xf7, 0xd2, 0x58, 0x59, 0xed, 0xd0, 0xd1, 0x96, 0xe6, 0xaa,
-	0xdc, 0x79, 0xb7, 0xc1, 0x95, 0x33, 0xd1, 0x6d, 0xf4, 0xdc, 0x7e, 0x7b, 0xcb, 0xd3, 0x2b, 0x0f,
-	0x8a, 0xfc, 0xf4, 0x71, 0x9a, 0xdd, 0x83, 0x3a, 0x11, 0x2c, 0x3c, 0x06, 0xb5, 0x48, 0xf2, 0x89,
-	0xe8, 0x3a, 0xb4, 0x63, 0x45, 0xef, 0xa0, 0x59, 0x5f, 0x4d, 0xb1, 0x3f, 0x1d, 0x00, 0x52, 0xec,
-	0xf3, 0xd3, 0xc1, 0x0e, 0xa6, 0x40, 0x1c, 0x4c, 0x38, 0x45, 0xa4, 0xe5, 0xd3, 0xd8, 0x5b, 0x03,
-	0xf7, 0x95, 0xff, 0x8c, 0xe2, 0xd0, 0xf2, 0x71, 0x88, 0x54, 0xf2, 0x38, 0x4c, 0x86, 0x9c, 0x02,
-	0xd0, 0xf2, 0xb5, 0x84, 0x64, 0x44, 0x42, 0x5d, 0x06, 0xe2, 0xbf, 0xe9, 0xe7, 0xb2, 0xc7, 0x60,
-	0x65, 0x1c, 0x08, 0xb9, 0xcf, 0x4f, 0xa7, 0x3c, 0x0e, 0xb9, 0x0e, 0x42, 0x49, 0x87, 0x84, 0xa1,
-	0xac, 0x09, 0x53, 0xb1, 0xb0, 0x34, 0xde, 0x6d, 0xe8, 0xa0, 0x44, 0x78, 0x89, 0xf1, 0x06, 0x99,
-	0x2f, 0x2b, 0xd9, 0x03, 0x68, 0x17, 0x1e, 0x09, 0xef, 0x83, 0x32, 0x0b, 0xd7, 0x6c, 0x16, 0x68,
-	0x89, 0xa1, 0x22, 0x85, 0xa6, 0x51, 0xa2, 0xcf, 0xf1, 0x74, 0xa2, 0x13, 0x13, 0x87, 0xde, 0x1d,
-	0x70, 0x05, 0x3f, 0x25, 0x16, 0xda, 0x5b, 0xeb, 0x0b, 0x87, 0x10, 0x7c, 0x1f, 0x17, 0x78, 0x77,
-	0xa1, 0x3e, 0xe4, 0x32, 0x88, 0xc6, 0xc4, 0x4d, 0x11, 0x27, 0x5a, 0xfa, 0x98, 0x66, 0x7c, 0xbd,
-	0x82, 0x7d, 0x0c, 0x2d, 0x73, 0x82, 0xf0, 0xde, 0x83, 0xaa, 0xe0, 0xa7, 0x06, 0xe6, 0xff, 0x16,
-	0x2c, 0xf8, 0x34, 0xc9, 0xbe, 0xd0, 0x18, 0x5f, 0x46, 0x43, 0xc4, 0x98, 0x46, 0x43, 0x1d, 0x2a,
-	0x1c, 0x62, 0xc0, 0x29, 0x73, 0x35, 0xca, 0x85, 0x80, 0xd3, 0x14, 0x7b, 0x04, 0x2b, 0x16, 0x14,
-	0xe1, 0xf5, 0xcb, 0xf4, 0x2c, 0x83, 0xab, 0xf9, 0xd9, 0x84, 0x86, 0x8a, 0x25, 0x62, 0x2d, 0x6d,
-	0xea, 0xe8, 0x4d, 0x6a, 0xda, 0xac, 0xdf, 0x05, 0xd0, 0xeb, 0x97, 0xa3, 0xed, 0x43, 0x63, 0xa4,
-	0xe6, 0x35, 0xde, 0xd5, 0xd2, 0x31, 0xc2, 0x37, 0xd3, 0x6c, 0x04, 0x1d, 0xc2, 0xf3, 0xe2, 0x8c,
-	0x67, 0x67, 0x11, 0xff, 0xc1, 0xbb, 0x05, 0x55, 0x9c, 0xa3, 0xd3, 0xce, 0x99, 0xa7, 0x29, 0xbb,
-	0xcc, 0x55, 0xca, 0x65, 0x6e, 0x03, 0x9a, 0xaa, 0x60, 0x70, 0xd1, 0x75, 0x7b, 0x2e, 0x5e, 0x59,
-	0x23, 0xb3, 0x5f, 0x1c, 0x9d, 0x3c, 0xca, 0xf5, 0x82, 0x51, 0xe7, 0x42, 0x46, 0xbd, 0x4d, 0x68,
-	0x66, 0x3c, 0xe4, 0x51, 0x2a, 0xd1, 0x11, 0x9b, 0x44, 0x5f, 0xa9, 0x1f, 0x07, 0x32, 0xf0, 0xf3,
-	0x35, 0xde, 0x4d, 0xa8, 0x3c, 0x7d, 0x4d, 0x96, 0x8b, 0x30, 0x3f, 0xe5, 0xf3, 0xd7, 0xc1, 0x78,
-	0xca, 0xfd, 0xca, 0xd3, 0xd7, 0xde, 0x1d, 0x58, 0x4d, 0x33, 0x7e, 0xb6, 0x2f, 0x03, 0x39, 0x15,
-	0x56, 0x31, 0x5b, 0xd0, 0xb2, 0x07, 0xd0, 0xf4, 0xcd, 0xa1, 0x77, 0x2d, 0x10, 0x2a, 0x28, 0xab,
-	0x65, 0x10, 0x05, 0x00, 0xd6, 0x07, 0x4f, 0x2b, 0x07, 0x23, 0x1e, 0x9e, 0x1c, 0xcc, 0x9e, 0x45,
-	0x82, 0xaa, 0x3f, 0xcf, 0x32, 0xb5, 0xbb, 0xe5, 0xd3, 0x98, 0xcd, 0xa1, 0x3d, 0xc0, 0x9e, 0xa8,
-	0x8c, 0xe2, 0xfd, 0x0b, 0xa7, 0x19, 0xd5, 0x61, 0x75, 0x45, 0xd5, 0xfd, 0x28, 0x2b, 0xbd, 0x1e,
-	0xb4, 0x27, 0x7c, 0x92, 0x26, 0xc9, 0x78, 0x3f, 0x7a, 0xc3, 0x35, 0xfb, 0xb6, 0x0a, 0x6b, 0xc1,
-	0x44, 0x1c, 0x7f, 0x33, 0xe5, 0x53, 0x4e, 0x4b, 0x5c, 0x55, 0x0b, 0x6c, 0x1d, 0x0b, 0xa0, 0xe5,
-	0xf3, 0x53, 0x5d, 0xc9, 0xd6, 0xa1, 0x26, 0x64, 0x90, 0x19, 0x83, 0x4a, 0xc0, 0x94, 0xe2, 0xf1,
-	0x50, 0x1b, 0xc0, 0xa1, 0x2a, 0x40, 0x8f, 0x8b, 0xeb, 0x47, 0x05, 0x48, 0x87, 0x52, 0x27, 0x60,
-	0x95, 0xdc, 0xc3, 0x21, 0xbb, 0x05, 0xed, 0xaf, 0x2d, 0x54, 0x1e, 0x54, 0x05, 0xa2, 0x51, 0x36,
-	0x68, 0xcc, 0xee, 0xc2, 0x9a, 0xcf, 0xd3, 0xf1, 0x5c, 0x55, 0x17, 0xe5, 0x5f, 0xd1, 0x48, 0x1c,
-	0xbb, 0x91, 0xb0, 0xdf, 0x1d, 0x7d, 0x9d, 0x77, 0x92, 0xe1, 0xdc, 0x14, 0x6b, 0xe7, 0xd2, 0x62,
-	0x7d, 0xe5, 0xdc, 0xb1, 0xdb, 0x8d, 0x7b, 0x69, 0xbb, 0xa9, 0x9e, 0x6b, 0x37, 0xa6, 0xbd, 0xd7,
-	0xac, 0xf6, 0x5e, 0xf8, 0x52, 0x2f, 0xf9, 0xf2, 0xbd, 0xae, 0x12, 0x1a, 0x45, 0x09, 0xa7, 0xf3,
-	0x16, 0x38, 0x8d, 0xad, 0xca, 0x52, 0x5b, 0x6e, 0xc9, 0xd6, 0x3d, 0x80, 0x3d, 0x31, 0x08, 0xa6,
-	0xc7, 0x23, 0xf9, 0x2a, 0x45, 0x2f, 0xf6, 0x44, 0x48, 0xd2, 0x34, 0x25, 0x86, 0x9b, 0xbe, 0xa5,
-	0x61, 0x8f, 0x60, 0x75, 0x4f, 0x3c, 0x97, 0xe9, 0x80, 0x0a, 0xe3, 0x3c, 0x0e, 0xf1, 0xba, 0x44,
-	0x22, 0x96, 0x69, 0x48, 0xb9, 0x32, 0x8f, 0x43, 0xbd, 0x6b, 0x41, 0xcb, 0x7e, 0x76, 0xa0, 0x43,
-	0xd9, 0xfc, 0x64, 0xc6, 0xc3, 0xa9, 0x4c, 0x32, 0x44, 0x34, 0xcc, 0xa2, 0x33, 0x9e, 0xe9, 0xb2,
-	0xa4, 0x25, 0x64, 0xf9, 0x68, 0x1a, 0x87, 0xcf, 0xb1, 0x13, 0xaa, 0xb6, 0x97, 0xcb, 0xe5, 0x47,
-	0x86, 0xbb, 0xf8, 0xc8, 0x58, 0x87, 0x5a, 0x1a, 0x64, 0xc1, 0x44, 0xdf, 0x58, 0x25, 0xa0, 0x96,
-	0xcf, 0x64, 0x16, 0x68, 0xea, 0x95, 0xc0, 0x1e, 0xea, 0xaa, 0x96, 0xb7, 0x3f, 0x0f, 0xaa, 0x74,
-	0xaa, 0xa3, 0x48, 0xa3, 0x03, 0x3d, 0xa8, 0x1e, 0xcc, 0x53, 0x73, 0x8b, 0x68, 0xcc, 0x3e, 0x83,
-	0xd5, 0xd2, 0x46, 0xbc, 0xfd, 0xa5, 0x7a, 0xbc, 0xbc, 0x3d, 0xe9, 0xb2, 0x7c, 0x04, 0xcd, 0xab,
-	0x5a, 0x44, 0x42, 0x84, 0x69, 0xdc, 0x2a, 0x78, 0xb9, 0x6c, 0x85, 0xb5, 0x5a, 0x0a, 0xeb, 0x77,
-	0x70, 0x9d, 0xae, 0xce, 0x97, 0xc3, 0x21, 0xb6, 0xd9, 0x60, 0x3c, 0x3e, 0x0c, 0xc2, 0x13, 0x3c,
-	0x3e, 0x12, 0x2f, 0x4e, 0x74, 0x8c, 0x68, 0x8c, 0x57, 0x73, 0x22, 0x8e, 0x75, 0xb2, 0xe0, 0x30,
-	0x6f, 0x86, 0xe5, 0x2a, 0x99, 0xbb, 0xa2, 0x9a, 0xe1, 0x8f, 0x0e, 0xac, 0xbf, 0x0c, 0xb2, 0x80,
-	0x82, 0x6a, 0x57, 0xed, 0x4f, 0xa0, 0x4d, 0xa5, 0x59, 0x37, 0x62, 0xe7, 0xc2, 0x46, 0x6c, 0x2f,
-	0x2b, 0x39, 0x59, 0x39, 0xef, 0x64, 0x24, 0x30, 0xdb, 0x74, 0x59, 0xd1, 0x12, 0xfb, 0x14, 0x3a,
-	0x88, 0xe0, 0x60, 0x66, 0xda, 0xe9, 0x87, 0xe5, 0x48, 0x5c, 0xd7, 0x46, 0xed, 0x45, 0x26, 0x10,
-	0xbf, 0x39, 0xb0, 0x62, 0xeb, 0x91, 0x1a, 0x5c, 0x6d, 0x0a, 0x10, 0x8e, 0xbd, 0xf7, 0x91, 0x5d,
-	0x7a, 0x50, 0x55, 0x96, 0xf5, 0x3a, 0x3d, 0xe9, 0x7d, 0x04, 0x2d, 0x69, 0x30, 0x2c, 0x90, 0x96,
-	0x9b, 0x2d, 0x56, 0x60, 0x12, 0x87, 0xa3, 0x68, 0x3c, 0xb4, 0x5f, 0xca, 0xb9, 0x02, 0xd3, 0x35,
-	0x8a, 0x87, 0x7c, 0x46, 0xe9, 0xda, 0xf1, 0x95, 0x80, 0x14, 0xa4, 0x59, 0x92, 0x1c, 0x89, 0x6e,
-	0x9d, 0x9a, 0xa6, 0x96, 0xd8, 0x4f, 0x0e, 0x34, 0x73, 0x17, 0xf2, 0xad, 0x8e, 0xbd, 0x95, 0x41,
-	0x45, 0xce, 0xb4, 0x03, 0xcb, 0x4a, 0x61, 0x45, 0xce, 0xbc, 0x7b, 0xd0, 0xd0, 0xd5, 0x63, 0xe1,
-	0xe1, 0x64, 0x17, 0x18, 0xb3, 0xc4, 0x02, 0x53, 0x2d, 0x81, 0x39, 0xc2, 0x7a, 0x7d, 0xaa, 0x58,
-	0xdd, 0x99, 0x1f, 0x44, 0x72, 0xcc, 0xdf, 0xba, 0x79, 0xac, 0x43, 0x4d, 0xe2, 0x06, 0xfd, 0xa8,
-	0x55, 0x02, 0x79, 0x24, 0xf6, 0xf9, 0xa9, 0x7e, 0xd0, 0x2a, 0x81, 0x9d, 0x01, 0x7c, 0x15, 0x8d,
-	0xb9, 0x7e, 0xdb, 0xf6, 0xa0, 0x4d, 0x87, 0x96, 0xba, 0xa2, 0xad, 0xb2, 0x2a, 0x4d, 0xa5, 0x54,
-	0x69, 0x96, 0xdb, 0xc4, 0xb7, 0x0b, 0x17, 0xf2, 0x39, 0x97, 0xda, 0xaa, 0x11, 0xb1, 0xe5, 0x3f,
-	0x89, 0x87, 0xea, 0x03, 0xea, 0x82, 0x3e, 0xb4, 0xac, 0xf6, 0xb2, 0x31, 0xb4, 0x14, 0xd6, 0x7f,
-	0xf7, 0xb8, 0x2d, 0xb2, 0xd1, 0xbd, 0x24, 0x1b, 0xd9, 0x96, 0x79, 0xf9, 0xd1, 0xc3, 0xf6, 0x76,
-	0xe9, 0x61, 0xbb, 0x56, 0xda, 0x52, 0xbc, 0x6c, 0xff, 0x70, 0x70, 0x13, 0x3a, 0x80, 0xd1, 0xbb,
-	0xd0, 0xb9, 0x9c, 0xb0, 0x8a, 0x4d, 0x98, 0x71, 0xd9, 0xb5, 0xda, 0xcd, 0xe5, 0x39, 0x7e, 0x03,
-	0x80, 0xe2, 0xb3, 0x97, 0x27, 0x7a, 0xcd, 0xb7, 0x34, 0xd8, 0x54, 0xf2, 0xc5, 0x6a, 0x4d, 0x9d,
-	0x32, 0x7a, 0x41, 0x6b, 0x3f, 0x33, 0x1b, 0x74, 0x88, 0x11, 0xf1, 0x33, 0xa4, 0xf0, 0xe7, 0xc2,
-	0xcf, 0x90, 0x62, 0x89, 0x29, 0x0b, 0x6f, 0x00, 0x06, 0x68, 0x83, 0xaa, 0x5a, 0xe1, 0xaf, 0x63,
-	0xfb, 0x5b, 0x46, 0x5f, 0x39, 0x87, 0xbe, 0xe4, 0xbb, 0xbb, 0xe8, 0xbb, 0x85, 0xb9, 0x5a, 0xc6,
-	0x2c, 0xe9, 0xfa, 0x28, 0x4c, 0xe6, 0xfa, 0x5c, 0x2d, 0x12, 0xeb, 0x50, 0x0b, 0xe9, 0x64, 0x97,
-	0x4e, 0x56, 0x02, 0xe2, 0x19, 0x46, 0x19, 0xa7, 0xdb, 0xae, 0x6d, 0x16, 0x0a, 0xe6, 0xe3, 0x7b,
-	0x34, 0x1d, 0xcf, 0xcb, 0x76, 0x97, 0x7b, 0x7e, 0xc7, 0xd0, 0x58, 0x29, 0x65, 0x13, 0xe5, 0xea,
-	0x5e, 0x7c, 0x94, 0x18, 0x16, 0x1f, 0xea, 0xb7, 0x18, 0xea, 0xae, 0x74, 0x53, 0x3e, 0x87, 0x6b,
-	0x56, 0x05, 0xd9, 0xcd, 0x7d, 0x2d, 0x82, 0xe7, 0x6a, 0x1b, 0xcb, 0x19, 0x60, 0xbb, 0xd0, 0x1c,
-	0x4c, 0x52, 0x75, 0x45, 0xdf, 0xe6, 0xf3, 0xa1, 0x0b, 0x8d, 0x70, 0x92, 0x5a, 0xbf, 0x3a, 0x8c,
-	0xb8, 0x73, 0xf3, 0xdb, 0x77, 0x8f, 0x23, 0x39, 0x9a, 0x1e, 0x6e, 0x86, 0xc9, 0xe4, 0xfe, 0xf6,
-	0x76, 0x18, 0xdf, 0xa7, 0x3f, 0x54, 0xdb, 0xdb, 0xf7, 0xe9, 0x9c, 0xc3, 0x3a, 0xfd, 0x82, 0xda,
-	0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6a, 0x8c, 0x39, 0xbe, 0x12, 0x00, 0x00,
+	proto.RegisterType((*ReqSnapshotChunk)(nil), "types.ReqSnapshotChunk")
+	proto.RegisterType((*SnapshotNode)(nil), "types.SnapshotNode")
+	proto.RegisterType((*SnapshotNodes)(nil), "types.SnapshotNodes")
+	proto.RegisterType((*SnapshotChunk)(nil), "types.SnapshotChunk")
+	proto.RegisterType((*ReplySnapshotNodes)(nil), "types.ReplySnapshotNodes")
+	proto.RegisterType((*ReqStateProof)(nil), "types.ReqStateProof")
+	proto.RegisterType((*StateProof)(nil), "types.StateProof")
+	proto.RegisterType((*KeyValueDiff)(nil), "types.KeyValueDiff")
+	proto.RegisterType((*ReqBlockStateDiff)(nil), "types.ReqBlockStateDiff")
+	proto.RegisterType((*ReqStateDiff)(nil), "types.ReqStateDiff")
+	proto.RegisterType((*StateDiff)(nil), "types.StateDiff")
+	proto.RegisterType((*ReorgEvent)(nil), "types.ReorgEvent")
+	proto.RegisterType((*ReqReorgEvents)(nil), "types.ReqReorgEvents")
+	proto.RegisterType((*ReorgEvents)(nil), "types.ReorgEvents")
+	proto.RegisterType((*ChainCheckpoint)(nil), "types.ChainCheckpoint")
+	proto.RegisterType((*ChainCheckpoints)(nil), "types.ChainCheckpoints")
+}
+
+func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_4ceb609e7ebae636) }
+
+var fileDescriptor_blockchain_4ceb609e7ebae636 = []byte{
+	// 2241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x19, 0x4d, 0x6f, 0x1c, 0x49,
+	0x55, 0xdd, 0x3d, 0x33, 0x9e, 0x79, 0x33, 0xe3, 0x38, 0x1d, 0x6b, 0x35, 0x8a, 0x96, 0xac, 0xb7,
+	0x09, 0x59, 0x13, 0x82, 0x83, 0x12, 0x94, 0x5d, 0x56, 0x48, 0x6c, 0xe2, 0x64, 0x71, 0x94, 0x25,
+	0x1b, 0x7a, 0xbc, 0x39, 0x70, 0xa2, 0xd3, 0x5d, 0x76, 0x37, 0x9e, 0xe9, 0x6e, 0x77, 0xd5, 0x38,
+	0x33, 0x7b, 0xe2, 0xcc, 0x1d, 0x21, 0x24, 0x24, 0x4e, 0x5c, 0x10, 0x27, 0xc4, 0x1f, 0x40, 0xe2,
+	0xc2, 0x05, 0xf1, 0x0f, 0xf8, 0x13, 0xfc, 0x00, 0xf4, 0x5e, 0x55, 0x75, 0x57, 0x8d, 0x67, 0x62,
+	0x5b, 0x48, 0x48, 0xdc, 0xea, 0xbd, 0x7a, 0x55, 0xef, 0xb3, 0xde, 0x47, 0x37, 0x6c, 0xbd, 0x99,
+	0x14, 0xf1, 0x49, 0x9c, 0x46, 0x59, 0xbe, 0x57, 0x56, 0x85, 0x28, 0xfc, 0xb6, 0x58, 0x94, 0x8c,
+	0xdf, 0xbc, 0x2e, 0xaa, 0x28, 0xe7, 0x51, 0x2c, 0xb2, 0x42, 0xed, 0xdc, 0x1c, 0xc4, 0xc5, 0x74,
+	0xaa, 0xa1, 0xe0, 0x4f, 0x2e, 0x74, 0x0e, 0x58, 0x94, 0xb0, 0xca, 0x1f, 0xc1, 0xc6, 0x19, 0xab,
+	0x78, 0x56, 0xe4, 0x23, 0x67, 0xc7, 0xd9, 0xf5, 0x42, 0x0d, 0xfa, 0xb7, 0x00, 0xca, 0xa8, 0x62,
+	0xb9, 0x38, 0x88, 0x78, 0x3a, 0x72, 0x77, 0x9c, 0xdd, 0x41, 0x68, 0x60, 0xfc, 0xf7, 0xa0, 0x23,
+	0xe6, 0xb4, 0xe7, 0xd1, 0x9e, 0x82, 0xfc, 0xf7, 0xa1, 0xc7, 0x45, 0x24, 0x18, 0x6d, 0xb5, 0x68,
+	0xab, 0x41, 0xe0, 0xa9, 0x94, 0x65, 0xc7, 0xa9, 0x18, 0xb5, 0x89, 0x9d, 0x82, 0xf0, 0x14, 0xa9,
+	0x73, 0x98, 0x4d, 0xd9, 0xa8, 0x43, 0x5b, 0x0d, 0x02, 0xa5, 0x14, 0xf3, 0xfd, 0x62, 0x96, 0x8b,
+	0x51, 0x4f, 0x4a, 0xa9, 0x40, 0xdf, 0x87, 0x56, 0x8a, 0x8c, 0x80, 0x18, 0xd1, 0x1a, 0x25, 0x4f,
+	0xb2, 0xa3, 0xa3, 0x2c, 0x9e, 0x4d, 0xc4, 0x62, 0xd4, 0xdf, 0x71, 0x76, 0x87, 0xa1, 0x81, 0xf1,
+	0xf7, 0xa0, 0xc7, 0xb3, 0xe3, 0x3c, 0x12, 0xb3, 0x8a, 0x8d, 0xba, 0x3b, 0xce, 0x6e, 0xff, 0xc1,
+	0xd6, 0x1e, 0x99, 0x6e, 0x6f, 0xac, 0xf1, 0x61, 0x43, 0x12, 0xfc, 0xcb, 0x85, 0xf6, 0x13, 0x94,
+	0xe5, 0xff, 0xc4, 0x5a, 0x17, 0xe9, 0x7f, 0x13, 0xba, 0xd3, 0x28, 0xcb, 0x89, 0xe5, 0x80, 0x58,
+	0xd6, 0x30, 0x9e, 0xa5, 0xb5, 0xe4, 0x3a, 0xa4, 0xab, 0x0d, 0xcc, 0x55, 0x6d, 0xe7, 0xdf, 0x06,
+	0x4f, 0xcc, 0xf9, 0x68, 0x63, 0xc7, 0xdb, 0xed, 0x3f, 0xf0, 0x15, 0xe5, 0x61, 0x13, 0x9f, 0x21,
+	0x6e, 0x07, 0xf7, 0xa0, 0x43, 0x06, 0xe6, 0x7e, 0x00, 0xed, 0x4c, 0xb0, 0x29, 0x1f, 0x39, 0x74,
+	0x62, 0xa0, 0x4e, 0xd0, 0x6e, 0x28, 0xb7, 0x82, 0xdf, 0xb9, 0x00, 0x84, 0x18, 0xb3, 0xd3, 0xfd,
+	0x27, 0x18, 0x02, 0x79, 0x34, 0x65, 0xe4, 0x91, 0x5e, 0x48, 0x6b, 0x7f, 0x0b, 0xbc, 0xaf, 0xc2,
+	0x2f, 0xc8, 0x0f, 0xbd, 0x10, 0x97, 0x68, 0x4a, 0x96, 0xc7, 0x45, 0xc2, 0xc8, 0x01, 0xbd, 0x50,
+	0x41, 0x68, 0x8c, 0x8c, 0xcb, 0xc7, 0x40, 0xf6, 0xef, 0x86, 0x35, 0xec, 0x07, 0x30, 0x98, 0x44,
+	0x5c, 0x8c, 0xd9, 0xe9, 0x8c, 0xe5, 0x31, 0x53, 0x4e, 0xb0, 0x70, 0x68, 0x30, 0x84, 0x95, 0xc1,
+	0xa4, 0x2f, 0x0c, 0x8c, 0x7f, 0x1b, 0x86, 0x08, 0x91, 0xbc, 0x64, 0xf1, 0x0d, 0x62, 0x6f, 0x23,
+	0x31, 0xb0, 0xd8, 0x9c, 0xc5, 0xac, 0xe2, 0xa3, 0xee, 0x8e, 0xb7, 0xdb, 0x0b, 0x35, 0xe8, 0x6f,
+	0x43, 0x3b, 0x4a, 0x92, 0x8a, 0x8f, 0x7a, 0x84, 0x97, 0x00, 0x4a, 0x3d, 0x29, 0x8e, 0x0f, 0xd1,
+	0x36, 0x23, 0xd8, 0xf1, 0x76, 0xdb, 0x61, 0x0d, 0x07, 0x8f, 0xa0, 0xdf, 0x58, 0x87, 0xfb, 0x1f,
+	0xd9, 0x16, 0xbd, 0x6e, 0x5a, 0x94, 0x48, 0xb4, 0x59, 0x7f, 0x0c, 0xfd, 0x57, 0x33, 0x9e, 0x8e,
+	0xd9, 0xe9, 0xd3, 0x48, 0x44, 0xeb, 0xcc, 0xca, 0xd9, 0x29, 0x99, 0xd5, 0x0b, 0x71, 0x89, 0x54,
+	0x49, 0x24, 0x22, 0x15, 0xd5, 0xb4, 0x0e, 0x1e, 0x00, 0xa8, 0x8b, 0x1e, 0xc7, 0x27, 0x97, 0xbb,
+	0x27, 0xf8, 0xad, 0x03, 0x5d, 0x2d, 0x12, 0x6e, 0xe7, 0xb3, 0xa9, 0x7a, 0x62, 0xb8, 0xf4, 0xef,
+	0x34, 0x07, 0xfa, 0x0f, 0xb6, 0x97, 0x54, 0x20, 0x47, 0x48, 0x71, 0xee, 0x42, 0x27, 0x61, 0x22,
+	0xca, 0x26, 0x24, 0x50, 0x13, 0x71, 0x44, 0xfa, 0x94, 0x76, 0x42, 0x45, 0x81, 0x86, 0xa9, 0x58,
+	0x51, 0x1d, 0x93, 0xdb, 0x1b, 0xc3, 0x84, 0x88, 0x7b, 0x76, 0xc6, 0x72, 0x11, 0xca, 0xfd, 0xe0,
+	0x7b, 0xd0, 0xd3, 0xac, 0xb8, 0xff, 0x4d, 0x68, 0x71, 0x76, 0xaa, 0xad, 0x79, 0x6d, 0x49, 0x94,
+	0x90, 0x36, 0x83, 0xcf, 0x94, 0x32, 0xaf, 0xb2, 0x04, 0x95, 0x29, 0xb3, 0x44, 0xa9, 0x8f, 0x4b,
+	0x8c, 0x71, 0x7a, 0xac, 0x4a, 0x9d, 0xa5, 0x18, 0xa7, 0xad, 0xe0, 0x13, 0x18, 0x18, 0x32, 0x73,
+	0x7f, 0xd7, 0xf6, 0xe2, 0x2a, 0xbd, 0x94, 0x1b, 0xf7, 0x60, 0x43, 0x86, 0x2f, 0xca, 0x6a, 0x1d,
+	0x1a, 0xaa, 0x43, 0x72, 0x5b, 0xd3, 0x1f, 0x00, 0x28, 0xfa, 0xd5, 0xd2, 0xee, 0xc2, 0x46, 0x2a,
+	0xf7, 0x95, 0xbc, 0x9b, 0xd6, 0x35, 0x3c, 0xd4, 0xdb, 0x41, 0x0a, 0x43, 0x92, 0xe7, 0xcb, 0x33,
+	0x56, 0x9d, 0x65, 0xec, 0xad, 0xff, 0x21, 0xb4, 0x70, 0x8f, 0x6e, 0x3b, 0xc7, 0x9e, 0xb6, 0xcc,
+	0xcc, 0xee, 0xda, 0x99, 0xfd, 0x26, 0x74, 0x65, 0x8e, 0x64, 0x7c, 0xe4, 0xed, 0x78, 0x98, 0xa5,
+	0x34, 0x1c, 0xfc, 0xd1, 0x81, 0xbe, 0xa1, 0x7a, 0x63, 0x51, 0x67, 0xad, 0x45, 0xfd, 0x3d, 0xe8,
+	0x56, 0x2c, 0x66, 0x59, 0x29, 0x50, 0x11, 0xd3, 0x88, 0xa1, 0x44, 0x63, 0xd4, 0x87, 0x35, 0x8d,
+	0xff, 0x01, 0xb8, 0x2f, 0x5e, 0x8f, 0x3c, 0xcb, 0xcd, 0x2f, 0xd8, 0xe2, 0x75, 0x34, 0x99, 0xb1,
+	0xd0, 0x7d, 0xf1, 0xda, 0xbf, 0x03, 0x9b, 0x65, 0xc5, 0xce, 0xc6, 0x22, 0x12, 0x33, 0x6e, 0xe4,
+	0xef, 0x25, 0x6c, 0xf0, 0x08, 0xba, 0xa1, 0xbe, 0xf4, 0xae, 0x21, 0x84, 0x74, 0xca, 0xa6, 0x2d,
+	0x44, 0x23, 0x40, 0xb0, 0x0b, 0xbe, 0x42, 0xee, 0xa7, 0x2c, 0x3e, 0x39, 0x9c, 0x7f, 0x91, 0x71,
+	0x2a, 0x78, 0xac, 0xaa, 0xe4, 0xe9, 0x5e, 0x48, 0xeb, 0x60, 0x01, 0xfd, 0x7d, 0x6c, 0x03, 0x24,
+	0x53, 0x4c, 0x39, 0xf1, 0xac, 0xa2, 0xd2, 0x23, 0xb3, 0x92, 0x7c, 0x48, 0x36, 0xd2, 0xdf, 0x81,
+	0xfe, 0x94, 0x4d, 0xcb, 0xa2, 0x98, 0x8c, 0xb3, 0xaf, 0x99, 0xb2, 0xbe, 0x89, 0xc2, 0xf4, 0x37,
+	0xe5, 0xc7, 0x3f, 0x9d, 0xb1, 0x19, 0x23, 0x12, 0x4f, 0xa6, 0x3f, 0x13, 0x17, 0x44, 0xd0, 0x0b,
+	0xd9, 0xa9, 0x4a, 0xde, 0xdb, 0xd0, 0xe6, 0x22, 0xaa, 0x34, 0x43, 0x09, 0x60, 0x48, 0xb1, 0x3c,
+	0xd1, 0x8f, 0x9d, 0xe5, 0x89, 0xcc, 0xb9, 0x4f, 0x9b, 0x77, 0xda, 0x0d, 0x6b, 0x58, 0x07, 0x60,
+	0x8b, 0xd4, 0xc3, 0x65, 0xf0, 0x21, 0xf4, 0x7f, 0x62, 0x48, 0xe5, 0x43, 0x8b, 0xa3, 0x34, 0x92,
+	0x07, 0xad, 0x83, 0xbb, 0xb0, 0x15, 0xb2, 0x72, 0xb2, 0x90, 0x09, 0x55, 0xea, 0xd7, 0xd4, 0x4e,
+	0xc7, 0xac, 0x9d, 0xc1, 0xdf, 0x1d, 0xf5, 0x9c, 0x9f, 0x14, 0xc9, 0x42, 0xd7, 0x27, 0xe7, 0x9d,
+	0xf5, 0xe9, 0xca, 0xb1, 0x63, 0x56, 0x58, 0xef, 0x9d, 0x15, 0xb6, 0x75, 0xae, 0xc2, 0xea, 0x8e,
+	0xa6, 0x6d, 0x74, 0x34, 0x8d, 0x2e, 0x1d, 0x4b, 0x97, 0x5f, 0xa8, 0x2c, 0xa1, 0xa4, 0xb0, 0xe4,
+	0x74, 0x2e, 0x21, 0xa7, 0xe6, 0xe5, 0xae, 0xe4, 0xe5, 0x59, 0xbc, 0xee, 0x01, 0x3c, 0xe7, 0xfb,
+	0xd1, 0xec, 0x38, 0x15, 0x5f, 0x95, 0xa8, 0xc5, 0x73, 0x1e, 0x13, 0x34, 0x2b, 0xc9, 0xc2, 0xdd,
+	0xd0, 0xc0, 0x04, 0x9f, 0xc0, 0xe6, 0x73, 0xfe, 0x52, 0x94, 0xfb, 0x94, 0x18, 0x17, 0x79, 0x8c,
+	0xcf, 0x25, 0xe3, 0xb9, 0x28, 0x63, 0xc4, 0xf0, 0x45, 0x1e, 0xab, 0x53, 0x4b, 0xd8, 0xe0, 0x0f,
+	0x0e, 0x0c, 0x29, 0x9a, 0x9f, 0xcd, 0x59, 0x3c, 0x13, 0x45, 0x85, 0x12, 0x25, 0x55, 0x76, 0xc6,
+	0x2a, 0x95, 0x96, 0x14, 0x84, 0x56, 0x3e, 0x9a, 0xe5, 0xf1, 0x4b, 0xac, 0x2e, 0xb2, 0xd2, 0xd7,
+	0xb0, 0xdd, 0x57, 0x79, 0xcb, 0x7d, 0xd5, 0x36, 0xb4, 0xcb, 0xa8, 0x8a, 0xa6, 0xea, 0xc5, 0x4a,
+	0x00, 0xb1, 0x6c, 0x2e, 0xaa, 0x48, 0x99, 0x5e, 0x02, 0x6b, 0x6d, 0xff, 0x31, 0x0c, 0xad, 0x02,
+	0x84, 0xc6, 0x24, 0x6e, 0x8e, 0x34, 0x26, 0x31, 0xf2, 0xa1, 0x85, 0x45, 0x59, 0x05, 0x3f, 0xad,
+	0x83, 0x1f, 0xc2, 0xa6, 0x75, 0x10, 0xb3, 0x82, 0x95, 0xa7, 0x57, 0xd7, 0x37, 0x95, 0xae, 0x8f,
+	0xa0, 0x7b, 0x55, 0x8e, 0x68, 0x28, 0xae, 0xce, 0x28, 0xa7, 0xd6, 0xb0, 0xa1, 0x5e, 0xcb, 0x52,
+	0xef, 0xe7, 0x70, 0x83, 0x9e, 0xd4, 0xe3, 0x24, 0xc1, 0x2e, 0x21, 0x9a, 0x4c, 0xde, 0x44, 0xb2,
+	0x9a, 0x67, 0xfc, 0xcb, 0x13, 0xe5, 0x3b, 0x5a, 0xe3, 0x93, 0x9d, 0xf2, 0x63, 0x15, 0x44, 0xb8,
+	0xac, 0x8b, 0xa4, 0x9d, 0x3d, 0x6b, 0x55, 0x64, 0x91, 0xfc, 0xa5, 0x03, 0xdb, 0xaf, 0xa2, 0x2a,
+	0x22, 0x67, 0x9b, 0xd9, 0xfc, 0xfb, 0xd0, 0xa7, 0x94, 0xad, 0x2a, 0xb9, 0xb3, 0xb6, 0x92, 0x9b,
+	0x64, 0x96, 0x92, 0xee, 0x79, 0x25, 0x33, 0x8e, 0x51, 0xa8, 0xd2, 0x8d, 0x82, 0x82, 0x4f, 0x61,
+	0x88, 0x12, 0x1c, 0xce, 0x75, 0x99, 0xfd, 0xb6, 0xed, 0x89, 0x1b, 0x8a, 0xa9, 0x49, 0xa4, 0x1d,
+	0xf1, 0x37, 0x07, 0x06, 0x26, 0x1e, 0x4d, 0x83, 0xd4, 0x3a, 0x31, 0xe1, 0xda, 0xff, 0x16, 0x5a,
+	0x97, 0x7a, 0x4b, 0x77, 0x55, 0x0d, 0x54, 0x9b, 0xfe, 0x77, 0xa1, 0x27, 0xb4, 0x0c, 0x4b, 0x46,
+	0xab, 0xd9, 0x36, 0x14, 0x18, 0xdc, 0x71, 0x9a, 0x4d, 0x12, 0x73, 0x68, 0xa8, 0x11, 0x18, 0xc6,
+	0x59, 0x9e, 0xb0, 0x39, 0x85, 0xf1, 0x30, 0x94, 0x00, 0x9a, 0xa0, 0xac, 0x8a, 0xe2, 0x88, 0x8f,
+	0x3a, 0x54, 0x4c, 0x15, 0x14, 0xfc, 0xca, 0x81, 0x6e, 0xad, 0x42, 0x7d, 0xd4, 0x31, 0x8f, 0x06,
+	0xe0, 0x8a, 0xf9, 0xc8, 0xb5, 0xdc, 0x60, 0xa6, 0x48, 0x57, 0xcc, 0xfd, 0x7b, 0xb0, 0xa1, 0xb2,
+	0xca, 0x52, 0xe7, 0x65, 0x26, 0x1e, 0x4d, 0x62, 0x08, 0xd3, 0xb2, 0x84, 0x39, 0xc2, 0x3c, 0x7e,
+	0x2a, 0xad, 0xfa, 0x64, 0x71, 0x98, 0x89, 0x09, 0xbb, 0x74, 0x51, 0xd9, 0x86, 0xb6, 0xc0, 0x03,
+	0xaa, 0xbf, 0x97, 0x00, 0x69, 0xc4, 0xc7, 0xec, 0x54, 0xf5, 0xf6, 0x12, 0x08, 0xce, 0x00, 0x3e,
+	0xcf, 0x26, 0x4c, 0xb5, 0xf9, 0x3b, 0xd0, 0xa7, 0x4b, 0xad, 0x6a, 0x69, 0xa2, 0x8c, 0x0c, 0xe4,
+	0x5a, 0x19, 0x68, 0x35, 0x4f, 0xec, 0x69, 0x18, 0x17, 0x2f, 0x99, 0x50, 0x5c, 0x35, 0x88, 0xad,
+	0xc0, 0xb3, 0x3c, 0x91, 0xb3, 0xe4, 0x9a, 0xfa, 0xb4, 0x2a, 0x27, 0x07, 0xff, 0x74, 0xe0, 0x5a,
+	0xc8, 0xc8, 0x1b, 0xaf, 0xaa, 0xe2, 0xb8, 0x62, 0x9c, 0x23, 0x97, 0x6a, 0x96, 0xe7, 0x59, 0x7e,
+	0xac, 0x1e, 0xa3, 0x06, 0xcd, 0x29, 0x55, 0x8a, 0xab, 0x41, 0x92, 0xb7, 0x10, 0xd1, 0x44, 0x65,
+	0x01, 0x09, 0x50, 0x0f, 0x5f, 0xe4, 0x4c, 0x25, 0x00, 0x5a, 0xe3, 0x1d, 0x6f, 0x8b, 0xea, 0x04,
+	0xbb, 0x3e, 0x0c, 0xa3, 0x76, 0xa8, 0x41, 0xdc, 0x61, 0x93, 0xa8, 0xe4, 0x2c, 0x51, 0x09, 0x51,
+	0x83, 0xfe, 0x77, 0xa0, 0xc3, 0xd3, 0xa8, 0x4a, 0xf4, 0xb8, 0x77, 0xa3, 0x0e, 0x01, 0x92, 0x7c,
+	0x8c, 0x7b, 0xa1, 0x22, 0x09, 0x7e, 0xe3, 0xc0, 0xf0, 0x71, 0x15, 0xa7, 0xd9, 0x19, 0x5b, 0xfd,
+	0x29, 0xa2, 0x6d, 0x8b, 0x4d, 0x66, 0x76, 0xd7, 0x98, 0xd9, 0xb3, 0xcc, 0x8c, 0xa9, 0x20, 0x2e,
+	0xa6, 0x25, 0x9a, 0x89, 0x94, 0xea, 0x85, 0x35, 0xbc, 0xec, 0xec, 0xf6, 0x39, 0x67, 0x07, 0xbf,
+	0x76, 0xa0, 0x27, 0x45, 0xfa, 0xef, 0x66, 0x91, 0xe6, 0xed, 0x7b, 0xef, 0x7a, 0xfb, 0x97, 0x1e,
+	0x43, 0x1e, 0xe8, 0x46, 0x9d, 0xe6, 0x90, 0xdb, 0xd6, 0x1c, 0xb2, 0x65, 0xdd, 0xdd, 0x0c, 0x22,
+	0x7f, 0x75, 0xa0, 0xf7, 0x79, 0x36, 0x11, 0xff, 0x23, 0x5d, 0xee, 0xca, 0x6e, 0xaa, 0x45, 0x32,
+	0x8d, 0xce, 0xa7, 0x0a, 0x95, 0xca, 0x90, 0xa8, 0xd1, 0xbb, 0x7d, 0xb1, 0xde, 0xb5, 0x0a, 0xeb,
+	0xf4, 0xae, 0x09, 0x94, 0xde, 0xff, 0x70, 0x00, 0xa4, 0x3b, 0x31, 0x99, 0xac, 0x7d, 0x6b, 0xab,
+	0x03, 0x4b, 0xbf, 0x40, 0xcf, 0xe8, 0x8a, 0xde, 0x9d, 0x72, 0x6f, 0x01, 0x50, 0x04, 0x3d, 0xaf,
+	0xf3, 0x6e, 0x3b, 0x34, 0x30, 0xd8, 0xfb, 0xd4, 0xc4, 0x92, 0xa6, 0x43, 0x09, 0x76, 0x09, 0x6b,
+	0x4e, 0x43, 0x1b, 0xf2, 0x09, 0x28, 0x10, 0x87, 0xfa, 0x46, 0x9f, 0xb5, 0x43, 0x7d, 0x43, 0xa2,
+	0xab, 0xd4, 0xd7, 0x00, 0xfb, 0xc8, 0x83, 0x8a, 0x6c, 0xa3, 0xaf, 0x63, 0xea, 0x6b, 0x4b, 0xef,
+	0x9e, 0x93, 0xde, 0xd2, 0xdd, 0x5b, 0xd6, 0xdd, 0x90, 0xb9, 0x65, 0xcb, 0x2c, 0x28, 0x9b, 0x4b,
+	0x99, 0x74, 0x36, 0xbf, 0x9a, 0x27, 0xb6, 0xa1, 0x1d, 0xd3, 0xcd, 0x1e, 0xdd, 0x2c, 0x01, 0x94,
+	0x27, 0xc9, 0x2a, 0x46, 0x11, 0xa5, 0x78, 0x36, 0x88, 0x20, 0xc4, 0xb1, 0xa9, 0x9c, 0x2c, 0x6c,
+	0xbe, 0xab, 0x35, 0xbf, 0xa3, 0xcd, 0xe8, 0x5a, 0xd1, 0x44, 0x0f, 0xe0, 0x79, 0x7e, 0x54, 0x68,
+	0x2b, 0x7e, 0x0c, 0xbd, 0x1a, 0x77, 0xa5, 0xc4, 0xfd, 0x23, 0xb8, 0x6e, 0x14, 0xb4, 0x83, 0x5a,
+	0xd7, 0xc6, 0x79, 0x9e, 0xe2, 0xb1, 0xda, 0x02, 0xc1, 0x01, 0x74, 0xf7, 0xa7, 0xa5, 0xac, 0x18,
+	0x97, 0x99, 0x72, 0x47, 0xb0, 0x11, 0x4f, 0x4b, 0xe3, 0x23, 0xa4, 0x06, 0x83, 0x3f, 0x3b, 0xe4,
+	0x8e, 0x71, 0x1e, 0x95, 0x3c, 0x2d, 0xc4, 0x7e, 0x3a, 0xcb, 0xd7, 0x17, 0xa1, 0xa5, 0x2c, 0xe9,
+	0x9e, 0x2f, 0x89, 0xef, 0x43, 0x8f, 0xe5, 0xc9, 0x81, 0x39, 0x29, 0x34, 0x08, 0xdc, 0x7d, 0x9b,
+	0x89, 0x94, 0x44, 0x53, 0x45, 0xb0, 0x41, 0x10, 0x57, 0x39, 0xd8, 0xb7, 0x65, 0xf9, 0x97, 0x90,
+	0x9e, 0xfd, 0x3a, 0xf5, 0xc7, 0x87, 0xe0, 0x11, 0x0c, 0xb4, 0xc0, 0x2f, 0xf1, 0x6b, 0x9d, 0xb6,
+	0xb1, 0x63, 0x3c, 0x4d, 0xfd, 0x09, 0xca, 0x35, 0x3e, 0x41, 0x7d, 0x0a, 0x43, 0xf3, 0x1c, 0x35,
+	0x76, 0x39, 0x2e, 0x96, 0x1a, 0x3b, 0x93, 0x28, 0x94, 0x14, 0xc1, 0xef, 0x1d, 0x18, 0x5e, 0xce,
+	0x4a, 0x1f, 0x99, 0x9f, 0x46, 0x56, 0x7c, 0x61, 0xd1, 0xbb, 0x8d, 0xe7, 0xbc, 0xf5, 0x9e, 0xab,
+	0x25, 0x6c, 0x5d, 0x28, 0xe1, 0x53, 0x15, 0xe2, 0xb6, 0x8a, 0x23, 0xd8, 0x98, 0x66, 0x9c, 0xcb,
+	0x86, 0x00, 0xcd, 0xaa, 0x41, 0x6a, 0xa1, 0xa2, 0x33, 0x96, 0x90, 0x94, 0x83, 0x50, 0x02, 0xc1,
+	0x0f, 0x60, 0x88, 0xf1, 0x20, 0x22, 0xc1, 0x5e, 0x61, 0xfb, 0xb5, 0x56, 0xcd, 0x2d, 0xf0, 0x4e,
+	0xd8, 0x42, 0xf7, 0xf7, 0x27, 0x6c, 0x81, 0xad, 0x3b, 0x5c, 0xe2, 0xa0, 0x35, 0x84, 0xb9, 0xcb,
+	0x43, 0x98, 0xba, 0xd6, 0xab, 0xaf, 0x45, 0x39, 0xcf, 0xf0, 0xf3, 0x8a, 0x1e, 0xcb, 0x08, 0x40,
+	0x2c, 0xb5, 0x87, 0x7a, 0x2c, 0x23, 0x20, 0x48, 0x61, 0xa0, 0xbf, 0xc6, 0x3c, 0xcd, 0x8e, 0x8e,
+	0xf4, 0x6d, 0x4e, 0x73, 0xdb, 0x26, 0xb8, 0x62, 0xa1, 0xd2, 0x99, 0x2b, 0x3f, 0x7b, 0x17, 0x93,
+	0x84, 0x4e, 0xe8, 0xa1, 0x5c, 0xc3, 0xb8, 0x97, 0xb3, 0xb7, 0xaf, 0x0d, 0xe6, 0x35, 0xac, 0xde,
+	0xb0, 0x2c, 0x8a, 0x28, 0x3c, 0xb1, 0xbb, 0x4a, 0x12, 0x78, 0x0d, 0x03, 0x6d, 0x68, 0x3a, 0x1b,
+	0xc0, 0xa0, 0x98, 0x24, 0xe3, 0xda, 0x32, 0x52, 0x66, 0x0b, 0x87, 0x34, 0x39, 0x7b, 0x3b, 0x5e,
+	0xb2, 0x9e, 0x85, 0x0b, 0xfe, 0xe2, 0x40, 0xef, 0x62, 0x89, 0xf4, 0xbf, 0x02, 0xd3, 0x09, 0x35,
+	0xe2, 0x9c, 0x2c, 0xde, 0x25, 0x64, 0x69, 0x9d, 0x97, 0xa5, 0x19, 0x9c, 0xda, 0x56, 0xf4, 0x9a,
+	0x2e, 0xd2, 0xc9, 0xf4, 0xdf, 0x0e, 0x40, 0x53, 0xe5, 0xed, 0x99, 0xc3, 0xd3, 0x33, 0xc7, 0x2d,
+	0x80, 0xa3, 0xa2, 0x3a, 0xb1, 0xf2, 0x8f, 0x81, 0xa1, 0xd9, 0x1f, 0x21, 0xe3, 0x0b, 0x8b, 0x86,
+	0xb1, 0xda, 0xe2, 0x4c, 0x18, 0xa7, 0x2c, 0x51, 0xdf, 0x0f, 0xe5, 0x94, 0xb1, 0x84, 0x45, 0xba,
+	0x48, 0x58, 0x74, 0x32, 0x1d, 0x2d, 0x61, 0x51, 0xc2, 0x84, 0x95, 0x22, 0x55, 0xfd, 0xae, 0x04,
+	0x68, 0xdc, 0xc3, 0xdf, 0x2f, 0x1b, 0x6a, 0xdc, 0xc3, 0x3f, 0x2f, 0xe6, 0x0c, 0xda, 0xb5, 0x67,
+	0xd0, 0xe0, 0x0e, 0x6c, 0x86, 0xec, 0xb4, 0x51, 0x9c, 0x37, 0xd5, 0x4d, 0x69, 0x1e, 0xeb, 0x4a,
+	0x6f, 0x12, 0xad, 0xa9, 0xf4, 0x66, 0x9b, 0x24, 0xcd, 0x3a, 0x85, 0x6b, 0x54, 0xe4, 0xe9, 0x63,
+	0x61, 0x59, 0x64, 0xb9, 0xb8, 0x4a, 0x90, 0xda, 0x3f, 0x76, 0xbc, 0x8b, 0x7f, 0x8a, 0x7d, 0x06,
+	0x5b, 0x4b, 0xec, 0xb8, 0x7f, 0xcf, 0x96, 0xf5, 0x3d, 0x75, 0x7e, 0x89, 0x4e, 0x09, 0xfc, 0xe4,
+	0x83, 0x9f, 0x7d, 0xe3, 0x38, 0x13, 0xe9, 0xec, 0xcd, 0x5e, 0x5c, 0x4c, 0xef, 0x3f, 0x7c, 0x18,
+	0xe7, 0xf7, 0xe9, 0x67, 0xe6, 0xc3, 0x87, 0xf7, 0xe9, 0xdc, 0x9b, 0x0e, 0xfd, 0xad, 0x7c, 0xf8,
+	0x9f, 0x01, 0x00, 0xb1, 0x39, 0x9a, 0xcc, 0xe9, 0x1c, 0x00, 0x00,
 }
@@ -73,6 +73,34 @@ type Mempool struct {
 	MaxTxFeeRate int64 `protobuf:"varint,9,opt,name=maxTxFeeRate" json:"maxTxFeeRate,omitempty"`
 	// 单笔最大交易费, 默认1e9
 	MaxTxFee int64 `protobuf:"varint,10,opt,name=maxTxFee" json:"maxTxFee,omitempty"`
+	// 替换交易时手续费需要比原交易高出的百分比, 默认10
+	ReplaceFeeBump int64 `protobuf:"varint,11,opt,name=replaceFeeBump" json:"replaceFeeBump,omitempty"`
+	// 交易记录的保存路径, 设置后重启时恢复mempool中的交易, 默认不保存
+	JournalPath string `protobuf:"bytes,12,opt,name=journalPath" json:"journalPath,omitempty"`
+	// 每个账户的交易配额, 默认不限制
+	AccountQuota *MempoolQuota `protobuf:"bytes,13,opt,name=accountQuota" json:"accountQuota,omitempty"`
+	// 每个执行器的交易配额, 默认不限制
+	ExecQuota *MempoolQuota `protobuf:"bytes,14,opt,name=execQuota" json:"execQuota,omitempty"`
+	// 每个来源节点的交易配额, 默认不限制
+	PeerQuota *MempoolQuota `protobuf:"bytes,15,opt,name=peerQuota" json:"peerQuota,omitempty"`
+	// 等待生效的交易最大数量, 默认1024
+	MaxScheduledTxs int64 `protobuf:"varint,16,opt,name=maxScheduledTxs" json:"maxScheduledTxs,omitempty"`
+	// 每个账户等待生效的交易最大数量, 默认10
+	MaxScheduledTxsPerAccount int64 `protobuf:"varint,17,opt,name=maxScheduledTxsPerAccount" json:"maxScheduledTxsPerAccount,omitempty"`
+	// 交易的生效高度最多比当前高度高出的区块数, 默认100
+	MaxNotBeforeHeight int64 `protobuf:"varint,18,opt,name=maxNotBeforeHeight" json:"maxNotBeforeHeight,omitempty"`
+	// 交易的生效时间最多比当前时间晚的秒数, 默认600, 等待区中的交易超过600秒没有生效会被删除
+	MaxNotBeforeTime int64 `protobuf:"varint,19,opt,name=maxNotBeforeTime" json:"maxNotBeforeTime,omitempty"`
+}
+
+// MempoolQuota mempool交易配额, 为0的项不限制
+type MempoolQuota struct {
+	// 在mempool中的最大交易数量
+	MaxTxCount int64 `protobuf:"varint,1,opt,name=maxTxCount" json:"maxTxCount,omitempty"`
+	// 在mempool中的交易总字节数
+	MaxTxBytes int64 `protobuf:"varint,2,opt,name=maxTxBytes" json:"maxTxBytes,omitempty"`
+	// 每秒最多接收的交易数量
+	MaxTxPerSecond int64 `protobuf:"varint,3,opt,name=maxTxPerSecond" json:"maxTxPerSecond,omitempty"`
 }
 
 // Consensus 配置
@@ -91,6 +119,10 @@ type Consensus struct {
 	MinerExecs []string `protobuf:"bytes,7,rep,name=minerExecs" json:"minerExecs,omitempty"`
 	// 最优区块选择
 	EnableBestBlockCmp bool `protobuf:"bytes,8,rep,name=enableBestBlockCmp" json:"enableBestBlockCmp,omitempty"`
+	// 打包区块的最大字节数, 为0或者超过链的限制时使用链的限制
+	MaxBlockBytes int64 `protobuf:"varint,9,opt,name=maxBlockBytes" json:"maxBlockBytes,omitempty"`
+	// 打包区块的最大交易数目, 为0或者超过链的限制时使用链的限制
+	MaxBlockTxs int64 `protobuf:"varint,10,opt,name=maxBlockTxs" json:"maxBlockTxs,omitempty"`
 }
 
 // Wallet 配置
@@ -158,6 +190,28 @@ type BlockChain struct {
 	OnChainTimeout int64 `protobuf:"varint,17,opt,name=onChainTimeout" json:"onChainTimeout,omitempty"`
 	// 使能精简localdb
 	EnableReduceLocaldb bool `protobuf:"varint,18,opt,name=enableReduceLocaldb" json:"enableReduceLocaldb,omitempty"`
+	// 使能快照同步，新节点从其他节点获取检查点高度的状态快照，之后只同步检查点之后的区块
+	EnableSnapshotSync bool `protobuf:"varint,19,opt,name=enableSnapshotSync" json:"enableSnapshotSync,omitempty"`
+	// 快照检查点高度
+	SnapshotHeight int64 `protobuf:"varint,20,opt,name=snapshotHeight" json:"snapshotHeight,omitempty"`
+	// 快照检查点区块hash，必须配置可信的hash，同步的区块头链从这个hash开始倒序校验
+	SnapshotHash string `protobuf:"bytes,21,opt,name=snapshotHash" json:"snapshotHash,omitempty"`
+	// 使能轻节点，只同步和保存区块头，通过检查点锚定的区块头校验交易和状态的证明
+	EnableLightNode bool `protobuf:"varint,22,opt,name=enableLightNode" json:"enableLightNode,omitempty"`
+	// 区块裁剪模式，archive:保存所有区块数据，full:默认模式，pruned:只保留最近的区块数据
+	PruneMode string `protobuf:"bytes,23,opt,name=pruneMode" json:"pruneMode,omitempty"`
+	// pruned模式下保留的区块个数，不能小于最大回滚高度
+	PruneRetainBlocks int64 `protobuf:"varint,24,opt,name=pruneRetainBlocks" json:"pruneRetainBlocks,omitempty"`
+	// 最大允许回滚的区块数，0表示不限制
+	MaxReorgDepth int64 `protobuf:"varint,25,opt,name=maxReorgDepth" json:"maxReorgDepth,omitempty"`
+	// 内置的检查点，格式为height:hash，主链在检查点高度的区块必须与之一致
+	Checkpoints []string `protobuf:"bytes,26,rep,name=checkpoints" json:"checkpoints,omitempty"`
+	// 运行时添加检查点的签名公钥
+	CheckpointSigners []string `protobuf:"bytes,27,rep,name=checkpointSigners" json:"checkpointSigners,omitempty"`
+	// 重建索引的并行数，0表示使用cpu核数
+	ReindexWorkers int32 `protobuf:"varint,28,opt,name=reindexWorkers" json:"reindexWorkers,omitempty"`
+	// 文件方式推送seq时数据文件所在的目录，callback的URL只能使用此目录中的相对路径，为空时不能使用文件推送
+	PushFileDir string `protobuf:"bytes,29,opt,name=pushFileDir" json:"pushFileDir,omitempty"`
 }
 
 // P2P 配置
@@ -237,6 +291,8 @@ type Exec struct {
 	Alias            []string `protobuf:"bytes,5,rep,name=alias" json:"alias,omitempty"`
 	// 是否保存token交易信息
 	SaveTokenTxList bool `protobuf:"varint,6,opt,name=saveTokenTxList" json:"saveTokenTxList,omitempty"`
+	// 并行执行区块中交易的线程数, 小于2时顺序执行
+	ParallelWorkers int32 `protobuf:"varint,8,opt,name=parallelWorkers" json:"parallelWorkers,omitempty"`
 }
 
 // Pprof 配置
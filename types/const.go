@@ -126,6 +126,23 @@ const (
 	ExecOk   = 2
 )
 
+//state diff type
+const (
+	StateDiffAdd    = 1
+	StateDiffModify = 2
+	StateDiffDelete = 3
+)
+
+//mempool中交易的状态变化事件
+const (
+	TxEventAccepted = 1
+	TxEventRejected = 2
+	TxEventEvicted  = 3
+	TxEventExpired  = 4
+	TxEventReplaced = 5
+	TxEventIncluded = 6
+)
+
 // TODO 后续调试确认放的位置
 //func init() {
 //	S("TxHeight", false)
@@ -79,6 +79,17 @@ var (
 	ErrEmptyTx                    = errors.New("ErrEmptyTx")
 	ErrTxFeeTooLow                = errors.New("ErrTxFeeTooLow")
 	ErrTxFeeTooHigh               = errors.New("ErrTxFeeTooHigh")
+	ErrReplaceFeeTooLow           = errors.New("ErrReplaceFeeTooLow")
+	ErrTxReplaced                 = errors.New("ErrTxReplaced")
+	ErrQuotaTxCount               = errors.New("ErrQuotaTxCount")
+	ErrQuotaTxBytes               = errors.New("ErrQuotaTxBytes")
+	ErrQuotaTxRate                = errors.New("ErrQuotaTxRate")
+	ErrTxNotMature                = errors.New("ErrTxNotMature")
+	ErrTxNotBeforeNotSupport      = errors.New("ErrTxNotBeforeNotSupport")
+	ErrTxReplaceNotSupport        = errors.New("ErrTxReplaceNotSupport")
+	ErrScheduledTxFull            = errors.New("ErrScheduledTxFull")
+	ErrTxNotBeforeTooFar          = errors.New("ErrTxNotBeforeTooFar")
+	ErrOutOfGas                   = errors.New("ErrOutOfGas")
 	ErrTxMsgSizeTooBig            = errors.New("ErrTxMsgSizeTooBig")
 	ErrFutureBlock                = errors.New("ErrFutureBlock")
 	ErrHashNotFound               = errors.New("ErrHashNotFound")
@@ -195,4 +206,30 @@ var (
 	ErrMaxCountPerTime   = errors.New("ErrMaxCountPerTime")
 	ErrInValidFileHeader = errors.New("ErrInValidFileHeader")
 	ErrFileExists        = errors.New("ErrFileExists")
+
+	ErrSnapshotHeaders    = errors.New("ErrSnapshotHeaders")
+	ErrSnapshotBlock      = errors.New("ErrSnapshotBlock")
+	ErrSnapshotNode       = errors.New("ErrSnapshotNode")
+	ErrSnapshotNoPeer     = errors.New("ErrSnapshotNoPeer")
+	ErrSnapshotUnfinished = errors.New("ErrSnapshotUnfinished")
+	ErrSnapshotNoHash     = errors.New("ErrSnapshotNoHash")
+	ErrLightUnanchored    = errors.New("ErrLightUnanchored")
+
+	ErrLightNode      = errors.New("ErrLightNode")
+	ErrInvalidHeaders = errors.New("ErrInvalidHeaders")
+	ErrTxProof        = errors.New("ErrTxProof")
+	ErrStateProof     = errors.New("ErrStateProof")
+
+	ErrBlockPruned = errors.New("ErrBlockPruned")
+
+	ErrReorgTooDeep       = errors.New("ErrReorgTooDeep")
+	ErrCheckpointMismatch = errors.New("ErrCheckpointMismatch")
+	ErrCheckpointSign     = errors.New("ErrCheckpointSign")
+
+	ErrPushSeqAckTimeout = errors.New("ErrPushSeqAckTimeout")
+	ErrPushNotSubscribed = errors.New("ErrPushNotSubscribed")
+	ErrPushSeqScheme     = errors.New("ErrPushSeqScheme")
+	ErrPushSeqFilePath   = errors.New("ErrPushSeqFilePath")
+	ErrPushSubscribed    = errors.New("ErrPushSubscribed")
+	ErrTooManySubscriber = errors.New("ErrTooManySubscriber")
 )
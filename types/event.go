@@ -157,6 +157,63 @@ const (
 	EventGetParaTxByTitleAndHeight = 310
 	//比较当前区块和新广播的区块最优区块
 	EventCmpBestBlock = 311
+
+	//快照同步：获取/请求快照分片，读写mavl树节点
+	EventGetSnapshotChunk      = 312
+	EventFetchSnapshotChunk    = 313
+	EventStoreGetSnapshotNodes = 314
+	EventStoreSetSnapshotNodes = 315
+	EventStoreVerifySnapshot   = 316
+
+	//轻节点：校验交易和状态证明，获取状态证明以及本地保存的区块头
+	EventVerifyTxProof    = 317
+	EventVerifyStateProof = 318
+	EventGetStateProof    = 319
+	EventStoreGetProof    = 320
+	EventGetLightHeader   = 321
+
+	//链重组事件
+	EventGetReorgEvents = 322
+
+	//检查点
+	EventAddCheckpoint  = 323
+	EventGetCheckpoints = 324
+
+	//grpc订阅seq推送的数据以及确认
+	EventGetPushSeqData = 325
+	EventAckPushSeq     = 326
+
+	//重建索引的进度
+	EventGetReindexProgress = 327
+
+	//区块以及状态哈希之间的状态差异
+	EventGetBlockStateDiff = 328
+	EventStoreDiff         = 329
+
+	//订阅mempool中交易的状态变化
+	EventSubTxEvents   = 330
+	EventGetTxEvents   = 331
+	EventUnSubTxEvents = 332
+
+	//检查交易能否进入mempool，不加入mempool
+	EventCheckTxDryRun = 333
+
+	//查询，删除mempool中的交易以及统计
+	EventQueryMempoolTxs = 334
+	EventEvictMempoolTxs = 335
+	EventGetMempoolStats = 336
+
+	//获取mempool中还没有生效的交易
+	EventGetScheduledTxs = 337
+
+	//模拟执行交易，不修改状态
+	EventSimulateTx = 338
+
+	//跟踪交易的执行过程
+	EventTraceTx = 339
+
+	//快照同步失败时删除已经写入的mavl树节点
+	EventStoreDelSnapshotNodes = 340
 )
 
 var eventName = map[int]string{
@@ -296,5 +353,34 @@ var eventName = map[int]string{
 	EventReplyHeightByTitle:         "EventReplyHeightByTitle",
 	EventGetParaTxByTitleAndHeight:  "EventGetParaTxByTitleAndHeight",
 	EventCmpBestBlock:               "EventCmpBestBlock",
+	EventGetSnapshotChunk:           "EventGetSnapshotChunk",
+	EventFetchSnapshotChunk:         "EventFetchSnapshotChunk",
+	EventStoreGetSnapshotNodes:      "EventStoreGetSnapshotNodes",
+	EventStoreSetSnapshotNodes:      "EventStoreSetSnapshotNodes",
+	EventStoreVerifySnapshot:        "EventStoreVerifySnapshot",
+	EventVerifyTxProof:              "EventVerifyTxProof",
+	EventVerifyStateProof:           "EventVerifyStateProof",
+	EventGetStateProof:              "EventGetStateProof",
+	EventStoreGetProof:              "EventStoreGetProof",
+	EventGetLightHeader:             "EventGetLightHeader",
+	EventGetReorgEvents:             "EventGetReorgEvents",
+	EventAddCheckpoint:              "EventAddCheckpoint",
+	EventGetCheckpoints:             "EventGetCheckpoints",
+	EventGetPushSeqData:             "EventGetPushSeqData",
+	EventAckPushSeq:                 "EventAckPushSeq",
+	EventGetReindexProgress:         "EventGetReindexProgress",
+	EventGetBlockStateDiff:          "EventGetBlockStateDiff",
+	EventStoreDiff:                  "EventStoreDiff",
+	EventSubTxEvents:                "EventSubTxEvents",
+	EventGetTxEvents:                "EventGetTxEvents",
+	EventUnSubTxEvents:              "EventUnSubTxEvents",
+	EventCheckTxDryRun:              "EventCheckTxDryRun",
+	EventQueryMempoolTxs:            "EventQueryMempoolTxs",
+	EventEvictMempoolTxs:            "EventEvictMempoolTxs",
+	EventGetMempoolStats:            "EventGetMempoolStats",
+	EventGetScheduledTxs:            "EventGetScheduledTxs",
+	EventSimulateTx:                 "EventSimulateTx",
+	EventTraceTx:                    "EventTraceTx",
+	EventStoreDelSnapshotNodes:      "EventStoreDelSnapshotNodes",
 	EventUpgrade:                    "EventUpgrade",
 }
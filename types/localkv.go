@@ -22,6 +22,7 @@ var (
 	ConsensusParaTxsPrefix = []byte("LODBP:Consensus:Para:")            //存贮para共识模块从主链拉取的平行链交易
 	FlagReduceLocaldb      = []byte("FLAG:ReduceLocaldb")               // 精简版localdb标记
 	ReduceLocaldbHeight    = append(FlagReduceLocaldb, []byte(":H")...) // 精简版localdb高度
+	TxReplacedPrefix       = []byte("TxReplaced:")                      // 被已经上链的替换交易替换掉的交易
 )
 
 // GetLocalDBKeyList 获取localdb的key列表
@@ -53,6 +54,11 @@ func (c *Chain33Config) CalcTxKeyValue(txr *TxResult) []byte {
 	return Encode(txr)
 }
 
+//CalcTxReplacedKey 替换交易上链之后记录被替换的交易hash，value为替换交易的hash
+func CalcTxReplacedKey(hash []byte) []byte {
+	return append(TxReplacedPrefix, hash...)
+}
+
 //CalcTxShortKey local db中保存交易的方法
 func CalcTxShortKey(hash []byte) []byte {
 	return append(TxShortHashPerfix, hash[0:8]...)
@@ -40,7 +40,7 @@ func (m *P2PGetPeerInfo) Reset()         { *m = P2PGetPeerInfo{} }
 func (m *P2PGetPeerInfo) String() string { return proto.CompactTextString(m) }
 func (*P2PGetPeerInfo) ProtoMessage()    {}
 func (*P2PGetPeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{0}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{0}
 }
 func (m *P2PGetPeerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetPeerInfo.Unmarshal(m, b)
@@ -89,7 +89,7 @@ func (m *P2PPeerInfo) Reset()         { *m = P2PPeerInfo{} }
 func (m *P2PPeerInfo) String() string { return proto.CompactTextString(m) }
 func (*P2PPeerInfo) ProtoMessage()    {}
 func (*P2PPeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{1}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{1}
 }
 func (m *P2PPeerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PPeerInfo.Unmarshal(m, b)
@@ -172,7 +172,7 @@ func (m *P2PVersion) Reset()         { *m = P2PVersion{} }
 func (m *P2PVersion) String() string { return proto.CompactTextString(m) }
 func (*P2PVersion) ProtoMessage()    {}
 func (*P2PVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{2}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{2}
 }
 func (m *P2PVersion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PVersion.Unmarshal(m, b)
@@ -263,7 +263,7 @@ func (m *P2PVerAck) Reset()         { *m = P2PVerAck{} }
 func (m *P2PVerAck) String() string { return proto.CompactTextString(m) }
 func (*P2PVerAck) ProtoMessage()    {}
 func (*P2PVerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{3}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{3}
 }
 func (m *P2PVerAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PVerAck.Unmarshal(m, b)
@@ -324,7 +324,7 @@ func (m *P2PPing) Reset()         { *m = P2PPing{} }
 func (m *P2PPing) String() string { return proto.CompactTextString(m) }
 func (*P2PPing) ProtoMessage()    {}
 func (*P2PPing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{4}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{4}
 }
 func (m *P2PPing) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PPing.Unmarshal(m, b)
@@ -385,7 +385,7 @@ func (m *P2PPong) Reset()         { *m = P2PPong{} }
 func (m *P2PPong) String() string { return proto.CompactTextString(m) }
 func (*P2PPong) ProtoMessage()    {}
 func (*P2PPong) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{5}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{5}
 }
 func (m *P2PPong) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PPong.Unmarshal(m, b)
@@ -425,7 +425,7 @@ func (m *P2PGetAddr) Reset()         { *m = P2PGetAddr{} }
 func (m *P2PGetAddr) String() string { return proto.CompactTextString(m) }
 func (*P2PGetAddr) ProtoMessage()    {}
 func (*P2PGetAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{6}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{6}
 }
 func (m *P2PGetAddr) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetAddr.Unmarshal(m, b)
@@ -467,7 +467,7 @@ func (m *P2PAddr) Reset()         { *m = P2PAddr{} }
 func (m *P2PAddr) String() string { return proto.CompactTextString(m) }
 func (*P2PAddr) ProtoMessage()    {}
 func (*P2PAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{7}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{7}
 }
 func (m *P2PAddr) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PAddr.Unmarshal(m, b)
@@ -513,7 +513,7 @@ func (m *P2PAddrList) Reset()         { *m = P2PAddrList{} }
 func (m *P2PAddrList) String() string { return proto.CompactTextString(m) }
 func (*P2PAddrList) ProtoMessage()    {}
 func (*P2PAddrList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{8}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{8}
 }
 func (m *P2PAddrList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PAddrList.Unmarshal(m, b)
@@ -563,7 +563,7 @@ func (m *P2PExternalInfo) Reset()         { *m = P2PExternalInfo{} }
 func (m *P2PExternalInfo) String() string { return proto.CompactTextString(m) }
 func (*P2PExternalInfo) ProtoMessage()    {}
 func (*P2PExternalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{9}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{9}
 }
 func (m *P2PExternalInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PExternalInfo.Unmarshal(m, b)
@@ -612,7 +612,7 @@ func (m *P2PGetBlocks) Reset()         { *m = P2PGetBlocks{} }
 func (m *P2PGetBlocks) String() string { return proto.CompactTextString(m) }
 func (*P2PGetBlocks) ProtoMessage()    {}
 func (*P2PGetBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{10}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{10}
 }
 func (m *P2PGetBlocks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetBlocks.Unmarshal(m, b)
@@ -666,7 +666,7 @@ func (m *P2PGetMempool) Reset()         { *m = P2PGetMempool{} }
 func (m *P2PGetMempool) String() string { return proto.CompactTextString(m) }
 func (*P2PGetMempool) ProtoMessage()    {}
 func (*P2PGetMempool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{11}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{11}
 }
 func (m *P2PGetMempool) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetMempool.Unmarshal(m, b)
@@ -693,6 +693,145 @@ func (m *P2PGetMempool) GetVersion() int32 {
 	return 0
 }
 
+// mempool交易短哈希的摘要, 按照短哈希的第一个字节分成256个区间, 记录每个区间的交易数目和短哈希的异或值
+type P2PMempoolSketch struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Counts               []uint32 `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	Xors                 []uint64 `protobuf:"varint,3,rep,packed,name=xors,proto3" json:"xors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PMempoolSketch) Reset()         { *m = P2PMempoolSketch{} }
+func (m *P2PMempoolSketch) String() string { return proto.CompactTextString(m) }
+func (*P2PMempoolSketch) ProtoMessage()    {}
+func (*P2PMempoolSketch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_476920d3553a38c8, []int{12}
+}
+func (m *P2PMempoolSketch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PMempoolSketch.Unmarshal(m, b)
+}
+func (m *P2PMempoolSketch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PMempoolSketch.Marshal(b, m, deterministic)
+}
+func (dst *P2PMempoolSketch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PMempoolSketch.Merge(dst, src)
+}
+func (m *P2PMempoolSketch) XXX_Size() int {
+	return xxx_messageInfo_P2PMempoolSketch.Size(m)
+}
+func (m *P2PMempoolSketch) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PMempoolSketch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PMempoolSketch proto.InternalMessageInfo
+
+func (m *P2PMempoolSketch) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PMempoolSketch) GetCounts() []uint32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *P2PMempoolSketch) GetXors() []uint64 {
+	if m != nil {
+		return m.Xors
+	}
+	return nil
+}
+
+type P2PMempoolDiff struct {
+	ShortHashes          []string `protobuf:"bytes,1,rep,name=shortHashes,proto3" json:"shortHashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PMempoolDiff) Reset()         { *m = P2PMempoolDiff{} }
+func (m *P2PMempoolDiff) String() string { return proto.CompactTextString(m) }
+func (*P2PMempoolDiff) ProtoMessage()    {}
+func (*P2PMempoolDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_476920d3553a38c8, []int{13}
+}
+func (m *P2PMempoolDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PMempoolDiff.Unmarshal(m, b)
+}
+func (m *P2PMempoolDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PMempoolDiff.Marshal(b, m, deterministic)
+}
+func (dst *P2PMempoolDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PMempoolDiff.Merge(dst, src)
+}
+func (m *P2PMempoolDiff) XXX_Size() int {
+	return xxx_messageInfo_P2PMempoolDiff.Size(m)
+}
+func (m *P2PMempoolDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PMempoolDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PMempoolDiff proto.InternalMessageInfo
+
+func (m *P2PMempoolDiff) GetShortHashes() []string {
+	if m != nil {
+		return m.ShortHashes
+	}
+	return nil
+}
+
+type P2PGetMempoolTxs struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ShortHashes          []string `protobuf:"bytes,2,rep,name=shortHashes,proto3" json:"shortHashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PGetMempoolTxs) Reset()         { *m = P2PGetMempoolTxs{} }
+func (m *P2PGetMempoolTxs) String() string { return proto.CompactTextString(m) }
+func (*P2PGetMempoolTxs) ProtoMessage()    {}
+func (*P2PGetMempoolTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_476920d3553a38c8, []int{14}
+}
+func (m *P2PGetMempoolTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PGetMempoolTxs.Unmarshal(m, b)
+}
+func (m *P2PGetMempoolTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PGetMempoolTxs.Marshal(b, m, deterministic)
+}
+func (dst *P2PGetMempoolTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PGetMempoolTxs.Merge(dst, src)
+}
+func (m *P2PGetMempoolTxs) XXX_Size() int {
+	return xxx_messageInfo_P2PGetMempoolTxs.Size(m)
+}
+func (m *P2PGetMempoolTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PGetMempoolTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PGetMempoolTxs proto.InternalMessageInfo
+
+func (m *P2PGetMempoolTxs) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetMempoolTxs) GetShortHashes() []string {
+	if m != nil {
+		return m.ShortHashes
+	}
+	return nil
+}
+
 type P2PInv struct {
 	Invs                 []*Inventory `protobuf:"bytes,1,rep,name=invs,proto3" json:"invs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -704,7 +843,7 @@ func (m *P2PInv) Reset()         { *m = P2PInv{} }
 func (m *P2PInv) String() string { return proto.CompactTextString(m) }
 func (*P2PInv) ProtoMessage()    {}
 func (*P2PInv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{12}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{15}
 }
 func (m *P2PInv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PInv.Unmarshal(m, b)
@@ -748,7 +887,7 @@ func (m *Inventory) Reset()         { *m = Inventory{} }
 func (m *Inventory) String() string { return proto.CompactTextString(m) }
 func (*Inventory) ProtoMessage()    {}
 func (*Inventory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{13}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{16}
 }
 func (m *Inventory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Inventory.Unmarshal(m, b)
@@ -805,7 +944,7 @@ func (m *P2PGetData) Reset()         { *m = P2PGetData{} }
 func (m *P2PGetData) String() string { return proto.CompactTextString(m) }
 func (*P2PGetData) ProtoMessage()    {}
 func (*P2PGetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{14}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{17}
 }
 func (m *P2PGetData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetData.Unmarshal(m, b)
@@ -839,7 +978,6 @@ func (m *P2PGetData) GetInvs() []*Inventory {
 	return nil
 }
 
-//
 type P2PRoute struct {
 	TTL                  int32    `protobuf:"varint,1,opt,name=TTL,proto3" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -851,7 +989,7 @@ func (m *P2PRoute) Reset()         { *m = P2PRoute{} }
 func (m *P2PRoute) String() string { return proto.CompactTextString(m) }
 func (*P2PRoute) ProtoMessage()    {}
 func (*P2PRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{15}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{18}
 }
 func (m *P2PRoute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PRoute.Unmarshal(m, b)
@@ -892,7 +1030,7 @@ func (m *P2PTx) Reset()         { *m = P2PTx{} }
 func (m *P2PTx) String() string { return proto.CompactTextString(m) }
 func (*P2PTx) ProtoMessage()    {}
 func (*P2PTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{16}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{19}
 }
 func (m *P2PTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PTx.Unmarshal(m, b)
@@ -926,6 +1064,53 @@ func (m *P2PTx) GetRoute() *P2PRoute {
 	return nil
 }
 
+// 从其他节点接收的交易, 附带来源节点, 用于mempool按照节点限制交易
+type P2PRecvTx struct {
+	Tx                   *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Peer                 string       `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *P2PRecvTx) Reset()         { *m = P2PRecvTx{} }
+func (m *P2PRecvTx) String() string { return proto.CompactTextString(m) }
+func (*P2PRecvTx) ProtoMessage()    {}
+func (*P2PRecvTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_476920d3553a38c8, []int{20}
+}
+func (m *P2PRecvTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PRecvTx.Unmarshal(m, b)
+}
+func (m *P2PRecvTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PRecvTx.Marshal(b, m, deterministic)
+}
+func (dst *P2PRecvTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PRecvTx.Merge(dst, src)
+}
+func (m *P2PRecvTx) XXX_Size() int {
+	return xxx_messageInfo_P2PRecvTx.Size(m)
+}
+func (m *P2PRecvTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PRecvTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PRecvTx proto.InternalMessageInfo
+
+func (m *P2PRecvTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *P2PRecvTx) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
 // *
 // p2p 发送区块协议
 type P2PBlock struct {
@@ -939,7 +1124,7 @@ func (m *P2PBlock) Reset()         { *m = P2PBlock{} }
 func (m *P2PBlock) String() string { return proto.CompactTextString(m) }
 func (*P2PBlock) ProtoMessage()    {}
 func (*P2PBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{17}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{21}
 }
 func (m *P2PBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PBlock.Unmarshal(m, b)
@@ -982,7 +1167,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{18}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{22}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightBlock.Unmarshal(m, b)
@@ -1043,7 +1228,7 @@ func (m *LightTx) Reset()         { *m = LightTx{} }
 func (m *LightTx) String() string { return proto.CompactTextString(m) }
 func (*LightTx) ProtoMessage()    {}
 func (*LightTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{19}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{23}
 }
 func (m *LightTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightTx.Unmarshal(m, b)
@@ -1089,7 +1274,7 @@ func (m *P2PTxReq) Reset()         { *m = P2PTxReq{} }
 func (m *P2PTxReq) String() string { return proto.CompactTextString(m) }
 func (*P2PTxReq) ProtoMessage()    {}
 func (*P2PTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{20}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{24}
 }
 func (m *P2PTxReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PTxReq.Unmarshal(m, b)
@@ -1129,7 +1314,7 @@ func (m *P2PBlockTxReq) Reset()         { *m = P2PBlockTxReq{} }
 func (m *P2PBlockTxReq) String() string { return proto.CompactTextString(m) }
 func (*P2PBlockTxReq) ProtoMessage()    {}
 func (*P2PBlockTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{21}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{25}
 }
 func (m *P2PBlockTxReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PBlockTxReq.Unmarshal(m, b)
@@ -1177,7 +1362,7 @@ func (m *P2PBlockTxReply) Reset()         { *m = P2PBlockTxReply{} }
 func (m *P2PBlockTxReply) String() string { return proto.CompactTextString(m) }
 func (*P2PBlockTxReply) ProtoMessage()    {}
 func (*P2PBlockTxReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{22}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{26}
 }
 func (m *P2PBlockTxReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PBlockTxReply.Unmarshal(m, b)
@@ -1235,7 +1420,7 @@ func (m *P2PQueryData) Reset()         { *m = P2PQueryData{} }
 func (m *P2PQueryData) String() string { return proto.CompactTextString(m) }
 func (*P2PQueryData) ProtoMessage()    {}
 func (*P2PQueryData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{23}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{27}
 }
 func (m *P2PQueryData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PQueryData.Unmarshal(m, b)
@@ -1381,7 +1566,7 @@ func (m *Versions) Reset()         { *m = Versions{} }
 func (m *Versions) String() string { return proto.CompactTextString(m) }
 func (*Versions) ProtoMessage()    {}
 func (*Versions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{24}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{28}
 }
 func (m *Versions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Versions.Unmarshal(m, b)
@@ -1444,7 +1629,7 @@ func (m *BroadCastData) Reset()         { *m = BroadCastData{} }
 func (m *BroadCastData) String() string { return proto.CompactTextString(m) }
 func (*BroadCastData) ProtoMessage()    {}
 func (*BroadCastData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{25}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{29}
 }
 func (m *BroadCastData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BroadCastData.Unmarshal(m, b)
@@ -1782,7 +1967,7 @@ func (m *P2PGetHeaders) Reset()         { *m = P2PGetHeaders{} }
 func (m *P2PGetHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PGetHeaders) ProtoMessage()    {}
 func (*P2PGetHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{26}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{30}
 }
 func (m *P2PGetHeaders) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PGetHeaders.Unmarshal(m, b)
@@ -1823,6 +2008,54 @@ func (m *P2PGetHeaders) GetEndHeight() int64 {
 	return 0
 }
 
+// *
+// 获取快照分片
+type P2PGetSnapshotChunk struct {
+	Version              int32             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Req                  *ReqSnapshotChunk `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *P2PGetSnapshotChunk) Reset()         { *m = P2PGetSnapshotChunk{} }
+func (m *P2PGetSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*P2PGetSnapshotChunk) ProtoMessage()    {}
+func (*P2PGetSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_476920d3553a38c8, []int{31}
+}
+func (m *P2PGetSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PGetSnapshotChunk.Unmarshal(m, b)
+}
+func (m *P2PGetSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PGetSnapshotChunk.Marshal(b, m, deterministic)
+}
+func (dst *P2PGetSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PGetSnapshotChunk.Merge(dst, src)
+}
+func (m *P2PGetSnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_P2PGetSnapshotChunk.Size(m)
+}
+func (m *P2PGetSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PGetSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PGetSnapshotChunk proto.InternalMessageInfo
+
+func (m *P2PGetSnapshotChunk) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetSnapshotChunk) GetReq() *ReqSnapshotChunk {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
 // *
 // p2p 区块头传输协议
 type P2PHeaders struct {
@@ -1836,7 +2069,7 @@ func (m *P2PHeaders) Reset()         { *m = P2PHeaders{} }
 func (m *P2PHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PHeaders) ProtoMessage()    {}
 func (*P2PHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{27}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{32}
 }
 func (m *P2PHeaders) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PHeaders.Unmarshal(m, b)
@@ -1880,7 +2113,7 @@ func (m *InvData) Reset()         { *m = InvData{} }
 func (m *InvData) String() string { return proto.CompactTextString(m) }
 func (*InvData) ProtoMessage()    {}
 func (*InvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{28}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{33}
 }
 func (m *InvData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvData.Unmarshal(m, b)
@@ -2031,7 +2264,7 @@ func (m *InvDatas) Reset()         { *m = InvDatas{} }
 func (m *InvDatas) String() string { return proto.CompactTextString(m) }
 func (*InvDatas) ProtoMessage()    {}
 func (*InvDatas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{29}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{34}
 }
 func (m *InvDatas) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvDatas.Unmarshal(m, b)
@@ -2076,7 +2309,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{30}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{35}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -2151,7 +2384,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{31}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{36}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -2195,7 +2428,7 @@ func (m *NodeNetInfo) Reset()         { *m = NodeNetInfo{} }
 func (m *NodeNetInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeNetInfo) ProtoMessage()    {}
 func (*NodeNetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{32}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{37}
 }
 func (m *NodeNetInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeNetInfo.Unmarshal(m, b)
@@ -2261,7 +2494,7 @@ func (m *PeersReply) Reset()         { *m = PeersReply{} }
 func (m *PeersReply) String() string { return proto.CompactTextString(m) }
 func (*PeersReply) ProtoMessage()    {}
 func (*PeersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{33}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{38}
 }
 func (m *PeersReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeersReply.Unmarshal(m, b)
@@ -2303,7 +2536,7 @@ func (m *PeersInfo) Reset()         { *m = PeersInfo{} }
 func (m *PeersInfo) String() string { return proto.CompactTextString(m) }
 func (*PeersInfo) ProtoMessage()    {}
 func (*PeersInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_9d3b24329eee0184, []int{34}
+	return fileDescriptor_p2p_476920d3553a38c8, []int{39}
 }
 func (m *PeersInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeersInfo.Unmarshal(m, b)
@@ -2371,11 +2604,15 @@ func init() {
 	proto.RegisterType((*P2PExternalInfo)(nil), "types.P2PExternalInfo")
 	proto.RegisterType((*P2PGetBlocks)(nil), "types.P2PGetBlocks")
 	proto.RegisterType((*P2PGetMempool)(nil), "types.P2PGetMempool")
+	proto.RegisterType((*P2PMempoolSketch)(nil), "types.P2PMempoolSketch")
+	proto.RegisterType((*P2PMempoolDiff)(nil), "types.P2PMempoolDiff")
+	proto.RegisterType((*P2PGetMempoolTxs)(nil), "types.P2PGetMempoolTxs")
 	proto.RegisterType((*P2PInv)(nil), "types.P2PInv")
 	proto.RegisterType((*Inventory)(nil), "types.Inventory")
 	proto.RegisterType((*P2PGetData)(nil), "types.P2PGetData")
 	proto.RegisterType((*P2PRoute)(nil), "types.P2PRoute")
 	proto.RegisterType((*P2PTx)(nil), "types.P2PTx")
+	proto.RegisterType((*P2PRecvTx)(nil), "types.P2PRecvTx")
 	proto.RegisterType((*P2PBlock)(nil), "types.P2PBlock")
 	proto.RegisterType((*LightBlock)(nil), "types.LightBlock")
 	proto.RegisterType((*LightTx)(nil), "types.LightTx")
@@ -2386,6 +2623,7 @@ func init() {
 	proto.RegisterType((*Versions)(nil), "types.Versions")
 	proto.RegisterType((*BroadCastData)(nil), "types.BroadCastData")
 	proto.RegisterType((*P2PGetHeaders)(nil), "types.P2PGetHeaders")
+	proto.RegisterType((*P2PGetSnapshotChunk)(nil), "types.P2PGetSnapshotChunk")
 	proto.RegisterType((*P2PHeaders)(nil), "types.P2PHeaders")
 	proto.RegisterType((*InvData)(nil), "types.InvData")
 	proto.RegisterType((*InvDatas)(nil), "types.InvDatas")
@@ -2427,10 +2665,16 @@ type P2PgserviceClient interface {
 	GetBlocks(ctx context.Context, in *P2PGetBlocks, opts ...grpc.CallOption) (*P2PInv, error)
 	// 获取mempool
 	GetMemPool(ctx context.Context, in *P2PGetMempool, opts ...grpc.CallOption) (*P2PInv, error)
+	// 发送本地mempool的摘要, 返回远程节点在摘要不一致的区间中的交易短哈希
+	GetMempoolSketch(ctx context.Context, in *P2PMempoolSketch, opts ...grpc.CallOption) (*P2PMempoolDiff, error)
+	// 通过短哈希获取mempool中的交易
+	GetMempoolTxs(ctx context.Context, in *P2PGetMempoolTxs, opts ...grpc.CallOption) (*ReplyTxList, error)
 	// 获取数据
 	GetData(ctx context.Context, in *P2PGetData, opts ...grpc.CallOption) (P2Pgservice_GetDataClient, error)
 	// 获取头部
 	GetHeaders(ctx context.Context, in *P2PGetHeaders, opts ...grpc.CallOption) (*P2PHeaders, error)
+	// 获取快照分片
+	GetSnapshotChunk(ctx context.Context, in *P2PGetSnapshotChunk, opts ...grpc.CallOption) (*SnapshotChunk, error)
 	// 获取 peerinfo
 	GetPeerInfo(ctx context.Context, in *P2PGetPeerInfo, opts ...grpc.CallOption) (*P2PPeerInfo, error)
 	// grpc server 读客户端发送来的数据
@@ -2540,6 +2784,24 @@ func (c *p2PgserviceClient) GetMemPool(ctx context.Context, in *P2PGetMempool, o
 	return out, nil
 }
 
+func (c *p2PgserviceClient) GetMempoolSketch(ctx context.Context, in *P2PMempoolSketch, opts ...grpc.CallOption) (*P2PMempoolDiff, error) {
+	out := new(P2PMempoolDiff)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetMempoolSketch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *p2PgserviceClient) GetMempoolTxs(ctx context.Context, in *P2PGetMempoolTxs, opts ...grpc.CallOption) (*ReplyTxList, error) {
+	out := new(ReplyTxList)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetMempoolTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *p2PgserviceClient) GetData(ctx context.Context, in *P2PGetData, opts ...grpc.CallOption) (P2Pgservice_GetDataClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_P2Pgservice_serviceDesc.Streams[0], "/types.p2pgservice/GetData", opts...)
 	if err != nil {
@@ -2581,6 +2843,15 @@ func (c *p2PgserviceClient) GetHeaders(ctx context.Context, in *P2PGetHeaders, o
 	return out, nil
 }
 
+func (c *p2PgserviceClient) GetSnapshotChunk(ctx context.Context, in *P2PGetSnapshotChunk, opts ...grpc.CallOption) (*SnapshotChunk, error) {
+	out := new(SnapshotChunk)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetSnapshotChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *p2PgserviceClient) GetPeerInfo(ctx context.Context, in *P2PGetPeerInfo, opts ...grpc.CallOption) (*P2PPeerInfo, error) {
 	out := new(P2PPeerInfo)
 	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetPeerInfo", in, out, opts...)
@@ -2695,10 +2966,16 @@ type P2PgserviceServer interface {
 	GetBlocks(context.Context, *P2PGetBlocks) (*P2PInv, error)
 	// 获取mempool
 	GetMemPool(context.Context, *P2PGetMempool) (*P2PInv, error)
+	// 发送本地mempool的摘要, 返回远程节点在摘要不一致的区间中的交易短哈希
+	GetMempoolSketch(context.Context, *P2PMempoolSketch) (*P2PMempoolDiff, error)
+	// 通过短哈希获取mempool中的交易
+	GetMempoolTxs(context.Context, *P2PGetMempoolTxs) (*ReplyTxList, error)
 	// 获取数据
 	GetData(*P2PGetData, P2Pgservice_GetDataServer) error
 	// 获取头部
 	GetHeaders(context.Context, *P2PGetHeaders) (*P2PHeaders, error)
+	// 获取快照分片
+	GetSnapshotChunk(context.Context, *P2PGetSnapshotChunk) (*SnapshotChunk, error)
 	// 获取 peerinfo
 	GetPeerInfo(context.Context, *P2PGetPeerInfo) (*P2PPeerInfo, error)
 	// grpc server 读客户端发送来的数据
@@ -2894,6 +3171,42 @@ func _P2Pgservice_GetMemPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _P2Pgservice_GetMempoolSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PMempoolSketch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetMempoolSketch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetMempoolSketch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetMempoolSketch(ctx, req.(*P2PMempoolSketch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _P2Pgservice_GetMempoolTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetMempoolTxs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetMempoolTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetMempoolTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetMempoolTxs(ctx, req.(*P2PGetMempoolTxs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _P2Pgservice_GetData_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(P2PGetData)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2933,6 +3246,24 @@ func _P2Pgservice_GetHeaders_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _P2Pgservice_GetSnapshotChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetSnapshotChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetSnapshotChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetSnapshotChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetSnapshotChunk(ctx, req.(*P2PGetSnapshotChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _P2Pgservice_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(P2PGetPeerInfo)
 	if err := dec(in); err != nil {
@@ -3078,10 +3409,22 @@ var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMemPool",
 			Handler:    _P2Pgservice_GetMemPool_Handler,
 		},
+		{
+			MethodName: "GetMempoolSketch",
+			Handler:    _P2Pgservice_GetMempoolSketch_Handler,
+		},
+		{
+			MethodName: "GetMempoolTxs",
+			Handler:    _P2Pgservice_GetMempoolTxs_Handler,
+		},
 		{
 			MethodName: "GetHeaders",
 			Handler:    _P2Pgservice_GetHeaders_Handler,
 		},
+		{
+			MethodName: "GetSnapshotChunk",
+			Handler:    _P2Pgservice_GetSnapshotChunk_Handler,
+		},
 		{
 			MethodName: "GetPeerInfo",
 			Handler:    _P2Pgservice_GetPeerInfo_Handler,
@@ -3115,107 +3458,118 @@ var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 	Metadata: "p2p.proto",
 }
 
-func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_9d3b24329eee0184) }
-
-var fileDescriptor_p2p_9d3b24329eee0184 = []byte{
-	// 1569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0x1b, 0x37,
-	0x16, 0x1e, 0xdd, 0x2c, 0xe9, 0xc8, 0x57, 0x26, 0x1b, 0x08, 0x82, 0x37, 0xc9, 0x12, 0xce, 0xc6,
-	0xbb, 0xd9, 0x28, 0xc9, 0x38, 0x9b, 0x05, 0x36, 0xfb, 0x62, 0x67, 0xdb, 0xc8, 0xa8, 0x1b, 0x4c,
-	0x69, 0xb5, 0x0f, 0x7d, 0x1b, 0x4b, 0xb4, 0x34, 0x88, 0xc4, 0x99, 0xcc, 0x50, 0x82, 0x9c, 0xf7,
-	0xbe, 0xf5, 0xa9, 0xfd, 0x01, 0x7d, 0xe8, 0xdf, 0xcb, 0x8f, 0x28, 0x78, 0x48, 0xce, 0x45, 0x92,
-	0x85, 0xa2, 0x41, 0xdf, 0x86, 0xe7, 0xc6, 0x73, 0xfd, 0x78, 0x24, 0x68, 0x46, 0x6e, 0xd4, 0x8d,
-	0xe2, 0x50, 0x86, 0xa4, 0x26, 0x6f, 0x22, 0x9e, 0x74, 0x0e, 0x64, 0xec, 0x8b, 0xc4, 0x1f, 0xc8,
-	0x20, 0x14, 0x9a, 0xd3, 0xd9, 0x1e, 0x84, 0xd3, 0x69, 0x7a, 0xda, 0xbf, 0x9a, 0x84, 0x83, 0xf7,
-	0x83, 0xb1, 0x1f, 0x18, 0x0a, 0xfd, 0x27, 0xec, 0x7a, 0xae, 0xf7, 0x96, 0x4b, 0x8f, 0xf3, 0xf8,
-	0x5c, 0x5c, 0x87, 0xa4, 0x0d, 0xf5, 0x39, 0x8f, 0x93, 0x20, 0x14, 0xed, 0xd2, 0xc3, 0xd2, 0x71,
-	0x8d, 0xd9, 0x23, 0xfd, 0xa9, 0x04, 0x2d, 0xcf, 0xf5, 0x52, 0x49, 0x02, 0x55, 0x7f, 0x38, 0x8c,
-	0x51, 0xac, 0xc9, 0xf0, 0x5b, 0xd1, 0xa2, 0x30, 0x96, 0xed, 0x32, 0xaa, 0xe2, 0xb7, 0xa2, 0x09,
-	0x7f, 0xca, 0xdb, 0x15, 0x2d, 0xa7, 0xbe, 0xc9, 0x43, 0x68, 0x4d, 0xf9, 0x34, 0x0a, 0xc3, 0xc9,
-	0x65, 0xf0, 0x91, 0xb7, 0xab, 0x28, 0x9e, 0x27, 0x91, 0x47, 0xb0, 0x35, 0xe6, 0xfe, 0x90, 0xc7,
-	0xed, 0xda, 0xc3, 0xd2, 0x71, 0xcb, 0xdd, 0xe9, 0x62, 0x90, 0xdd, 0x1e, 0x12, 0x99, 0x61, 0xd2,
-	0x4f, 0x25, 0x00, 0xcf, 0xf5, 0xbe, 0xd3, 0x3e, 0xde, 0xee, 0xbd, 0xe2, 0x24, 0x3c, 0x9e, 0x07,
-	0x03, 0x8e, 0xce, 0x55, 0x98, 0x3d, 0x92, 0x43, 0x68, 0xca, 0x60, 0xca, 0x13, 0xe9, 0x4f, 0x23,
-	0x74, 0xb2, 0xc2, 0x32, 0x02, 0xe9, 0x40, 0x43, 0x45, 0xc6, 0xf8, 0x60, 0x8e, 0x6e, 0x36, 0x59,
-	0x7a, 0xb6, 0xbc, 0x2f, 0xe3, 0x70, 0x8a, 0x5e, 0x1a, 0x9e, 0x3a, 0x93, 0xbb, 0x50, 0x13, 0xa1,
-	0x18, 0xf0, 0xf6, 0x16, 0x5a, 0xd4, 0x07, 0x75, 0xd7, 0x2c, 0xe1, 0xf1, 0xe9, 0x88, 0x0b, 0xd9,
-	0xae, 0xa3, 0x4a, 0x46, 0x50, 0x59, 0x49, 0xa4, 0x1f, 0xcb, 0x1e, 0x0f, 0x46, 0x63, 0xd9, 0x6e,
-	0xa0, 0x66, 0x9e, 0x44, 0xbf, 0x85, 0xa6, 0x8e, 0xf6, 0x74, 0xf0, 0xfe, 0x0f, 0x05, 0x9b, 0xba,
-	0x55, 0xc9, 0xb9, 0x45, 0xa7, 0x50, 0x57, 0x95, 0x0d, 0xc4, 0x28, 0x13, 0x28, 0xe5, 0xfd, 0xb6,
-	0xb5, 0x2e, 0xaf, 0xa9, 0x75, 0x25, 0x57, 0xeb, 0x23, 0xa8, 0x26, 0xc1, 0x48, 0x60, 0xa6, 0x5a,
-	0xee, 0xbe, 0xa9, 0xd9, 0x65, 0x30, 0x12, 0xbe, 0x9c, 0xc5, 0x9c, 0x21, 0x97, 0x3e, 0xd0, 0xd7,
-	0x85, 0xb7, 0x5d, 0x47, 0x29, 0x16, 0xf5, 0x2d, 0x97, 0xa7, 0xea, 0xa2, 0xf5, 0x32, 0xaf, 0xd1,
-	0xc8, 0xed, 0x02, 0xb6, 0x3a, 0x93, 0x20, 0x51, 0xfd, 0x58, 0xb1, 0xd5, 0x51, 0x67, 0x7a, 0x89,
-	0xad, 0xac, 0x94, 0x2f, 0x82, 0x44, 0xde, 0x62, 0xa0, 0x0b, 0x8d, 0x88, 0xf3, 0x38, 0x10, 0xd7,
-	0x21, 0x1a, 0x68, 0xb9, 0xc4, 0x04, 0x94, 0x1b, 0x03, 0x96, 0xca, 0xd0, 0x37, 0xb0, 0xe7, 0xb9,
-	0xde, 0x17, 0x0b, 0xc9, 0x63, 0xe1, 0x4f, 0x6e, 0x9d, 0x91, 0x43, 0x68, 0x06, 0x49, 0x38, 0x93,
-	0x49, 0x30, 0xd4, 0xe5, 0x69, 0xb0, 0x8c, 0x40, 0xc7, 0xb0, 0xad, 0x43, 0x3f, 0x53, 0xb3, 0x9a,
-	0x6c, 0x28, 0xf2, 0x52, 0xb7, 0x94, 0x57, 0xba, 0x45, 0xdd, 0xc4, 0xc5, 0xd0, 0xf0, 0x4d, 0x67,
-	0xa7, 0x04, 0xfa, 0x0f, 0xd8, 0xd1, 0x37, 0x7d, 0xad, 0xc7, 0x6e, 0xc3, 0xe8, 0x77, 0x61, 0xcb,
-	0x73, 0xbd, 0x73, 0x31, 0x57, 0x05, 0x0e, 0xc4, 0x3c, 0x69, 0x97, 0x30, 0x1f, 0xb6, 0xc0, 0xe7,
-	0x62, 0xce, 0x85, 0x0c, 0xe3, 0x1b, 0x86, 0x5c, 0xfa, 0x16, 0x9a, 0x29, 0x89, 0xec, 0x42, 0x59,
-	0xde, 0x18, 0x8b, 0x65, 0x79, 0xa3, 0x72, 0x32, 0xf6, 0x93, 0x31, 0x3a, 0xbc, 0xcd, 0xf0, 0x9b,
-	0xdc, 0x53, 0xd3, 0x9e, 0x73, 0xd3, 0x9c, 0xe8, 0x85, 0x6d, 0x84, 0xff, 0xfb, 0xd2, 0xdf, 0x90,
-	0x0b, 0xeb, 0x56, 0x79, 0xa3, 0x5b, 0x87, 0xd0, 0xf0, 0x5c, 0x8f, 0x85, 0x33, 0xc9, 0xc9, 0x3e,
-	0x54, 0xfa, 0xfd, 0x0b, 0x63, 0x47, 0x7d, 0x52, 0x06, 0x35, 0xcf, 0xf5, 0xfa, 0x0b, 0x42, 0xa1,
-	0x2c, 0x17, 0xc8, 0xc9, 0x2a, 0xde, 0xcf, 0xa0, 0x95, 0x95, 0xe5, 0x82, 0x3c, 0x82, 0x5a, 0xac,
-	0xec, 0x60, 0x14, 0x2d, 0x77, 0x2f, 0x6b, 0x0c, 0x34, 0xcf, 0x34, 0x97, 0x76, 0xf1, 0x46, 0x2c,
-	0x25, 0xa1, 0x50, 0x43, 0xfc, 0x35, 0x96, 0xb7, 0x8d, 0x0a, 0x32, 0x99, 0x66, 0xd1, 0x9f, 0x4b,
-	0x00, 0x17, 0x2a, 0x72, 0xad, 0x42, 0xd4, 0x38, 0x7d, 0xb4, 0x6d, 0x89, 0xdf, 0x39, 0x60, 0x2c,
-	0x6f, 0x00, 0x46, 0xf2, 0x2f, 0xa8, 0x4f, 0x03, 0xc1, 0xe3, 0xfe, 0x02, 0x53, 0xba, 0x3e, 0x12,
-	0x2b, 0xa2, 0x3a, 0x25, 0xe9, 0x2f, 0x7a, 0x7e, 0x32, 0xe6, 0x49, 0xbb, 0x8a, 0xc3, 0x92, 0x11,
-	0x68, 0x0f, 0xea, 0xe8, 0x54, 0x7f, 0xa1, 0x0a, 0x25, 0x91, 0x8c, 0x3e, 0x6d, 0x33, 0x73, 0xfa,
-	0xbd, 0xf9, 0xa0, 0x98, 0x8f, 0xfe, 0x82, 0xf1, 0x0f, 0xb7, 0x99, 0xa2, 0x5f, 0x61, 0x5f, 0x62,
-	0x02, 0xb4, 0xe0, 0x21, 0x34, 0x31, 0x3b, 0xa9, 0x6c, 0x93, 0x65, 0x04, 0x84, 0xef, 0xc5, 0xb9,
-	0x18, 0x06, 0x03, 0xae, 0xeb, 0x5f, 0x63, 0x19, 0x81, 0x26, 0x38, 0x93, 0xa9, 0xb1, 0x68, 0x72,
-	0xf3, 0x39, 0xe6, 0xc8, 0x11, 0x54, 0xe4, 0x22, 0x69, 0x57, 0x0a, 0x68, 0x90, 0xcf, 0xa8, 0x62,
-	0xd3, 0x05, 0xce, 0xf0, 0x37, 0x33, 0x1e, 0xdf, 0x60, 0xdf, 0x3e, 0x86, 0x9a, 0x54, 0x91, 0x98,
-	0xca, 0xe7, 0x92, 0x83, 0x01, 0xf6, 0x1c, 0xa6, 0xf9, 0xe4, 0x15, 0xc0, 0x55, 0x1a, 0xb7, 0x49,
-	0xe5, 0xdd, 0x4c, 0x3a, 0xcb, 0x49, 0xcf, 0x61, 0x39, 0xc9, 0xb3, 0x3a, 0xd4, 0xe6, 0xfe, 0x64,
-	0xa6, 0xd0, 0xa3, 0x61, 0x9e, 0xc2, 0x84, 0xdc, 0x07, 0x88, 0xdc, 0xa8, 0x38, 0x30, 0x39, 0x0a,
-	0xe2, 0x47, 0x78, 0x2d, 0xad, 0x80, 0x86, 0xf6, 0x3c, 0x49, 0x21, 0xa8, 0x02, 0xb7, 0xdc, 0xeb,
-	0x9d, 0x9e, 0xe9, 0xa7, 0x32, 0xec, 0x9c, 0xc5, 0xa1, 0x3f, 0x7c, 0xe3, 0x27, 0x7a, 0x3a, 0xef,
-	0xe7, 0xc6, 0x66, 0x3b, 0x1f, 0x62, 0xcf, 0xc1, 0x91, 0x79, 0x6c, 0xfb, 0x7f, 0xa5, 0x45, 0x30,
-	0x2e, 0x95, 0x05, 0xe4, 0xab, 0x61, 0x8e, 0x02, 0x31, 0x32, 0x7d, 0xbb, 0x9b, 0xc3, 0xdc, 0x40,
-	0x8c, 0x7a, 0x0e, 0x43, 0x2e, 0x79, 0x92, 0x81, 0x41, 0xb5, 0x60, 0xd0, 0x26, 0xa0, 0xe7, 0x14,
-	0xf0, 0x61, 0x22, 0xfb, 0x0b, 0xb3, 0x4b, 0x58, 0x93, 0xa6, 0xa9, 0x95, 0x49, 0xc5, 0x25, 0x4f,
-	0xa1, 0x3e, 0xd1, 0x93, 0x87, 0xaf, 0x76, 0xcb, 0x3d, 0xc8, 0x0b, 0x5a, 0x2f, 0xad, 0x0c, 0x79,
-	0x02, 0xb5, 0x0f, 0xaa, 0xc6, 0xf8, 0x90, 0xb7, 0xdc, 0x3b, 0x99, 0xa3, 0x69, 0xe9, 0x55, 0x50,
-	0x28, 0x43, 0x5e, 0x42, 0x03, 0xa3, 0x63, 0x3c, 0xc2, 0x87, 0xbd, 0xe5, 0xde, 0x5b, 0x53, 0xd8,
-	0x68, 0x72, 0xd3, 0x73, 0x58, 0x2a, 0x99, 0x15, 0x36, 0xb0, 0x60, 0xad, 0xc7, 0xfc, 0xcf, 0x7c,
-	0x17, 0xfe, 0x8d, 0x98, 0x6b, 0xef, 0x79, 0x0c, 0x75, 0x8d, 0x28, 0x16, 0xf3, 0x97, 0xf0, 0xc6,
-	0x72, 0xa9, 0x80, 0xfa, 0xb9, 0x98, 0x63, 0x27, 0x1c, 0x6d, 0x06, 0x50, 0xd3, 0x0f, 0x47, 0xc5,
-	0x7e, 0x28, 0xe0, 0x61, 0xd6, 0x0c, 0xfa, 0xf5, 0xa8, 0xd8, 0xd7, 0x23, 0xcb, 0xc8, 0x73, 0x68,
-	0x98, 0xfb, 0xd4, 0x58, 0xd6, 0x02, 0xc9, 0xa7, 0xd6, 0xc5, 0xdd, 0x0c, 0xff, 0x15, 0x9f, 0x69,
-	0x26, 0xfd, 0xa5, 0x04, 0x55, 0xf5, 0x6c, 0x7f, 0xd6, 0xe6, 0xaa, 0x20, 0x99, 0x4f, 0xae, 0xb1,
-	0xe7, 0x1a, 0x0c, 0xbf, 0x97, 0xb7, 0xd9, 0xda, 0xa6, 0x6d, 0x76, 0x6b, 0xd3, 0x36, 0xfb, 0x14,
-	0x1a, 0xca, 0x41, 0xdc, 0x49, 0xfe, 0x06, 0x35, 0x35, 0x6c, 0x36, 0xa6, 0x96, 0xed, 0x16, 0xce,
-	0x63, 0xa6, 0x39, 0xf4, 0xd7, 0x12, 0xb4, 0xde, 0x85, 0x43, 0xfe, 0x8e, 0x4b, 0xdc, 0x36, 0x28,
-	0x6c, 0x73, 0xb3, 0x7d, 0xe4, 0xe2, 0x2b, 0xd0, 0x54, 0xed, 0x27, 0xe1, 0xc0, 0x08, 0xe8, 0x99,
-	0xcf, 0x08, 0xf9, 0xc5, 0xb1, 0x82, 0x01, 0xe6, 0xb7, 0xe4, 0x70, 0x26, 0xaf, 0xc2, 0x99, 0x18,
-	0x26, 0x66, 0x5f, 0xcf, 0x08, 0x0a, 0x29, 0x02, 0x61, 0x98, 0x3a, 0xfc, 0xf4, 0x4c, 0x5f, 0x02,
-	0x28, 0xa7, 0x13, 0x8d, 0xbe, 0x7f, 0x2f, 0x86, 0xb5, 0x9f, 0x0b, 0x2b, 0xc1, 0x7d, 0xca, 0xc4,
-	0xf6, 0x43, 0x09, 0x9a, 0x29, 0x31, 0xad, 0x44, 0x29, 0x57, 0x89, 0x5d, 0x28, 0x07, 0x91, 0x09,
-	0xa1, 0x1c, 0x44, 0x6b, 0xf7, 0xd1, 0x25, 0x8c, 0xab, 0xae, 0x62, 0x5c, 0x11, 0x25, 0x6b, 0xcb,
-	0x28, 0xe9, 0xfe, 0x58, 0x87, 0x56, 0xe4, 0x46, 0x23, 0x9b, 0x87, 0x27, 0xd0, 0x4a, 0x61, 0xaf,
-	0xbf, 0x20, 0x05, 0xa0, 0xeb, 0xd8, 0x13, 0x86, 0x4a, 0x1d, 0xf2, 0x02, 0x76, 0x53, 0x61, 0x8d,
-	0x19, 0xcb, 0xa8, 0xb7, 0xa2, 0x72, 0x0c, 0x55, 0xdc, 0xc3, 0x97, 0x60, 0xaf, 0x93, 0x3f, 0x87,
-	0x62, 0x44, 0x1d, 0xd2, 0x85, 0xba, 0xdd, 0x90, 0x0f, 0x32, 0xa6, 0x21, 0xe5, 0xe5, 0xd5, 0x99,
-	0x3a, 0xe4, 0x15, 0xb4, 0x0c, 0x13, 0xfb, 0x6b, 0x8d, 0x0e, 0x29, 0xea, 0x28, 0x31, 0xea, 0x90,
-	0xe7, 0x50, 0xb7, 0x3f, 0xaf, 0x72, 0x3a, 0x86, 0xd4, 0xd9, 0x2f, 0x90, 0x4e, 0x07, 0xef, 0xa9,
-	0x43, 0xdc, 0xf4, 0x15, 0x72, 0xd7, 0xa9, 0xac, 0x92, 0xa8, 0x43, 0x9e, 0x42, 0xeb, 0x32, 0xbc,
-	0x96, 0xf6, 0xa6, 0xe5, 0xf0, 0x57, 0x33, 0xdb, 0xcc, 0x76, 0xe4, 0x3b, 0x85, 0x50, 0x34, 0xb1,
-	0xb3, 0x93, 0x11, 0xcf, 0xc5, 0x9c, 0x3a, 0xe4, 0x04, 0x40, 0x2f, 0xbb, 0x9e, 0x5a, 0x76, 0xef,
-	0x16, 0x74, 0xcc, 0x0a, 0xbc, 0xaa, 0xf4, 0x02, 0x93, 0x8c, 0xa8, 0x56, 0x4c, 0x98, 0x22, 0x75,
-	0xf6, 0x8a, 0x40, 0x93, 0x50, 0xe7, 0x79, 0x89, 0xfc, 0x07, 0xef, 0xb1, 0xf8, 0x59, 0xbc, 0xc7,
-	0x50, 0xf3, 0x29, 0x30, 0x24, 0xea, 0x90, 0xff, 0x62, 0x81, 0xd2, 0xdf, 0xd7, 0x7f, 0x29, 0x68,
-	0x5a, 0x72, 0x67, 0xcd, 0x6f, 0x10, 0xea, 0x90, 0xd7, 0xb0, 0x7f, 0xc9, 0xe3, 0x39, 0x8f, 0x2f,
-	0x65, 0xcc, 0xfd, 0x29, 0xe3, 0xfe, 0x30, 0xbd, 0xba, 0xf0, 0x4c, 0xa7, 0x21, 0x32, 0xfe, 0xe1,
-	0x5d, 0x30, 0xa1, 0xce, 0x71, 0x89, 0xfc, 0xaf, 0xa8, 0x7c, 0xc9, 0xc5, 0x70, 0xa5, 0x00, 0x6b,
-	0x8d, 0x61, 0xbc, 0x27, 0xb0, 0xfb, 0x26, 0x9c, 0x4c, 0xf8, 0x40, 0x9e, 0x0b, 0x9c, 0xd8, 0x15,
-	0xdd, 0xbd, 0xdc, 0x90, 0x9b, 0xa6, 0x7a, 0x05, 0x7b, 0x45, 0x25, 0x77, 0x45, 0xeb, 0x20, 0x0f,
-	0x0d, 0xa6, 0xee, 0x67, 0x0f, 0xbe, 0xff, 0xeb, 0x28, 0x90, 0xe3, 0xd9, 0x55, 0x77, 0x10, 0x4e,
-	0x9f, 0x9d, 0x9c, 0x0c, 0xc4, 0x33, 0xfc, 0x3f, 0xe3, 0xe4, 0xe4, 0x19, 0x4a, 0x5f, 0x6d, 0xe1,
-	0x1f, 0x1b, 0x27, 0xbf, 0x05, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xf8, 0xa8, 0x10, 0x1f, 0x11, 0x00,
-	0x00,
+func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_476920d3553a38c8) }
+
+var fileDescriptor_p2p_476920d3553a38c8 = []byte{
+	// 1749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xeb, 0x8e, 0xdb, 0xc6,
+	0x15, 0xa6, 0x2e, 0x5c, 0x49, 0x87, 0x7b, 0x91, 0xc7, 0x4e, 0x2c, 0x08, 0x6e, 0xe2, 0x0e, 0x9c,
+	0x7a, 0x53, 0xd7, 0x6b, 0x87, 0x9b, 0xba, 0x40, 0x53, 0x14, 0xb0, 0xd7, 0x8d, 0xb5, 0xa8, 0x6b,
+	0xb0, 0x23, 0xb5, 0x28, 0xf2, 0x8f, 0x4b, 0xcd, 0x4a, 0x84, 0xa5, 0x21, 0x4d, 0x8e, 0x04, 0x6e,
+	0xfe, 0xf7, 0x05, 0xda, 0x07, 0xe8, 0x8f, 0xbe, 0x55, 0x9f, 0x21, 0x0f, 0x51, 0xcc, 0x99, 0xe1,
+	0x4d, 0xd2, 0xaa, 0x41, 0x8d, 0xfc, 0xe3, 0x9c, 0xdb, 0x9c, 0xef, 0xdc, 0x66, 0x86, 0xd0, 0x8b,
+	0xdd, 0xf8, 0x2c, 0x4e, 0x22, 0x19, 0x11, 0x5b, 0xde, 0xc4, 0x3c, 0x1d, 0xde, 0x91, 0x89, 0x2f,
+	0x52, 0x3f, 0x90, 0x61, 0x24, 0x34, 0x67, 0x78, 0x18, 0x44, 0xcb, 0x65, 0xb1, 0xea, 0x5f, 0x2d,
+	0xa2, 0xe0, 0x7d, 0x30, 0xf7, 0x43, 0x43, 0xa1, 0xbf, 0x84, 0x63, 0xcf, 0xf5, 0xde, 0x70, 0xe9,
+	0x71, 0x9e, 0x5c, 0x8a, 0xeb, 0x88, 0x0c, 0xa0, 0xb3, 0xe6, 0x49, 0x1a, 0x46, 0x62, 0xd0, 0x78,
+	0xd8, 0x38, 0xb5, 0x59, 0xbe, 0xa4, 0xff, 0x68, 0x80, 0xe3, 0xb9, 0x5e, 0x21, 0x49, 0xa0, 0xed,
+	0x4f, 0xa7, 0x09, 0x8a, 0xf5, 0x18, 0x7e, 0x2b, 0x5a, 0x1c, 0x25, 0x72, 0xd0, 0x44, 0x55, 0xfc,
+	0x56, 0x34, 0xe1, 0x2f, 0xf9, 0xa0, 0xa5, 0xe5, 0xd4, 0x37, 0x79, 0x08, 0xce, 0x92, 0x2f, 0xe3,
+	0x28, 0x5a, 0x8c, 0xc3, 0xef, 0xf9, 0xa0, 0x8d, 0xe2, 0x55, 0x12, 0xf9, 0x02, 0x0e, 0xe6, 0xdc,
+	0x9f, 0xf2, 0x64, 0x60, 0x3f, 0x6c, 0x9c, 0x3a, 0xee, 0xd1, 0x19, 0x82, 0x3c, 0x1b, 0x21, 0x91,
+	0x19, 0x26, 0xfd, 0xa1, 0x01, 0xe0, 0xb9, 0xde, 0x5f, 0xb5, 0x8f, 0xb7, 0x7b, 0xaf, 0x38, 0x29,
+	0x4f, 0xd6, 0x61, 0xc0, 0xd1, 0xb9, 0x16, 0xcb, 0x97, 0xe4, 0x01, 0xf4, 0x64, 0xb8, 0xe4, 0xa9,
+	0xf4, 0x97, 0x31, 0x3a, 0xd9, 0x62, 0x25, 0x81, 0x0c, 0xa1, 0xab, 0x90, 0x31, 0x1e, 0xac, 0xd1,
+	0xcd, 0x1e, 0x2b, 0xd6, 0x39, 0xef, 0xdb, 0x24, 0x5a, 0x0e, 0xec, 0x92, 0xa7, 0xd6, 0xe4, 0x1e,
+	0xd8, 0x22, 0x12, 0x01, 0x1f, 0x1c, 0xa0, 0x45, 0xbd, 0x50, 0x7b, 0xad, 0x52, 0x9e, 0xbc, 0x9c,
+	0x71, 0x21, 0x07, 0x1d, 0x54, 0x29, 0x09, 0x2a, 0x2a, 0xa9, 0xf4, 0x13, 0x39, 0xe2, 0xe1, 0x6c,
+	0x2e, 0x07, 0x5d, 0xd4, 0xac, 0x92, 0xe8, 0x5f, 0xa0, 0xa7, 0xd1, 0xbe, 0x0c, 0xde, 0xff, 0x5f,
+	0x60, 0x0b, 0xb7, 0x5a, 0x15, 0xb7, 0xe8, 0x12, 0x3a, 0x2a, 0xb3, 0xa1, 0x98, 0x95, 0x02, 0x8d,
+	0xaa, 0xdf, 0x79, 0xae, 0x9b, 0x3b, 0x72, 0xdd, 0xaa, 0xe4, 0xfa, 0x11, 0xb4, 0xd3, 0x70, 0x26,
+	0x30, 0x52, 0x8e, 0xdb, 0x37, 0x39, 0x1b, 0x87, 0x33, 0xe1, 0xcb, 0x55, 0xc2, 0x19, 0x72, 0xe9,
+	0xe7, 0x7a, 0xbb, 0xe8, 0xb6, 0xed, 0x28, 0xc5, 0xa4, 0xbe, 0xe1, 0xf2, 0xa5, 0xda, 0x68, 0xb7,
+	0xcc, 0x37, 0x68, 0xe4, 0x76, 0x81, 0x3c, 0x3b, 0x8b, 0x30, 0x55, 0xf5, 0xd8, 0xca, 0xb3, 0xa3,
+	0xd6, 0x74, 0x0c, 0x8e, 0x51, 0x7e, 0x1b, 0xa6, 0xf2, 0x16, 0x03, 0x67, 0xd0, 0x8d, 0x39, 0x4f,
+	0x42, 0x71, 0x1d, 0xa1, 0x01, 0xc7, 0x25, 0x06, 0x50, 0xa5, 0x0d, 0x58, 0x21, 0x43, 0x2f, 0xe0,
+	0xc4, 0x73, 0xbd, 0x3f, 0x64, 0x92, 0x27, 0xc2, 0x5f, 0xdc, 0xda, 0x23, 0x0f, 0xa0, 0x17, 0xa6,
+	0xd1, 0x4a, 0xa6, 0xe1, 0x54, 0xa7, 0xa7, 0xcb, 0x4a, 0x02, 0x9d, 0xc3, 0xa1, 0x86, 0xfe, 0x4a,
+	0xf5, 0x6a, 0xba, 0x27, 0xc9, 0x1b, 0xd5, 0xd2, 0xdc, 0xaa, 0x16, 0xb5, 0x13, 0x17, 0x53, 0xc3,
+	0x37, 0x95, 0x5d, 0x10, 0xe8, 0x97, 0x70, 0xa4, 0x77, 0xfa, 0x93, 0x6e, 0xbb, 0x3d, 0xad, 0xff,
+	0x37, 0xe8, 0x7b, 0xae, 0x67, 0xe4, 0xc6, 0xef, 0xb9, 0x0c, 0xe6, 0x7b, 0x1c, 0xfb, 0x14, 0x0e,
+	0x82, 0x68, 0x25, 0x64, 0x8a, 0x51, 0x3b, 0x62, 0x66, 0xa5, 0x82, 0x91, 0x45, 0x49, 0x3a, 0x68,
+	0x3d, 0x6c, 0x9d, 0xb6, 0x19, 0x7e, 0x53, 0x17, 0x8e, 0x4b, 0xcb, 0xaf, 0xc3, 0xeb, 0x6b, 0x84,
+	0x35, 0x8f, 0x12, 0x39, 0xf2, 0xd3, 0x39, 0x4f, 0x07, 0x0d, 0xcc, 0x5c, 0x95, 0x44, 0xdf, 0x41,
+	0xbf, 0xe6, 0xf8, 0x24, 0xfb, 0x5f, 0x61, 0xaa, 0xd8, 0x6b, 0x6e, 0xdb, 0x3b, 0x83, 0x03, 0xcf,
+	0xf5, 0x2e, 0xc5, 0x5a, 0x95, 0x6f, 0x28, 0xd6, 0x7a, 0xd3, 0xb2, 0x7c, 0x2f, 0xc5, 0x9a, 0x0b,
+	0x19, 0x25, 0x37, 0x0c, 0xb9, 0xf4, 0x0d, 0xf4, 0x0a, 0x12, 0x39, 0x86, 0xa6, 0xbc, 0x31, 0x7b,
+	0x36, 0xe5, 0x8d, 0x02, 0x39, 0xf7, 0xd3, 0x39, 0xa6, 0xe3, 0x90, 0xe1, 0xb7, 0x0a, 0xc8, 0xbc,
+	0x9a, 0x04, 0xb3, 0xa2, 0x6f, 0xf3, 0x32, 0x7f, 0xed, 0x4b, 0x7f, 0x0f, 0x84, 0xdc, 0xad, 0xe6,
+	0x5e, 0xb7, 0x1e, 0x40, 0xd7, 0x73, 0x3d, 0x16, 0xad, 0x24, 0x27, 0x7d, 0x68, 0x4d, 0x26, 0x6f,
+	0x8d, 0x1d, 0xf5, 0x49, 0x19, 0xd8, 0x9e, 0xeb, 0x4d, 0x32, 0x42, 0xa1, 0x29, 0x33, 0xe4, 0x94,
+	0xf5, 0x3c, 0x29, 0x0f, 0x0e, 0xd6, 0x94, 0x19, 0xf9, 0x02, 0xec, 0x44, 0xd9, 0x41, 0x14, 0x8e,
+	0x7b, 0x52, 0x96, 0x3d, 0x9a, 0x67, 0x9a, 0x4b, 0x2f, 0x70, 0x1a, 0xa9, 0x51, 0xf8, 0x23, 0xed,
+	0xaa, 0x91, 0xc1, 0x79, 0x31, 0x46, 0xd4, 0x37, 0x3d, 0x43, 0xb7, 0xb1, 0xda, 0x09, 0x05, 0x1b,
+	0x8f, 0x28, 0x63, 0xe6, 0xd0, 0x98, 0x41, 0x26, 0xd3, 0x2c, 0xfa, 0xcf, 0x06, 0xc0, 0x5b, 0x15,
+	0x3e, 0xad, 0x42, 0xd4, 0xc4, 0xf9, 0x3e, 0xef, 0xdc, 0x76, 0x5a, 0x3f, 0x3b, 0x9a, 0x7b, 0xce,
+	0x0e, 0xf2, 0x2b, 0xe8, 0x2c, 0x43, 0xc1, 0x93, 0x49, 0x36, 0x68, 0xdd, 0xea, 0x76, 0x2e, 0xa2,
+	0x9a, 0x29, 0x9d, 0x64, 0xa6, 0x8a, 0xda, 0x58, 0x45, 0x25, 0x81, 0x8e, 0xa0, 0x83, 0x4e, 0x4d,
+	0x32, 0x95, 0x6d, 0x89, 0x64, 0xf4, 0xe9, 0x90, 0x99, 0xd5, 0x8f, 0x0d, 0x2a, 0xc5, 0x78, 0x4c,
+	0x32, 0xc6, 0x3f, 0xdc, 0x66, 0x8a, 0xfe, 0x11, 0x5b, 0x17, 0x03, 0xa0, 0x05, 0x1f, 0x40, 0x0f,
+	0xa3, 0x53, 0xc8, 0xf6, 0x58, 0x49, 0x50, 0x5c, 0x99, 0x5d, 0x8a, 0x69, 0x18, 0x98, 0x06, 0xb0,
+	0x59, 0x49, 0xa0, 0x29, 0x9c, 0x54, 0x8d, 0xc5, 0x8b, 0x9b, 0x8f, 0x31, 0x47, 0x1e, 0x41, 0x4b,
+	0x66, 0xba, 0xc9, 0x77, 0x47, 0x54, 0xb1, 0x69, 0x86, 0x63, 0xee, 0xcf, 0x2b, 0x9e, 0xdc, 0x60,
+	0xf1, 0x3f, 0x06, 0x5b, 0x2a, 0x24, 0x83, 0xc6, 0x66, 0x70, 0x10, 0xe0, 0xc8, 0x62, 0x9a, 0x4f,
+	0x5e, 0x00, 0x5c, 0x15, 0xb8, 0x4d, 0x28, 0xef, 0x95, 0xd2, 0x65, 0x4c, 0x46, 0x16, 0xab, 0x48,
+	0xbe, 0xea, 0x80, 0xbd, 0xf6, 0x17, 0x2b, 0x35, 0x60, 0xbb, 0xe6, 0xb6, 0x90, 0x92, 0xcf, 0x00,
+	0x62, 0x37, 0xae, 0x77, 0x5d, 0x85, 0x82, 0xb3, 0x23, 0xba, 0x96, 0xb9, 0x80, 0x2e, 0xdb, 0x2a,
+	0x49, 0x1d, 0x32, 0xaa, 0x8a, 0x2b, 0x17, 0x9c, 0x62, 0x4d, 0x7f, 0x68, 0xc2, 0xd1, 0xab, 0x24,
+	0xf2, 0xa7, 0x17, 0x7e, 0xaa, 0x5b, 0xfc, 0xb3, 0x4a, 0x8f, 0x1c, 0x56, 0x21, 0x8e, 0x2c, 0xec,
+	0x8f, 0xc7, 0x79, 0xfd, 0x6f, 0x95, 0x08, 0xe2, 0x52, 0x51, 0x40, 0xbe, 0x9a, 0x08, 0x71, 0x28,
+	0x66, 0xa6, 0x6e, 0x8f, 0x4b, 0x39, 0x75, 0x86, 0x8f, 0x2c, 0x86, 0x5c, 0xf2, 0xa4, 0x9c, 0x28,
+	0xed, 0x9a, 0xc1, 0x3c, 0x00, 0x23, 0xab, 0x36, 0x64, 0x16, 0x72, 0x92, 0x0d, 0xec, 0x9a, 0x49,
+	0x53, 0xd4, 0xca, 0xa4, 0xe2, 0x92, 0xa7, 0xd0, 0x59, 0xe8, 0xce, 0xc3, 0x8b, 0x8d, 0xe3, 0xde,
+	0xa9, 0x0a, 0xe6, 0x5e, 0xe6, 0x32, 0xe4, 0x09, 0xd8, 0x1f, 0x54, 0x8e, 0xf1, 0xae, 0xe3, 0xb8,
+	0x77, 0x4b, 0x47, 0x8b, 0xd4, 0x2b, 0x50, 0x28, 0x43, 0xbe, 0x86, 0x2e, 0xa2, 0x63, 0x3c, 0xc6,
+	0xbb, 0x8f, 0xe3, 0x7e, 0xba, 0x23, 0xb1, 0xf1, 0xe2, 0x66, 0x64, 0xb1, 0x42, 0xb2, 0x4c, 0x6c,
+	0x98, 0x9f, 0x67, 0xba, 0xcd, 0x7f, 0xca, 0xa3, 0xf3, 0x3b, 0xb8, 0xab, 0xb7, 0x1a, 0x0b, 0x3f,
+	0x4e, 0xe7, 0x91, 0xbc, 0x98, 0xaf, 0xc4, 0xbe, 0x0b, 0xd9, 0x97, 0xd0, 0x4a, 0x8a, 0x72, 0xbd,
+	0x6f, 0x50, 0x31, 0xfe, 0xa1, 0xa6, 0xcf, 0x94, 0x0c, 0xfd, 0x35, 0x1e, 0x0a, 0x39, 0x86, 0xc7,
+	0xd0, 0xd1, 0xd3, 0x2a, 0x3f, 0x94, 0x36, 0x66, 0x59, 0xce, 0xa5, 0x02, 0x3a, 0x97, 0x62, 0x8d,
+	0x55, 0xf6, 0x68, 0xff, 0x24, 0x36, 0xb5, 0xf6, 0xa8, 0x5e, 0x6b, 0xb5, 0x59, 0x5b, 0x16, 0x9a,
+	0x3e, 0xde, 0x5a, 0xf9, 0xf1, 0x56, 0x46, 0xfb, 0x39, 0x74, 0xcd, 0x7e, 0xaa, 0xe5, 0xed, 0x50,
+	0xf2, 0x65, 0xee, 0xe2, 0x71, 0x79, 0x40, 0x29, 0x3e, 0xd3, 0x4c, 0xfa, 0xaf, 0x06, 0xb4, 0xd5,
+	0xad, 0xe9, 0xa3, 0x1e, 0x0e, 0x6a, 0xdc, 0xf3, 0xc5, 0x35, 0xd6, 0x73, 0x97, 0xe1, 0xf7, 0xe6,
+	0x63, 0xc2, 0xde, 0xf7, 0x98, 0x38, 0xd8, 0xf7, 0x98, 0x78, 0x0a, 0x5d, 0xe5, 0x20, 0x5e, 0x09,
+	0x7f, 0x0e, 0xb6, 0x6a, 0xe4, 0x1c, 0x93, 0x93, 0x57, 0x22, 0xe7, 0x09, 0xd3, 0x1c, 0xfa, 0xef,
+	0x06, 0x38, 0xef, 0xa2, 0x29, 0x7f, 0xc7, 0x25, 0x5e, 0xf6, 0x28, 0x1c, 0x72, 0x73, 0xf9, 0xab,
+	0xe0, 0xab, 0xd1, 0x54, 0x5d, 0x2d, 0xa2, 0xc0, 0x08, 0xe8, 0x79, 0x52, 0x12, 0xaa, 0xf7, 0xf6,
+	0x16, 0x02, 0xac, 0x3e, 0x52, 0xa2, 0x95, 0xbc, 0x8a, 0x56, 0x62, 0x9a, 0x9a, 0xe7, 0x52, 0x49,
+	0x50, 0x53, 0x28, 0x14, 0x86, 0xa9, 0xe1, 0x17, 0x6b, 0xfa, 0x35, 0x80, 0x72, 0x3a, 0xd5, 0x93,
+	0xfd, 0x17, 0x75, 0x58, 0xfd, 0x0a, 0xac, 0x14, 0xaf, 0xb3, 0x06, 0xdb, 0xdf, 0x1b, 0xd0, 0x2b,
+	0x88, 0x45, 0x26, 0x1a, 0x95, 0x4c, 0x1c, 0x43, 0x33, 0x8c, 0x0d, 0x84, 0x66, 0x18, 0xef, 0x7c,
+	0x0e, 0x6c, 0xcc, 0xcf, 0xf6, 0xf6, 0xfc, 0xac, 0x4f, 0x60, 0x7b, 0x73, 0x02, 0xbb, 0xff, 0xe9,
+	0x82, 0x13, 0xbb, 0xf1, 0x2c, 0x8f, 0xc3, 0x13, 0x70, 0x8a, 0x91, 0x3a, 0xc9, 0x48, 0x6d, 0x88,
+	0x0e, 0x0f, 0x8b, 0xc6, 0x8a, 0x17, 0x37, 0xd4, 0x22, 0x5f, 0xc1, 0x71, 0x21, 0xac, 0xe7, 0xd1,
+	0xe6, 0x44, 0xdd, 0x52, 0x39, 0x85, 0x36, 0x3e, 0x83, 0x36, 0x46, 0xea, 0xb0, 0xba, 0x8e, 0xc4,
+	0x8c, 0x5a, 0xe4, 0x0c, 0x3a, 0xf9, 0x03, 0xe5, 0x4e, 0xc9, 0x34, 0xa4, 0xaa, 0xbc, 0x5a, 0x53,
+	0x8b, 0xbc, 0x00, 0xc7, 0x30, 0xb1, 0xbe, 0x76, 0xe8, 0x90, 0xba, 0x8e, 0x12, 0xa3, 0x16, 0x79,
+	0x0e, 0x9d, 0xfc, 0x75, 0x5b, 0xd1, 0x31, 0xa4, 0x61, 0xbf, 0x46, 0x7a, 0x19, 0xbc, 0xa7, 0x16,
+	0x71, 0x8b, 0x13, 0xce, 0xdd, 0xa5, 0xb2, 0x4d, 0xa2, 0x16, 0x79, 0x0a, 0xce, 0x38, 0xba, 0x96,
+	0xf9, 0x4e, 0x9b, 0xf0, 0xb7, 0x23, 0xdb, 0x2b, 0x9f, 0x28, 0x77, 0x6b, 0x50, 0x34, 0x71, 0x78,
+	0x54, 0x12, 0x2f, 0xc5, 0x9a, 0x5a, 0xe4, 0x1c, 0x40, 0x5f, 0xd9, 0x3d, 0xf5, 0xd6, 0xb8, 0x57,
+	0xd3, 0x31, 0x17, 0xf9, 0x6d, 0xa5, 0xd7, 0xd0, 0x2f, 0xd9, 0xe6, 0xe1, 0x71, 0xbf, 0x14, 0xaa,
+	0x31, 0x86, 0x9f, 0x6c, 0x31, 0xd4, 0x83, 0x82, 0x5a, 0xe4, 0xf7, 0x70, 0x54, 0x7f, 0x2d, 0xdc,
+	0xdf, 0xb5, 0xfb, 0x24, 0x4b, 0x87, 0xa4, 0x8a, 0x73, 0x92, 0x99, 0x14, 0x7c, 0x85, 0xa9, 0xc6,
+	0xd9, 0x5a, 0x4f, 0x9b, 0x22, 0x0d, 0x4f, 0xea, 0xe3, 0x2e, 0xa5, 0xd6, 0xf3, 0x06, 0xf9, 0x0d,
+	0xa2, 0xcd, 0xa7, 0x78, 0x1d, 0xad, 0xa1, 0x56, 0x13, 0x61, 0x48, 0xd4, 0x22, 0xdf, 0x22, 0xe2,
+	0xfa, 0xb9, 0x32, 0xac, 0xa9, 0xd7, 0x78, 0xc3, 0xdc, 0x74, 0x8d, 0x4a, 0x2d, 0xf2, 0x5b, 0x2c,
+	0xb7, 0xe2, 0x67, 0xcd, 0x27, 0x35, 0x13, 0x39, 0x79, 0xb8, 0xe3, 0x41, 0x4b, 0x2d, 0xf2, 0x0d,
+	0xf4, 0xc7, 0x3c, 0x59, 0xf3, 0x64, 0x2c, 0x13, 0xee, 0x2f, 0x19, 0xf7, 0xa7, 0x05, 0x84, 0xda,
+	0x85, 0xa6, 0x48, 0x18, 0xe3, 0x1f, 0xde, 0x85, 0x0b, 0x6a, 0x9d, 0x36, 0xc8, 0xef, 0xea, 0xca,
+	0x63, 0x2e, 0xa6, 0x5b, 0xe5, 0xb4, 0xd3, 0x18, 0xc6, 0xed, 0x1c, 0x8e, 0x2f, 0xa2, 0xc5, 0x82,
+	0x07, 0xf2, 0x52, 0xe0, 0xfc, 0xd9, 0xd2, 0x3d, 0xa9, 0x8c, 0x2c, 0x93, 0x9f, 0x17, 0x70, 0x52,
+	0x57, 0x72, 0xb7, 0xb4, 0xee, 0x54, 0xb4, 0x52, 0x53, 0xc5, 0xaf, 0x3e, 0xff, 0xee, 0x67, 0xb3,
+	0x50, 0xce, 0x57, 0x57, 0x67, 0x41, 0xb4, 0x7c, 0x76, 0x7e, 0x1e, 0x88, 0x67, 0xf8, 0x73, 0xec,
+	0xfc, 0xfc, 0x19, 0x4a, 0x5f, 0x1d, 0xe0, 0x5f, 0xb2, 0xf3, 0xff, 0x0e, 0x00, 0x4f, 0x4f, 0x7f,
+	0x75, 0x6c, 0x13, 0x00, 0x00,
 }
@@ -151,6 +151,46 @@ type Chain33Client interface {
 	GetParaTxByHeight(ctx context.Context, in *ReqParaTxByHeight, opts ...grpc.CallOption) (*ParaTxDetails, error)
 	// 获取区块头信息
 	GetHeaders(ctx context.Context, in *ReqBlocks, opts ...grpc.CallOption) (*Headers, error)
+	// 获取指定高度状态树中key的证明
+	GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error)
+	// 轻节点获取本地保存的区块头，height小于0时返回最新的区块头
+	GetLightHeader(ctx context.Context, in *ReqInt, opts ...grpc.CallOption) (*Header, error)
+	// 通过本地区块头校验交易的merkle证明
+	VerifyTxProof(ctx context.Context, in *TransactionDetail, opts ...grpc.CallOption) (*Reply, error)
+	// 通过本地区块头校验状态证明
+	VerifyStateProof(ctx context.Context, in *StateProof, opts ...grpc.CallOption) (*Reply, error)
+	// 获取最近的链重组事件
+	GetReorgEvents(ctx context.Context, in *ReqReorgEvents, opts ...grpc.CallOption) (*ReorgEvents, error)
+	// 添加签名的检查点
+	AddCheckpoint(ctx context.Context, in *ChainCheckpoint, opts ...grpc.CallOption) (*Reply, error)
+	// 获取所有的检查点
+	GetCheckpoints(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ChainCheckpoints, error)
+	// 订阅url为grpc://的seq callback推送的数据
+	SubBlockSeq(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (Chain33_SubBlockSeqClient, error)
+	// 确认已经处理完成的推送数据
+	AckBlockSeq(ctx context.Context, in *PushSeqAck, opts ...grpc.CallOption) (*Reply, error)
+	// 获取重建索引的进度
+	GetReindexProgress(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ReindexProgress, error)
+	// 获取区块修改的状态key以及修改前后的value
+	GetBlockStateDiff(ctx context.Context, in *ReqBlockStateDiff, opts ...grpc.CallOption) (*StateDiff, error)
+	// 获取两个状态哈希之间的差异
+	GetStateDiff(ctx context.Context, in *ReqStateDiff, opts ...grpc.CallOption) (*StateDiff, error)
+	// 订阅mempool中交易的状态变化事件
+	SubTxEvents(ctx context.Context, in *ReqSubTxEvents, opts ...grpc.CallOption) (Chain33_SubTxEventsClient, error)
+	// 检查交易能否进入mempool, 交易不会被加入mempool
+	CheckTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*ReplyCheckTx, error)
+	// 按照条件查询mempool中的交易
+	QueryMempoolTxs(ctx context.Context, in *ReqMempoolTxs, opts ...grpc.CallOption) (*ReplyMempoolTxs, error)
+	// 从mempool中删除交易, 只允许管理员调用
+	EvictMempoolTxs(ctx context.Context, in *ReqEvictMempoolTxs, opts ...grpc.CallOption) (*TxHashList, error)
+	// mempool中交易的统计
+	GetMempoolStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*MempoolStats, error)
+	// mempool中还没有到达生效高度或者时间的交易
+	GetScheduledTxs(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ReplyTxList, error)
+	// 在当前状态上模拟执行交易, 不会修改任何数据
+	SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error)
+	// 在区块中重新执行交易, 返回执行过程中的读写记录
+	TraceTransaction(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*ReplyTraceTx, error)
 }
 
 type chain33Client struct {
@@ -692,6 +732,232 @@ func (c *chain33Client) GetHeaders(ctx context.Context, in *ReqBlocks, opts ...g
 	return out, nil
 }
 
+func (c *chain33Client) GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetLightHeader(ctx context.Context, in *ReqInt, opts ...grpc.CallOption) (*Header, error) {
+	out := new(Header)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetLightHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) VerifyTxProof(ctx context.Context, in *TransactionDetail, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/types.chain33/VerifyTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) VerifyStateProof(ctx context.Context, in *StateProof, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/types.chain33/VerifyStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetReorgEvents(ctx context.Context, in *ReqReorgEvents, opts ...grpc.CallOption) (*ReorgEvents, error) {
+	out := new(ReorgEvents)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetReorgEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) AddCheckpoint(ctx context.Context, in *ChainCheckpoint, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/types.chain33/AddCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetCheckpoints(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ChainCheckpoints, error) {
+	out := new(ChainCheckpoints)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) SubBlockSeq(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (Chain33_SubBlockSeqClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[0], "/types.chain33/SubBlockSeq", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33SubBlockSeqClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_SubBlockSeqClient interface {
+	Recv() (*PushSeqData, error)
+	grpc.ClientStream
+}
+
+type chain33SubBlockSeqClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33SubBlockSeqClient) Recv() (*PushSeqData, error) {
+	m := new(PushSeqData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chain33Client) AckBlockSeq(ctx context.Context, in *PushSeqAck, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/types.chain33/AckBlockSeq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetReindexProgress(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ReindexProgress, error) {
+	out := new(ReindexProgress)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetReindexProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetBlockStateDiff(ctx context.Context, in *ReqBlockStateDiff, opts ...grpc.CallOption) (*StateDiff, error) {
+	out := new(StateDiff)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetBlockStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetStateDiff(ctx context.Context, in *ReqStateDiff, opts ...grpc.CallOption) (*StateDiff, error) {
+	out := new(StateDiff)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) SubTxEvents(ctx context.Context, in *ReqSubTxEvents, opts ...grpc.CallOption) (Chain33_SubTxEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[1], "/types.chain33/SubTxEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33SubTxEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_SubTxEventsClient interface {
+	Recv() (*TxLifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type chain33SubTxEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33SubTxEventsClient) Recv() (*TxLifecycleEvent, error) {
+	m := new(TxLifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chain33Client) CheckTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*ReplyCheckTx, error) {
+	out := new(ReplyCheckTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/CheckTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) QueryMempoolTxs(ctx context.Context, in *ReqMempoolTxs, opts ...grpc.CallOption) (*ReplyMempoolTxs, error) {
+	out := new(ReplyMempoolTxs)
+	err := c.cc.Invoke(ctx, "/types.chain33/QueryMempoolTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) EvictMempoolTxs(ctx context.Context, in *ReqEvictMempoolTxs, opts ...grpc.CallOption) (*TxHashList, error) {
+	out := new(TxHashList)
+	err := c.cc.Invoke(ctx, "/types.chain33/EvictMempoolTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetMempoolStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*MempoolStats, error) {
+	out := new(MempoolStats)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetMempoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetScheduledTxs(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ReplyTxList, error) {
+	out := new(ReplyTxList)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetScheduledTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error) {
+	out := new(ReplySimulateTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) TraceTransaction(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*ReplyTraceTx, error) {
+	out := new(ReplyTraceTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/TraceTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Chain33Server is the server API for Chain33 service.
 type Chain33Server interface {
 	// chain33 对外提供服务的接口
@@ -807,6 +1073,46 @@ type Chain33Server interface {
 	GetParaTxByHeight(context.Context, *ReqParaTxByHeight) (*ParaTxDetails, error)
 	// 获取区块头信息
 	GetHeaders(context.Context, *ReqBlocks) (*Headers, error)
+	// 获取指定高度状态树中key的证明
+	GetStateProof(context.Context, *ReqStateProof) (*StateProof, error)
+	// 轻节点获取本地保存的区块头，height小于0时返回最新的区块头
+	GetLightHeader(context.Context, *ReqInt) (*Header, error)
+	// 通过本地区块头校验交易的merkle证明
+	VerifyTxProof(context.Context, *TransactionDetail) (*Reply, error)
+	// 通过本地区块头校验状态证明
+	VerifyStateProof(context.Context, *StateProof) (*Reply, error)
+	// 获取最近的链重组事件
+	GetReorgEvents(context.Context, *ReqReorgEvents) (*ReorgEvents, error)
+	// 添加签名的检查点
+	AddCheckpoint(context.Context, *ChainCheckpoint) (*Reply, error)
+	// 获取所有的检查点
+	GetCheckpoints(context.Context, *ReqNil) (*ChainCheckpoints, error)
+	// 订阅url为grpc://的seq callback推送的数据
+	SubBlockSeq(*ReqString, Chain33_SubBlockSeqServer) error
+	// 确认已经处理完成的推送数据
+	AckBlockSeq(context.Context, *PushSeqAck) (*Reply, error)
+	// 获取重建索引的进度
+	GetReindexProgress(context.Context, *ReqNil) (*ReindexProgress, error)
+	// 获取区块修改的状态key以及修改前后的value
+	GetBlockStateDiff(context.Context, *ReqBlockStateDiff) (*StateDiff, error)
+	// 获取两个状态哈希之间的差异
+	GetStateDiff(context.Context, *ReqStateDiff) (*StateDiff, error)
+	// 订阅mempool中交易的状态变化事件
+	SubTxEvents(*ReqSubTxEvents, Chain33_SubTxEventsServer) error
+	// 检查交易能否进入mempool, 交易不会被加入mempool
+	CheckTransaction(context.Context, *Transaction) (*ReplyCheckTx, error)
+	// 按照条件查询mempool中的交易
+	QueryMempoolTxs(context.Context, *ReqMempoolTxs) (*ReplyMempoolTxs, error)
+	// 从mempool中删除交易, 只允许管理员调用
+	EvictMempoolTxs(context.Context, *ReqEvictMempoolTxs) (*TxHashList, error)
+	// mempool中交易的统计
+	GetMempoolStats(context.Context, *ReqNil) (*MempoolStats, error)
+	// mempool中还没有到达生效高度或者时间的交易
+	GetScheduledTxs(context.Context, *ReqNil) (*ReplyTxList, error)
+	// 在当前状态上模拟执行交易, 不会修改任何数据
+	SimulateTransaction(context.Context, *ReqSimulateTx) (*ReplySimulateTx, error)
+	// 在区块中重新执行交易, 返回执行过程中的读写记录
+	TraceTransaction(context.Context, *ReqHash) (*ReplyTraceTx, error)
 }
 
 func RegisterChain33Server(s *grpc.Server, srv Chain33Server) {
@@ -1875,6 +2181,372 @@ func _Chain33_GetHeaders_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateProof(ctx, req.(*ReqStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetLightHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetLightHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetLightHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetLightHeader(ctx, req.(*ReqInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_VerifyTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionDetail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).VerifyTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/VerifyTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).VerifyTxProof(ctx, req.(*TransactionDetail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_VerifyStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).VerifyStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/VerifyStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).VerifyStateProof(ctx, req.(*StateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetReorgEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqReorgEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetReorgEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetReorgEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetReorgEvents(ctx, req.(*ReqReorgEvents))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_AddCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainCheckpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).AddCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/AddCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).AddCheckpoint(ctx, req.(*ChainCheckpoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetCheckpoints(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_SubBlockSeq_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqString)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).SubBlockSeq(m, &chain33SubBlockSeqServer{stream})
+}
+
+type Chain33_SubBlockSeqServer interface {
+	Send(*PushSeqData) error
+	grpc.ServerStream
+}
+
+type chain33SubBlockSeqServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33SubBlockSeqServer) Send(m *PushSeqData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chain33_AckBlockSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushSeqAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).AckBlockSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/AckBlockSeq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).AckBlockSeq(ctx, req.(*PushSeqAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetReindexProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetReindexProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetReindexProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetReindexProgress(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetBlockStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqBlockStateDiff)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetBlockStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetBlockStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetBlockStateDiff(ctx, req.(*ReqBlockStateDiff))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateDiff)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateDiff(ctx, req.(*ReqStateDiff))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_SubTxEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqSubTxEvents)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).SubTxEvents(m, &chain33SubTxEventsServer{stream})
+}
+
+type Chain33_SubTxEventsServer interface {
+	Send(*TxLifecycleEvent) error
+	grpc.ServerStream
+}
+
+type chain33SubTxEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33SubTxEventsServer) Send(m *TxLifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chain33_CheckTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).CheckTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/CheckTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).CheckTransaction(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_QueryMempoolTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqMempoolTxs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).QueryMempoolTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/QueryMempoolTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).QueryMempoolTxs(ctx, req.(*ReqMempoolTxs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_EvictMempoolTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqEvictMempoolTxs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).EvictMempoolTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/EvictMempoolTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).EvictMempoolTxs(ctx, req.(*ReqEvictMempoolTxs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetMempoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetMempoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetMempoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetMempoolStats(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetScheduledTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetScheduledTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetScheduledTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetScheduledTxs(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSimulateTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).SimulateTransaction(ctx, req.(*ReqSimulateTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).TraceTransaction(ctx, req.(*ReqHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Chain33_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.chain33",
 	HandlerType: (*Chain33Server)(nil),
@@ -2115,87 +2787,191 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHeaders",
 			Handler:    _Chain33_GetHeaders_Handler,
 		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Chain33_GetStateProof_Handler,
+		},
+		{
+			MethodName: "GetLightHeader",
+			Handler:    _Chain33_GetLightHeader_Handler,
+		},
+		{
+			MethodName: "VerifyTxProof",
+			Handler:    _Chain33_VerifyTxProof_Handler,
+		},
+		{
+			MethodName: "VerifyStateProof",
+			Handler:    _Chain33_VerifyStateProof_Handler,
+		},
+		{
+			MethodName: "GetReorgEvents",
+			Handler:    _Chain33_GetReorgEvents_Handler,
+		},
+		{
+			MethodName: "AddCheckpoint",
+			Handler:    _Chain33_AddCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetCheckpoints",
+			Handler:    _Chain33_GetCheckpoints_Handler,
+		},
+		{
+			MethodName: "AckBlockSeq",
+			Handler:    _Chain33_AckBlockSeq_Handler,
+		},
+		{
+			MethodName: "GetReindexProgress",
+			Handler:    _Chain33_GetReindexProgress_Handler,
+		},
+		{
+			MethodName: "GetBlockStateDiff",
+			Handler:    _Chain33_GetBlockStateDiff_Handler,
+		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _Chain33_GetStateDiff_Handler,
+		},
+		{
+			MethodName: "CheckTransaction",
+			Handler:    _Chain33_CheckTransaction_Handler,
+		},
+		{
+			MethodName: "QueryMempoolTxs",
+			Handler:    _Chain33_QueryMempoolTxs_Handler,
+		},
+		{
+			MethodName: "EvictMempoolTxs",
+			Handler:    _Chain33_EvictMempoolTxs_Handler,
+		},
+		{
+			MethodName: "GetMempoolStats",
+			Handler:    _Chain33_GetMempoolStats_Handler,
+		},
+		{
+			MethodName: "GetScheduledTxs",
+			Handler:    _Chain33_GetScheduledTxs_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Chain33_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _Chain33_TraceTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubBlockSeq",
+			Handler:       _Chain33_SubBlockSeq_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubTxEvents",
+			Handler:       _Chain33_SubTxEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_f06a632c95a758db) }
-
-var fileDescriptor_rpc_f06a632c95a758db = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x6d, 0x6f, 0xdb, 0x36,
-	0x10, 0xd6, 0x87, 0xad, 0x69, 0x58, 0xc7, 0x71, 0x98, 0x34, 0x6b, 0x84, 0x15, 0x05, 0x04, 0x0c,
-	0x1b, 0x30, 0xd4, 0x4e, 0xed, 0x35, 0x7b, 0x69, 0x37, 0x20, 0x4e, 0x6a, 0xc7, 0x98, 0xeb, 0xb9,
-	0x91, 0xbb, 0x01, 0xfb, 0xc6, 0xc8, 0x37, 0x47, 0x88, 0x2c, 0x2a, 0x22, 0x15, 0xdb, 0xff, 0x6d,
-	0x3f, 0x6e, 0x20, 0xa9, 0x17, 0xd2, 0x92, 0x93, 0xec, 0x9b, 0x79, 0x77, 0xcf, 0xf1, 0x48, 0x3e,
-	0xf7, 0x9c, 0x85, 0xb6, 0xe3, 0xc8, 0x6b, 0x46, 0x31, 0xe5, 0x14, 0x7f, 0xc9, 0x57, 0x11, 0x30,
-	0xbb, 0xe6, 0xd1, 0xf9, 0x9c, 0x86, 0xca, 0x68, 0xef, 0xf1, 0x98, 0x84, 0x8c, 0x78, 0xdc, 0xcf,
-	0x4d, 0x8d, 0xab, 0x80, 0x7a, 0x37, 0xde, 0x35, 0xf1, 0x33, 0x4b, 0x6d, 0x41, 0x82, 0x00, 0x78,
-	0xba, 0xda, 0x8e, 0xda, 0x51, 0xfa, 0x73, 0x87, 0x78, 0x1e, 0x4d, 0xc2, 0xcc, 0x53, 0x87, 0x25,
-	0x78, 0x09, 0xa7, 0xb1, 0x5a, 0xb7, 0xff, 0xb5, 0xd1, 0x96, 0xcc, 0xd3, 0xe9, 0xe0, 0xd7, 0x68,
-	0xbb, 0x0f, 0xbc, 0x2b, 0x52, 0x33, 0xdc, 0x68, 0xca, 0x5a, 0x9a, 0x97, 0x70, 0xab, 0x2c, 0x76,
-	0x2d, 0xb7, 0x44, 0xc1, 0xca, 0xb1, 0x70, 0x0b, 0xed, 0xf4, 0x81, 0x0f, 0x09, 0xe3, 0x17, 0x40,
-	0xa6, 0x10, 0xe3, 0x9d, 0x02, 0x32, 0xf2, 0x03, 0x3b, 0x5b, 0x2a, 0xaf, 0x63, 0xe1, 0x5f, 0xd0,
-	0xc1, 0x59, 0x0c, 0x84, 0xc3, 0x25, 0x59, 0x4c, 0x8a, 0x33, 0xe1, 0xdd, 0x34, 0x50, 0x39, 0x27,
-	0x4b, 0x3b, 0x33, 0x7c, 0x0e, 0x99, 0x3f, 0x0b, 0x27, 0x4b, 0xc7, 0xc2, 0xe7, 0xa8, 0x51, 0x60,
-	0x97, 0xfd, 0x98, 0x26, 0x11, 0x7e, 0x69, 0xe2, 0x8a, 0x8c, 0xd2, 0x5d, 0x95, 0xe5, 0x37, 0xd4,
-	0xf8, 0x94, 0x40, 0xbc, 0xd2, 0x77, 0xaf, 0x17, 0x55, 0x5f, 0x10, 0x76, 0x6d, 0xbf, 0x48, 0xd7,
-	0x5a, 0xcc, 0x39, 0x70, 0xe2, 0x07, 0x8e, 0x85, 0xdf, 0xa2, 0x5d, 0x17, 0xc2, 0xa9, 0x0e, 0xc7,
-	0xe5, 0xf0, 0xd2, 0x4d, 0xfd, 0x8a, 0x0e, 0xfa, 0xc0, 0xb5, 0x88, 0xee, 0xea, 0x74, 0x3a, 0x8d,
-	0xf5, 0xad, 0xc5, 0xda, 0xde, 0xd7, 0x71, 0x93, 0xe5, 0x20, 0xfc, 0x87, 0x32, 0xc7, 0xc2, 0x7d,
-	0x74, 0xb8, 0x0e, 0x17, 0x95, 0x82, 0xf1, 0x48, 0xca, 0x62, 0x1f, 0x6d, 0xaa, 0x5e, 0x24, 0xfa,
-	0x09, 0xa1, 0x3e, 0xf0, 0x8f, 0x30, 0x1f, 0x53, 0x1a, 0xe0, 0x83, 0x02, 0xac, 0xac, 0x11, 0xa5,
-	0x81, 0x8d, 0xcd, 0x1a, 0x86, 0x3e, 0xe3, 0xf2, 0xe0, 0xcf, 0xfa, 0xc0, 0x4f, 0x15, 0x95, 0xd8,
-	0xfa, 0x4b, 0x3f, 0x4f, 0x97, 0x7f, 0x49, 0x0e, 0x66, 0x51, 0xf2, 0xc5, 0xd1, 0x08, 0x16, 0xa9,
-	0x41, 0xdf, 0xb0, 0xb0, 0xda, 0x07, 0x55, 0x60, 0xc7, 0xc2, 0x97, 0xe8, 0xb9, 0x32, 0x69, 0x47,
-	0x11, 0xd5, 0xe0, 0x57, 0x45, 0x9a, 0xca, 0x00, 0xfb, 0xd0, 0xc8, 0x38, 0x59, 0x16, 0x17, 0xd0,
-	0x43, 0x3b, 0x83, 0x79, 0x44, 0x63, 0x3e, 0x8e, 0xfd, 0xbb, 0x1b, 0x58, 0xe5, 0x14, 0xca, 0x73,
-	0x19, 0xee, 0x8d, 0xb5, 0x75, 0xd1, 0x8e, 0xe4, 0x01, 0x15, 0xcf, 0x06, 0x8c, 0x95, 0xf3, 0x18,
-	0x6e, 0xbb, 0xa1, 0x5f, 0xaa, 0x78, 0x29, 0xc7, 0xc2, 0x6d, 0xf4, 0xd4, 0x15, 0xd5, 0xf5, 0x00,
-	0xf0, 0x61, 0x19, 0xce, 0x7b, 0x00, 0x25, 0x22, 0xbd, 0x43, 0x5b, 0xae, 0x68, 0xb9, 0xab, 0x00,
-	0xbf, 0xa8, 0x80, 0x0c, 0xc9, 0x15, 0x04, 0xf7, 0x14, 0x5d, 0xfb, 0x08, 0xf1, 0x0c, 0xba, 0x24,
-	0x20, 0xa1, 0x07, 0xf8, 0xeb, 0xf5, 0x0c, 0xba, 0xd7, 0xe4, 0x81, 0x22, 0x97, 0x63, 0xe1, 0x13,
-	0xb4, 0xed, 0x02, 0x1f, 0x13, 0xc6, 0x16, 0x53, 0x7c, 0x54, 0x51, 0x82, 0x72, 0x95, 0x0a, 0xff,
-	0x06, 0x7d, 0x31, 0xa4, 0xde, 0xcd, 0x3a, 0x71, 0xd6, 0xc3, 0x5e, 0xa3, 0x27, 0x9f, 0x43, 0x19,
-	0xb8, 0x6f, 0x1c, 0x42, 0x19, 0x2b, 0x14, 0x48, 0xb0, 0x72, 0x0c, 0x10, 0x8b, 0x56, 0x59, 0x4f,
-	0x9e, 0xf5, 0xbf, 0xf0, 0xe7, 0x34, 0xae, 0xa7, 0x92, 0x95, 0x35, 0xc1, 0x1a, 0xa6, 0x9a, 0xfd,
-	0xef, 0x51, 0x4d, 0xec, 0x13, 0xd3, 0x08, 0x62, 0xf1, 0x5c, 0x45, 0x9f, 0xde, 0xe6, 0xc6, 0xbc,
-	0x09, 0x24, 0x34, 0x37, 0x3b, 0x16, 0xfe, 0x11, 0xed, 0xf6, 0x81, 0xa7, 0x37, 0xc4, 0x09, 0x4f,
-	0x4a, 0xfd, 0x63, 0x1e, 0x56, 0xc5, 0xc8, 0xee, 0x69, 0x64, 0x7a, 0xfc, 0xc7, 0x1d, 0xc4, 0x77,
-	0x3e, 0x2c, 0x4a, 0x6a, 0x95, 0x3d, 0xb6, 0x11, 0x25, 0x5b, 0x5d, 0x6c, 0x2a, 0xf8, 0x57, 0x05,
-	0x35, 0xd4, 0x46, 0x0f, 0x72, 0x2c, 0xfc, 0x46, 0x1e, 0x56, 0xe6, 0x13, 0x3b, 0xe8, 0xb5, 0x0e,
-	0x42, 0x5e, 0x49, 0xe5, 0x37, 0x68, 0xab, 0x0f, 0xa1, 0x0b, 0x30, 0xcd, 0xe5, 0x30, 0x5d, 0x0f,
-	0x49, 0x38, 0x33, 0x21, 0xc2, 0x9a, 0x41, 0xf8, 0x1a, 0x44, 0xae, 0xbb, 0xab, 0xf1, 0xa2, 0x12,
-	0xd2, 0x42, 0x4f, 0x5d, 0x72, 0x07, 0x12, 0x93, 0xd5, 0x9e, 0x19, 0x24, 0x68, 0x9d, 0x1e, 0x6d,
-	0x29, 0x77, 0x19, 0xdd, 0xf7, 0xb4, 0x81, 0x96, 0x72, 0x3c, 0x63, 0x88, 0xa6, 0x58, 0x6d, 0x84,
-	0xe4, 0x84, 0x38, 0x13, 0x33, 0x31, 0x57, 0x2c, 0xb9, 0xfa, 0x90, 0x4e, 0xce, 0xaa, 0x7d, 0x84,
-	0x4f, 0xbd, 0xde, 0x23, 0x31, 0x27, 0xa8, 0xae, 0xf6, 0xa1, 0x21, 0x83, 0x90, 0x25, 0xec, 0x91,
-	0xb8, 0x9f, 0xd1, 0x5e, 0x69, 0xdc, 0xe5, 0x47, 0xcb, 0x06, 0xe8, 0x20, 0xac, 0x1a, 0x7e, 0xc7,
-	0x92, 0xfc, 0x17, 0xb0, 0x9c, 0x2c, 0xd5, 0x00, 0x29, 0x91, 0xa9, 0x96, 0x4f, 0xec, 0xa5, 0x44,
-	0xbc, 0x45, 0xcf, 0xce, 0x93, 0x79, 0x94, 0x89, 0xa5, 0x36, 0x6d, 0x5c, 0x1e, 0xfb, 0xe1, 0xcc,
-	0x6c, 0x17, 0x65, 0x53, 0xbc, 0xd5, 0x60, 0xac, 0xe7, 0x07, 0x86, 0xc2, 0xe9, 0xf6, 0xd2, 0xf9,
-	0xde, 0x23, 0x6c, 0x48, 0xf0, 0xff, 0x43, 0x37, 0xd1, 0xd6, 0x9f, 0x10, 0x33, 0x71, 0x27, 0x1b,
-	0x1a, 0x3b, 0x75, 0x0b, 0xbd, 0x70, 0x2c, 0xfc, 0x2d, 0x7a, 0x32, 0x60, 0xee, 0x2a, 0xf4, 0x1e,
-	0x12, 0xa6, 0x16, 0xaa, 0x0f, 0xd8, 0x88, 0x47, 0x67, 0xa2, 0x2d, 0x1e, 0x03, 0x68, 0xa2, 0xad,
-	0x11, 0xf0, 0x2a, 0x59, 0xca, 0x2a, 0x19, 0xd1, 0x29, 0xa4, 0x21, 0xf2, 0x71, 0x44, 0xbf, 0xf6,
-	0x08, 0x27, 0x41, 0x8f, 0xf8, 0x41, 0x12, 0xc3, 0xa6, 0x1d, 0x06, 0x21, 0xef, 0xb4, 0xe5, 0xe3,
-	0x1c, 0xa4, 0x5a, 0x26, 0x7b, 0xd5, 0x85, 0xdb, 0x04, 0x04, 0xcf, 0x37, 0xc3, 0x4e, 0x7e, 0x70,
-	0x2c, 0xdc, 0x41, 0x7b, 0xb2, 0xd1, 0x54, 0xf4, 0x03, 0x44, 0xc8, 0x40, 0xef, 0x0a, 0x25, 0xba,
-	0xe7, 0xbf, 0xc7, 0xbe, 0xae, 0x45, 0xc5, 0xd0, 0x3d, 0x96, 0xff, 0x13, 0x53, 0xb0, 0x0b, 0xb7,
-	0xd8, 0xc8, 0x9e, 0x33, 0x35, 0x3b, 0x85, 0x63, 0xe1, 0xef, 0x11, 0x3a, 0x0b, 0x28, 0x83, 0x4f,
-	0x09, 0x24, 0xf0, 0xd0, 0x4d, 0xf7, 0xe4, 0x81, 0x4e, 0x83, 0x40, 0xf4, 0x4c, 0xd6, 0xec, 0xda,
-	0x74, 0x34, 0x3d, 0xb9, 0x4c, 0x9b, 0x66, 0xd9, 0x59, 0xdb, 0xae, 0x3f, 0x0b, 0xe5, 0xff, 0x4b,
-	0x5d, 0xe1, 0x73, 0xa3, 0xa9, 0xf0, 0xb9, 0xd9, 0xb1, 0xf0, 0x00, 0xd9, 0xaa, 0xf5, 0x46, 0x34,
-	0xcd, 0x57, 0xf5, 0x0f, 0xb1, 0x70, 0xde, 0x93, 0xea, 0x04, 0xd5, 0xa4, 0x2e, 0x5c, 0x92, 0x70,
-	0x3a, 0x4a, 0xe6, 0xb8, 0xe8, 0xb0, 0x5b, 0x61, 0x92, 0xaf, 0x53, 0x25, 0xc1, 0xdf, 0x49, 0x3d,
-	0xed, 0xd1, 0xd8, 0x98, 0xb1, 0xbf, 0xc3, 0xaa, 0xf4, 0x96, 0x5d, 0x84, 0xd7, 0x8b, 0x5d, 0xb2,
-	0xfc, 0xc0, 0xba, 0x71, 0x73, 0x95, 0x67, 0x92, 0x0f, 0x63, 0x12, 0x13, 0xa1, 0x25, 0x13, 0x9f,
-	0x07, 0x80, 0xbf, 0xd2, 0x7a, 0x54, 0x77, 0xe4, 0x23, 0x4a, 0x59, 0x0b, 0x5e, 0x0c, 0xd0, 0xde,
-	0x90, 0x92, 0xe9, 0xc6, 0x2c, 0x17, 0xe0, 0xcf, 0xae, 0x79, 0x96, 0xe5, 0xc8, 0x38, 0xb4, 0xee,
-	0x72, 0x2c, 0xfc, 0x41, 0x72, 0x20, 0xcb, 0xa4, 0xbc, 0x3a, 0x07, 0x4c, 0xcf, 0xc6, 0x8a, 0x8e,
-	0xe5, 0xc0, 0x50, 0xdf, 0x2b, 0x55, 0x5f, 0x40, 0x75, 0xe3, 0x8b, 0x86, 0x39, 0x56, 0xf7, 0xd5,
-	0xdf, 0x2f, 0x67, 0x3e, 0xbf, 0x4e, 0xae, 0x9a, 0x1e, 0x9d, 0xb7, 0x3a, 0x1d, 0x2f, 0x6c, 0xa5,
-	0x5f, 0x53, 0x2d, 0x19, 0x7a, 0xf5, 0x44, 0x7e, 0x66, 0x75, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x5a, 0xd4, 0x88, 0x08, 0xe5, 0x0d, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_71877676fa433cbf) }
+
+var fileDescriptor_rpc_71877676fa433cbf = []byte{
+	// 1495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x7b, 0x6f, 0xdb, 0x36,
+	0x10, 0xd7, 0x80, 0xad, 0x69, 0x18, 0x3b, 0x71, 0x98, 0x47, 0x5b, 0x61, 0x45, 0x01, 0x0d, 0xc3,
+	0x06, 0x0c, 0x4d, 0xd2, 0x64, 0xcd, 0xd6, 0xd7, 0x00, 0xe7, 0x61, 0xc7, 0x58, 0xea, 0xb9, 0x91,
+	0xdb, 0x01, 0xfb, 0x8f, 0x91, 0x2e, 0xb6, 0x10, 0x59, 0x54, 0x24, 0x2a, 0x91, 0x3f, 0xf6, 0xbe,
+	0xc1, 0x40, 0x52, 0x0f, 0x52, 0x92, 0x93, 0xee, 0x3f, 0xeb, 0xee, 0x7e, 0xc7, 0x23, 0xef, 0xee,
+	0x77, 0xa4, 0xd1, 0x72, 0x14, 0x3a, 0x3b, 0x61, 0x44, 0x19, 0xc5, 0xdf, 0xb1, 0x79, 0x08, 0xb1,
+	0xd9, 0x72, 0xe8, 0x6c, 0x46, 0x03, 0x29, 0x34, 0xd7, 0x59, 0x44, 0x82, 0x98, 0x38, 0xcc, 0x2b,
+	0x44, 0x9d, 0x4b, 0x9f, 0x3a, 0xd7, 0xce, 0x94, 0x78, 0xb9, 0xa4, 0x75, 0x47, 0x7c, 0x1f, 0x58,
+	0xf6, 0xb5, 0x1c, 0xee, 0x87, 0xd9, 0xcf, 0x36, 0x71, 0x1c, 0x9a, 0x04, 0xb9, 0x66, 0x15, 0x52,
+	0x70, 0x12, 0x46, 0x23, 0xf9, 0xbd, 0xff, 0xef, 0x0f, 0x68, 0x49, 0xf8, 0x39, 0x38, 0xc0, 0x2f,
+	0xd1, 0x72, 0x1f, 0xd8, 0x11, 0x77, 0x1d, 0xe3, 0xce, 0x8e, 0x88, 0x65, 0xe7, 0x02, 0x6e, 0xa4,
+	0xc4, 0x6c, 0x15, 0x92, 0xd0, 0x9f, 0x5b, 0x06, 0xde, 0x45, 0xed, 0x3e, 0xb0, 0x73, 0x12, 0xb3,
+	0x33, 0x20, 0x2e, 0x44, 0xb8, 0x5d, 0x42, 0x86, 0x9e, 0x6f, 0xe6, 0x9f, 0x52, 0x6b, 0x19, 0xf8,
+	0x2d, 0xda, 0x3c, 0x8e, 0x80, 0x30, 0xb8, 0x20, 0x77, 0xe3, 0x72, 0x4f, 0x78, 0x2d, 0x33, 0x94,
+	0xca, 0x71, 0x6a, 0xe6, 0x82, 0xcf, 0x41, 0xec, 0x4d, 0x82, 0x71, 0x6a, 0x19, 0xf8, 0x04, 0x75,
+	0x4a, 0x6c, 0xda, 0x8f, 0x68, 0x12, 0xe2, 0xe7, 0x3a, 0xae, 0xf4, 0x28, 0xd4, 0x4d, 0x5e, 0xfe,
+	0x40, 0x9d, 0x4f, 0x09, 0x44, 0x73, 0x75, 0xf5, 0xd5, 0x32, 0xea, 0x33, 0x12, 0x4f, 0xcd, 0xa7,
+	0xd9, 0xb7, 0x62, 0x73, 0x02, 0x8c, 0x78, 0xbe, 0x65, 0xe0, 0xd7, 0x68, 0xcd, 0x86, 0xc0, 0x55,
+	0xe1, 0xb8, 0x6e, 0x5e, 0x3b, 0xa9, 0x0f, 0x68, 0xb3, 0x0f, 0x4c, 0xb1, 0x38, 0x9a, 0x77, 0x5d,
+	0x37, 0x52, 0x97, 0xe6, 0xdf, 0xe6, 0x86, 0x8a, 0x1b, 0xa7, 0x83, 0xe0, 0x8a, 0xc6, 0x96, 0x81,
+	0xfb, 0x68, 0xbb, 0x0a, 0xe7, 0x91, 0x82, 0x96, 0x24, 0x29, 0x31, 0x9f, 0x2d, 0x8a, 0x9e, 0x3b,
+	0xfa, 0x1d, 0xa1, 0x3e, 0xb0, 0x8f, 0x30, 0x1b, 0x51, 0xea, 0xe3, 0xcd, 0x12, 0x2c, 0xa5, 0x21,
+	0xa5, 0xbe, 0x89, 0xf5, 0x18, 0xce, 0xbd, 0x98, 0x89, 0x8d, 0xaf, 0xf4, 0x81, 0x75, 0x65, 0x29,
+	0xc5, 0xd5, 0x4c, 0x6f, 0x65, 0x9f, 0x7f, 0x8b, 0x1a, 0xcc, 0xad, 0x44, 0xc6, 0xd1, 0x10, 0xee,
+	0x32, 0x81, 0xba, 0x60, 0x29, 0x35, 0x37, 0x9b, 0xc0, 0x96, 0x81, 0x2f, 0xd0, 0x96, 0x14, 0x29,
+	0x5b, 0xe1, 0xd1, 0xe0, 0x17, 0xa5, 0x9b, 0x46, 0x03, 0x73, 0x5b, 0xf3, 0x38, 0x4e, 0xcb, 0x03,
+	0xe8, 0xa1, 0xf6, 0x60, 0x16, 0xd2, 0x88, 0x8d, 0x22, 0xef, 0xf6, 0x1a, 0xe6, 0xf8, 0x79, 0xd5,
+	0x97, 0xa6, 0x5e, 0x18, 0xdb, 0x11, 0x6a, 0x8b, 0x3a, 0xa0, 0x3c, 0x6d, 0x10, 0xc7, 0x75, 0x3f,
+	0x9a, 0xda, 0xec, 0xa8, 0x87, 0xca, 0x33, 0x65, 0x19, 0x78, 0x1f, 0x3d, 0xb6, 0x79, 0x74, 0x3d,
+	0x00, 0xbc, 0x5d, 0x87, 0xb3, 0x1e, 0x40, 0xad, 0x90, 0xde, 0xa1, 0x25, 0x9b, 0xb7, 0xdc, 0xa5,
+	0x8f, 0x9f, 0x36, 0x40, 0xce, 0xc9, 0x25, 0xf8, 0xf7, 0x04, 0xdd, 0xfa, 0x08, 0xd1, 0x04, 0x8e,
+	0x88, 0x4f, 0x02, 0x07, 0xf0, 0xf7, 0x55, 0x0f, 0xaa, 0xd6, 0xc4, 0xd5, 0x90, 0x81, 0x1f, 0xe0,
+	0x21, 0x5a, 0xb6, 0x81, 0x8d, 0x48, 0x1c, 0xdf, 0xb9, 0xf8, 0x59, 0x43, 0x08, 0x52, 0x55, 0x0b,
+	0xfc, 0x47, 0xf4, 0xed, 0x39, 0x75, 0xae, 0xab, 0x85, 0x53, 0x35, 0x7b, 0x89, 0x1e, 0x7d, 0x0e,
+	0x84, 0xe1, 0x86, 0xb6, 0x09, 0x29, 0x6c, 0x60, 0x20, 0x5e, 0x95, 0x23, 0x80, 0x88, 0xb7, 0x4a,
+	0xd5, 0x79, 0xde, 0xff, 0x5c, 0x5f, 0x94, 0xf1, 0x6a, 0x46, 0x59, 0x79, 0x13, 0x54, 0x30, 0xcd,
+	0xd5, 0xff, 0x1e, 0xb5, 0xf8, 0x3a, 0x11, 0x0d, 0x21, 0xe2, 0xe9, 0x2a, 0xfb, 0xf4, 0xa6, 0x10,
+	0x9a, 0x5b, 0x2a, 0xb4, 0x10, 0x5b, 0x06, 0xfe, 0x0d, 0xad, 0xf5, 0x81, 0x65, 0x27, 0xc4, 0x08,
+	0x4b, 0x6a, 0xfd, 0xa3, 0x6f, 0x56, 0xda, 0x88, 0xee, 0xe9, 0xe4, 0x7c, 0xfc, 0xd7, 0x2d, 0x44,
+	0xb7, 0x1e, 0xdc, 0xd5, 0xd8, 0x2a, 0x4f, 0xb6, 0x66, 0x25, 0x5a, 0x9d, 0x2f, 0xca, 0xeb, 0xaf,
+	0x09, 0xaa, 0xb1, 0x8d, 0x6a, 0x64, 0x19, 0xf8, 0x95, 0xd8, 0xac, 0xf0, 0xc7, 0x57, 0x50, 0x63,
+	0x1d, 0x04, 0xac, 0xb1, 0x94, 0x5f, 0xa1, 0xa5, 0x3e, 0x04, 0x36, 0x80, 0x5b, 0xd0, 0x61, 0xf6,
+	0x7d, 0x4e, 0x82, 0x89, 0x0e, 0xe1, 0xd2, 0x1c, 0xc2, 0x2a, 0x10, 0xf1, 0x7d, 0x34, 0x1f, 0xdd,
+	0x35, 0x42, 0x76, 0xd1, 0x63, 0x9b, 0xdc, 0x82, 0xc0, 0xe4, 0xb1, 0xe7, 0x02, 0x01, 0xaa, 0x96,
+	0xc7, 0xbe, 0xa0, 0xbb, 0xbc, 0xdc, 0xd7, 0x95, 0x81, 0x96, 0xd5, 0x78, 0x5e, 0x21, 0x0a, 0x63,
+	0xed, 0x23, 0x24, 0x26, 0xc4, 0x31, 0x9f, 0x89, 0x05, 0x63, 0x89, 0xaf, 0xd3, 0x6c, 0x72, 0x36,
+	0xad, 0xc3, 0x75, 0x32, 0x7b, 0x5f, 0x89, 0x39, 0x44, 0xab, 0x72, 0x1d, 0x1a, 0xc4, 0x10, 0xc4,
+	0x49, 0xfc, 0x95, 0xb8, 0x37, 0x68, 0xbd, 0x36, 0xee, 0x8a, 0xad, 0xe5, 0x03, 0x74, 0x10, 0x34,
+	0x0d, 0xbf, 0x3d, 0x51, 0xfc, 0x67, 0x90, 0x8e, 0x53, 0x39, 0x40, 0x6a, 0xc5, 0xd4, 0x2a, 0x26,
+	0x76, 0x2a, 0x10, 0xaf, 0xd1, 0xca, 0x49, 0x32, 0x0b, 0x73, 0xb2, 0x54, 0xa6, 0x8d, 0xcd, 0x22,
+	0x2f, 0x98, 0xe8, 0xed, 0x22, 0x65, 0xb2, 0x6e, 0x15, 0x58, 0xdc, 0xf3, 0x7c, 0x8d, 0xe1, 0x54,
+	0x79, 0x6d, 0x7f, 0xef, 0x11, 0xd6, 0x28, 0xf8, 0xff, 0xa1, 0x77, 0xd0, 0xd2, 0x17, 0x88, 0x62,
+	0x7e, 0x26, 0x0b, 0x1a, 0x3b, 0x53, 0x73, 0xbe, 0xb0, 0x0c, 0xfc, 0x13, 0x7a, 0x34, 0x88, 0xed,
+	0x79, 0xe0, 0x3c, 0x44, 0x4c, 0xbb, 0x68, 0x75, 0x10, 0x0f, 0x59, 0x78, 0xcc, 0xdb, 0xe2, 0x6b,
+	0x00, 0x3b, 0x68, 0x69, 0x08, 0xac, 0x89, 0x96, 0xf2, 0x48, 0x86, 0xd4, 0x85, 0xcc, 0x44, 0x24,
+	0x87, 0xf7, 0x6b, 0x8f, 0x30, 0xe2, 0xf7, 0x88, 0xe7, 0x27, 0x11, 0x2c, 0x5a, 0x61, 0x10, 0xb0,
+	0x83, 0x7d, 0x91, 0x9c, 0xcd, 0x8c, 0xcb, 0x44, 0xaf, 0xda, 0x70, 0x93, 0x40, 0xe0, 0xdc, 0x07,
+	0x3b, 0xfc, 0xd5, 0x32, 0xf0, 0x01, 0x5a, 0x17, 0x8d, 0x26, 0xad, 0x1f, 0x28, 0x84, 0x1c, 0xf4,
+	0xae, 0x64, 0xa2, 0x7b, 0xee, 0x1e, 0x1b, 0x2a, 0x17, 0x95, 0x43, 0x77, 0x4f, 0xdc, 0x13, 0x33,
+	0xb0, 0x0d, 0x37, 0x58, 0xf3, 0x5e, 0x54, 0x6a, 0xbe, 0x0b, 0xcb, 0xc0, 0xbf, 0x20, 0x74, 0xec,
+	0xd3, 0x18, 0x3e, 0x25, 0x90, 0xc0, 0x43, 0x27, 0xdd, 0x13, 0x1b, 0xea, 0xfa, 0x3e, 0xef, 0x99,
+	0xbc, 0xd9, 0x95, 0xe9, 0xa8, 0x6b, 0x0a, 0x9a, 0xd6, 0xc5, 0xa2, 0xb3, 0x96, 0x6d, 0x6f, 0x12,
+	0x88, 0xfb, 0xa5, 0xca, 0xf0, 0x85, 0x50, 0x67, 0xf8, 0x42, 0x6c, 0x19, 0x78, 0x80, 0x4c, 0xd9,
+	0x7a, 0x43, 0x9a, 0xf9, 0x6b, 0xba, 0x21, 0x96, 0xca, 0x7b, 0x5c, 0x1d, 0xa2, 0x96, 0xe0, 0x85,
+	0x0b, 0x12, 0xb8, 0xc3, 0x64, 0x86, 0xcb, 0x0e, 0xbb, 0xe1, 0x22, 0x91, 0x9d, 0x26, 0x0a, 0xfe,
+	0x59, 0xf0, 0x69, 0x8f, 0x46, 0xda, 0x8c, 0xfd, 0x13, 0xe6, 0xb5, 0x5c, 0x1e, 0x21, 0x5c, 0x0d,
+	0x36, 0x8d, 0x8b, 0x0d, 0xab, 0xc2, 0xc5, 0x51, 0x1e, 0x8b, 0x7a, 0x18, 0x91, 0x88, 0x70, 0x2e,
+	0x19, 0x7b, 0xcc, 0x07, 0xfc, 0x44, 0xe9, 0x51, 0x55, 0x51, 0x8c, 0x28, 0x29, 0x2d, 0xeb, 0x62,
+	0x80, 0xd6, 0xcf, 0x29, 0x71, 0x17, 0x7a, 0x39, 0x03, 0x6f, 0x32, 0x65, 0xb9, 0x97, 0x67, 0xda,
+	0xa6, 0x55, 0x95, 0x65, 0xe0, 0x53, 0x51, 0x03, 0xb9, 0x27, 0xa9, 0x55, 0x6b, 0x40, 0xd7, 0x2c,
+	0x8c, 0x68, 0x4f, 0x0c, 0x0c, 0xf9, 0x5e, 0x69, 0x7a, 0x01, 0xad, 0x6a, 0x2f, 0x1a, 0x39, 0xa2,
+	0xdb, 0x7d, 0x39, 0xb1, 0x61, 0x14, 0x51, 0x7a, 0xa5, 0xde, 0x71, 0x4b, 0xa9, 0x99, 0x13, 0x74,
+	0x29, 0x2a, 0xf8, 0xf8, 0x9c, 0x47, 0x54, 0x7f, 0x40, 0xf1, 0x51, 0x5b, 0x7b, 0x40, 0xbd, 0x41,
+	0xed, 0x2f, 0x10, 0x79, 0x57, 0xf3, 0x71, 0x2a, 0x57, 0x5b, 0xf8, 0x56, 0xa9, 0x75, 0xc9, 0x6b,
+	0xd4, 0x91, 0x50, 0x25, 0xd6, 0x7a, 0x54, 0x0d, 0x2f, 0x17, 0x1e, 0xe3, 0x05, 0xd0, 0x68, 0x72,
+	0x7a, 0x0b, 0xfc, 0xea, 0xbf, 0xa5, 0x14, 0x64, 0x29, 0x56, 0x26, 0x41, 0x21, 0x13, 0x57, 0x9f,
+	0x76, 0xd7, 0x75, 0x8f, 0xa7, 0xe0, 0x5c, 0x87, 0xd4, 0x0b, 0x18, 0xde, 0x56, 0x87, 0x5c, 0x29,
+	0xaf, 0xad, 0xfb, 0x56, 0xac, 0x5b, 0x1a, 0xd4, 0xae, 0x4c, 0x4f, 0x9a, 0x1d, 0xc9, 0x45, 0x57,
+	0xec, 0xe4, 0x32, 0xa7, 0x93, 0x7b, 0xa6, 0xd6, 0x28, 0x89, 0xa7, 0x36, 0xdc, 0x9c, 0x10, 0x46,
+	0x2c, 0x63, 0xef, 0x1b, 0xbc, 0x87, 0x56, 0xba, 0xce, 0x75, 0x01, 0x5c, 0xd7, 0xcd, 0xba, 0x0d,
+	0x17, 0xd0, 0x0f, 0x08, 0x8b, 0xe3, 0xf1, 0x02, 0x17, 0x78, 0x4a, 0x26, 0xe2, 0x31, 0x50, 0x09,
+	0xb5, 0x1c, 0x5e, 0x9a, 0x99, 0x65, 0xe0, 0xae, 0x28, 0x5b, 0xb9, 0x20, 0xcf, 0xc1, 0x89, 0x77,
+	0x75, 0xa5, 0x96, 0xad, 0xae, 0x29, 0xfa, 0xbe, 0x90, 0x88, 0xcd, 0xb6, 0xf2, 0x02, 0x14, 0xe8,
+	0x8d, 0x4a, 0xfd, 0x2d, 0x04, 0x76, 0xc5, 0x29, 0x8d, 0xd3, 0x7a, 0x5a, 0x15, 0x71, 0x71, 0xcc,
+	0xfc, 0x2a, 0x7c, 0x05, 0xce, 0xdc, 0xf1, 0x41, 0x68, 0xc4, 0x79, 0x7d, 0x40, 0x1d, 0x71, 0xf2,
+	0x0f, 0x3d, 0x87, 0xb5, 0x67, 0xad, 0x44, 0xa4, 0x22, 0x82, 0x35, 0x41, 0x75, 0xd9, 0xcb, 0x93,
+	0xb3, 0x90, 0xd2, 0x3d, 0xa5, 0x54, 0x39, 0xc0, 0xd0, 0x57, 0xac, 0xa5, 0x8b, 0xd3, 0x5b, 0xcf,
+	0x61, 0x8a, 0x0b, 0xe5, 0x51, 0x52, 0x51, 0x15, 0x5d, 0x38, 0x4e, 0x39, 0x69, 0x66, 0x77, 0x7b,
+	0x79, 0x3b, 0xcf, 0xac, 0xf8, 0x01, 0x2d, 0xbc, 0x9d, 0xab, 0x36, 0x82, 0xa9, 0x39, 0xd0, 0x76,
+	0xa6, 0xe0, 0x26, 0x3e, 0xb8, 0xe3, 0xb4, 0x06, 0x6c, 0x7e, 0x4c, 0x9c, 0xa2, 0x0d, 0xdb, 0x9b,
+	0x25, 0x7e, 0xe5, 0x0e, 0xa7, 0x12, 0x47, 0xae, 0x4e, 0xf5, 0xad, 0x97, 0x72, 0xc1, 0x05, 0x9d,
+	0x71, 0x44, 0xf4, 0x49, 0x53, 0x1d, 0xe3, 0xfa, 0xff, 0x09, 0xc2, 0x3a, 0xb5, 0x8c, 0xa3, 0x17,
+	0xff, 0x3c, 0x9f, 0x78, 0x6c, 0x9a, 0x5c, 0xee, 0x38, 0x74, 0xb6, 0x7b, 0x70, 0xe0, 0x04, 0xbb,
+	0xd9, 0x5f, 0x40, 0xbb, 0xc2, 0xfe, 0xf2, 0x91, 0xf8, 0x6f, 0xe8, 0xe0, 0xbf, 0x01, 0x00, 0xd3,
+	0xb3, 0x00, 0x42, 0x9a, 0x12, 0x00, 0x00,
 }
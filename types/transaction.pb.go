@@ -35,7 +35,7 @@ func (m *AssetsGenesis) Reset()         { *m = AssetsGenesis{} }
 func (m *AssetsGenesis) String() string { return proto.CompactTextString(m) }
 func (*AssetsGenesis) ProtoMessage()    {}
 func (*AssetsGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{0}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{0}
 }
 func (m *AssetsGenesis) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AssetsGenesis.Unmarshal(m, b)
@@ -84,7 +84,7 @@ func (m *AssetsTransferToExec) Reset()         { *m = AssetsTransferToExec{} }
 func (m *AssetsTransferToExec) String() string { return proto.CompactTextString(m) }
 func (*AssetsTransferToExec) ProtoMessage()    {}
 func (*AssetsTransferToExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{1}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{1}
 }
 func (m *AssetsTransferToExec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AssetsTransferToExec.Unmarshal(m, b)
@@ -154,7 +154,7 @@ func (m *AssetsWithdraw) Reset()         { *m = AssetsWithdraw{} }
 func (m *AssetsWithdraw) String() string { return proto.CompactTextString(m) }
 func (*AssetsWithdraw) ProtoMessage()    {}
 func (*AssetsWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{2}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{2}
 }
 func (m *AssetsWithdraw) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AssetsWithdraw.Unmarshal(m, b)
@@ -223,7 +223,7 @@ func (m *AssetsTransfer) Reset()         { *m = AssetsTransfer{} }
 func (m *AssetsTransfer) String() string { return proto.CompactTextString(m) }
 func (*AssetsTransfer) ProtoMessage()    {}
 func (*AssetsTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{3}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{3}
 }
 func (m *AssetsTransfer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AssetsTransfer.Unmarshal(m, b)
@@ -284,7 +284,7 @@ func (m *Asset) Reset()         { *m = Asset{} }
 func (m *Asset) String() string { return proto.CompactTextString(m) }
 func (*Asset) ProtoMessage()    {}
 func (*Asset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{4}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{4}
 }
 func (m *Asset) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Asset.Unmarshal(m, b)
@@ -344,7 +344,7 @@ func (m *CreateTx) Reset()         { *m = CreateTx{} }
 func (m *CreateTx) String() string { return proto.CompactTextString(m) }
 func (*CreateTx) ProtoMessage()    {}
 func (*CreateTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{5}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{5}
 }
 func (m *CreateTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTx.Unmarshal(m, b)
@@ -443,7 +443,7 @@ func (m *ReWriteRawTx) Reset()         { *m = ReWriteRawTx{} }
 func (m *ReWriteRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReWriteRawTx) ProtoMessage()    {}
 func (*ReWriteRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{6}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{6}
 }
 func (m *ReWriteRawTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReWriteRawTx.Unmarshal(m, b)
@@ -509,7 +509,7 @@ func (m *CreateTransactionGroup) Reset()         { *m = CreateTransactionGroup{}
 func (m *CreateTransactionGroup) String() string { return proto.CompactTextString(m) }
 func (*CreateTransactionGroup) ProtoMessage()    {}
 func (*CreateTransactionGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{7}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{7}
 }
 func (m *CreateTransactionGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTransactionGroup.Unmarshal(m, b)
@@ -547,7 +547,7 @@ func (m *UnsignTx) Reset()         { *m = UnsignTx{} }
 func (m *UnsignTx) String() string { return proto.CompactTextString(m) }
 func (*UnsignTx) ProtoMessage()    {}
 func (*UnsignTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{8}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{8}
 }
 func (m *UnsignTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnsignTx.Unmarshal(m, b)
@@ -589,7 +589,7 @@ func (m *NoBalanceTxs) Reset()         { *m = NoBalanceTxs{} }
 func (m *NoBalanceTxs) String() string { return proto.CompactTextString(m) }
 func (*NoBalanceTxs) ProtoMessage()    {}
 func (*NoBalanceTxs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{9}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{9}
 }
 func (m *NoBalanceTxs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NoBalanceTxs.Unmarshal(m, b)
@@ -652,7 +652,7 @@ func (m *NoBalanceTx) Reset()         { *m = NoBalanceTx{} }
 func (m *NoBalanceTx) String() string { return proto.CompactTextString(m) }
 func (*NoBalanceTx) ProtoMessage()    {}
 func (*NoBalanceTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{10}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{10}
 }
 func (m *NoBalanceTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NoBalanceTx.Unmarshal(m, b)
@@ -709,10 +709,14 @@ type Transaction struct {
 	// 随机ID，可以防止payload 相同的时候，交易重复
 	Nonce int64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// 对方地址，如果没有对方地址，可以为空
-	To                   string   `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
-	GroupCount           int32    `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
-	Header               []byte   `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
-	Next                 []byte   `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+	To         string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	GroupCount int32  `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
+	Header     []byte `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
+	Next       []byte `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+	// 替换mempool中同一个账户发送的未打包交易，手续费需要比原交易高出一定比例
+	ReplaceHash []byte `protobuf:"bytes,11,opt,name=replaceHash,proto3" json:"replaceHash,omitempty"`
+	// 交易生效的区块高度或者区块时间，小于ExpireBound为高度，否则为时间，之前不能被打包
+	NotBefore            int64    `protobuf:"varint,12,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -722,7 +726,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{11}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{11}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -812,6 +816,20 @@ func (m *Transaction) GetNext() []byte {
 	return nil
 }
 
+func (m *Transaction) GetReplaceHash() []byte {
+	if m != nil {
+		return m.ReplaceHash
+	}
+	return nil
+}
+
+func (m *Transaction) GetNotBefore() int64 {
+	if m != nil {
+		return m.NotBefore
+	}
+	return 0
+}
+
 type Transactions struct {
 	Txs                  []*Transaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -823,7 +841,7 @@ func (m *Transactions) Reset()         { *m = Transactions{} }
 func (m *Transactions) String() string { return proto.CompactTextString(m) }
 func (*Transactions) ProtoMessage()    {}
 func (*Transactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{12}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{12}
 }
 func (m *Transactions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transactions.Unmarshal(m, b)
@@ -862,7 +880,7 @@ func (m *RingSignature) Reset()         { *m = RingSignature{} }
 func (m *RingSignature) String() string { return proto.CompactTextString(m) }
 func (*RingSignature) ProtoMessage()    {}
 func (*RingSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{13}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{13}
 }
 func (m *RingSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RingSignature.Unmarshal(m, b)
@@ -902,7 +920,7 @@ func (m *RingSignatureItem) Reset()         { *m = RingSignatureItem{} }
 func (m *RingSignatureItem) String() string { return proto.CompactTextString(m) }
 func (*RingSignatureItem) ProtoMessage()    {}
 func (*RingSignatureItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{14}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{14}
 }
 func (m *RingSignatureItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RingSignatureItem.Unmarshal(m, b)
@@ -960,7 +978,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{15}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{15}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Signature.Unmarshal(m, b)
@@ -1014,7 +1032,7 @@ func (m *AddrOverview) Reset()         { *m = AddrOverview{} }
 func (m *AddrOverview) String() string { return proto.CompactTextString(m) }
 func (*AddrOverview) ProtoMessage()    {}
 func (*AddrOverview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{16}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{16}
 }
 func (m *AddrOverview) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrOverview.Unmarshal(m, b)
@@ -1072,7 +1090,7 @@ func (m *ReqAddr) Reset()         { *m = ReqAddr{} }
 func (m *ReqAddr) String() string { return proto.CompactTextString(m) }
 func (*ReqAddr) ProtoMessage()    {}
 func (*ReqAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{17}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{17}
 }
 func (m *ReqAddr) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAddr.Unmarshal(m, b)
@@ -1145,7 +1163,7 @@ func (m *HexTx) Reset()         { *m = HexTx{} }
 func (m *HexTx) String() string { return proto.CompactTextString(m) }
 func (*HexTx) ProtoMessage()    {}
 func (*HexTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{18}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{18}
 }
 func (m *HexTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HexTx.Unmarshal(m, b)
@@ -1186,250 +1204,1187 @@ func (m *ReplyTxInfo) Reset()         { *m = ReplyTxInfo{} }
 func (m *ReplyTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfo) ProtoMessage()    {}
 func (*ReplyTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{19}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{19}
 }
 func (m *ReplyTxInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTxInfo.Unmarshal(m, b)
 }
-func (m *ReplyTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTxInfo.Marshal(b, m, deterministic)
+func (m *ReplyTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTxInfo.Marshal(b, m, deterministic)
+}
+func (dst *ReplyTxInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTxInfo.Merge(dst, src)
+}
+func (m *ReplyTxInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplyTxInfo.Size(m)
+}
+func (m *ReplyTxInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTxInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTxInfo proto.InternalMessageInfo
+
+func (m *ReplyTxInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ReplyTxInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyTxInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReplyTxInfo) GetAssets() []*Asset {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+type ReqTxList struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTxList) Reset()         { *m = ReqTxList{} }
+func (m *ReqTxList) String() string { return proto.CompactTextString(m) }
+func (*ReqTxList) ProtoMessage()    {}
+func (*ReqTxList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{20}
+}
+func (m *ReqTxList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTxList.Unmarshal(m, b)
+}
+func (m *ReqTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTxList.Marshal(b, m, deterministic)
+}
+func (dst *ReqTxList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTxList.Merge(dst, src)
+}
+func (m *ReqTxList) XXX_Size() int {
+	return xxx_messageInfo_ReqTxList.Size(m)
+}
+func (m *ReqTxList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTxList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTxList proto.InternalMessageInfo
+
+func (m *ReqTxList) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyTxList struct {
+	Txs                  []*Transaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyTxList) Reset()         { *m = ReplyTxList{} }
+func (m *ReplyTxList) String() string { return proto.CompactTextString(m) }
+func (*ReplyTxList) ProtoMessage()    {}
+func (*ReplyTxList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{21}
+}
+func (m *ReplyTxList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTxList.Unmarshal(m, b)
+}
+func (m *ReplyTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTxList.Marshal(b, m, deterministic)
+}
+func (dst *ReplyTxList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTxList.Merge(dst, src)
+}
+func (m *ReplyTxList) XXX_Size() int {
+	return xxx_messageInfo_ReplyTxList.Size(m)
+}
+func (m *ReplyTxList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTxList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTxList proto.InternalMessageInfo
+
+func (m *ReplyTxList) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ReqGetMempool struct {
+	IsAll                bool     `protobuf:"varint,1,opt,name=isAll,proto3" json:"isAll,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGetMempool) Reset()         { *m = ReqGetMempool{} }
+func (m *ReqGetMempool) String() string { return proto.CompactTextString(m) }
+func (*ReqGetMempool) ProtoMessage()    {}
+func (*ReqGetMempool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{22}
+}
+func (m *ReqGetMempool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGetMempool.Unmarshal(m, b)
+}
+func (m *ReqGetMempool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGetMempool.Marshal(b, m, deterministic)
+}
+func (dst *ReqGetMempool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGetMempool.Merge(dst, src)
+}
+func (m *ReqGetMempool) XXX_Size() int {
+	return xxx_messageInfo_ReqGetMempool.Size(m)
+}
+func (m *ReqGetMempool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGetMempool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGetMempool proto.InternalMessageInfo
+
+func (m *ReqGetMempool) GetIsAll() bool {
+	if m != nil {
+		return m.IsAll
+	}
+	return false
+}
+
+// 按照条件查询mempool中的交易, 条件为空时不过滤, 按照排队策略的顺序分页返回
+type ReqMempoolTxs struct {
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Execer string `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	// 手续费范围, maxFee为0时不限制
+	MinFee int64 `protobuf:"varint,3,opt,name=minFee,proto3" json:"minFee,omitempty"`
+	MaxFee int64 `protobuf:"varint,4,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	// 交易进入mempool的时间范围(秒), maxAge为0时不限制
+	MinAge               int64    `protobuf:"varint,5,opt,name=minAge,proto3" json:"minAge,omitempty"`
+	MaxAge               int64    `protobuf:"varint,6,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	Offset               int64    `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count                int64    `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMempoolTxs) Reset()         { *m = ReqMempoolTxs{} }
+func (m *ReqMempoolTxs) String() string { return proto.CompactTextString(m) }
+func (*ReqMempoolTxs) ProtoMessage()    {}
+func (*ReqMempoolTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{23}
+}
+func (m *ReqMempoolTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMempoolTxs.Unmarshal(m, b)
+}
+func (m *ReqMempoolTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMempoolTxs.Marshal(b, m, deterministic)
+}
+func (dst *ReqMempoolTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMempoolTxs.Merge(dst, src)
+}
+func (m *ReqMempoolTxs) XXX_Size() int {
+	return xxx_messageInfo_ReqMempoolTxs.Size(m)
+}
+func (m *ReqMempoolTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMempoolTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMempoolTxs proto.InternalMessageInfo
+
+func (m *ReqMempoolTxs) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ReqMempoolTxs) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqMempoolTxs) GetMinFee() int64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *ReqMempoolTxs) GetMaxFee() int64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+func (m *ReqMempoolTxs) GetMinAge() int64 {
+	if m != nil {
+		return m.MinAge
+	}
+	return 0
+}
+
+func (m *ReqMempoolTxs) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *ReqMempoolTxs) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReqMempoolTxs) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type MempoolTx struct {
+	Tx                   *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	EnterTime            int64        `protobuf:"varint,2,opt,name=enterTime,proto3" json:"enterTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MempoolTx) Reset()         { *m = MempoolTx{} }
+func (m *MempoolTx) String() string { return proto.CompactTextString(m) }
+func (*MempoolTx) ProtoMessage()    {}
+func (*MempoolTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{24}
+}
+func (m *MempoolTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolTx.Unmarshal(m, b)
+}
+func (m *MempoolTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolTx.Marshal(b, m, deterministic)
+}
+func (dst *MempoolTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTx.Merge(dst, src)
+}
+func (m *MempoolTx) XXX_Size() int {
+	return xxx_messageInfo_MempoolTx.Size(m)
+}
+func (m *MempoolTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTx proto.InternalMessageInfo
+
+func (m *MempoolTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MempoolTx) GetEnterTime() int64 {
+	if m != nil {
+		return m.EnterTime
+	}
+	return 0
+}
+
+type ReplyMempoolTxs struct {
+	Txs []*MempoolTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// 满足条件的交易总数
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyMempoolTxs) Reset()         { *m = ReplyMempoolTxs{} }
+func (m *ReplyMempoolTxs) String() string { return proto.CompactTextString(m) }
+func (*ReplyMempoolTxs) ProtoMessage()    {}
+func (*ReplyMempoolTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{25}
+}
+func (m *ReplyMempoolTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMempoolTxs.Unmarshal(m, b)
+}
+func (m *ReplyMempoolTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMempoolTxs.Marshal(b, m, deterministic)
+}
+func (dst *ReplyMempoolTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMempoolTxs.Merge(dst, src)
+}
+func (m *ReplyMempoolTxs) XXX_Size() int {
+	return xxx_messageInfo_ReplyMempoolTxs.Size(m)
+}
+func (m *ReplyMempoolTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMempoolTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMempoolTxs proto.InternalMessageInfo
+
+func (m *ReplyMempoolTxs) GetTxs() []*MempoolTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *ReplyMempoolTxs) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// 从mempool中删除指定哈希的交易以及地址发送的所有交易
+type ReqEvictMempoolTxs struct {
+	Hashes               [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqEvictMempoolTxs) Reset()         { *m = ReqEvictMempoolTxs{} }
+func (m *ReqEvictMempoolTxs) String() string { return proto.CompactTextString(m) }
+func (*ReqEvictMempoolTxs) ProtoMessage()    {}
+func (*ReqEvictMempoolTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{26}
+}
+func (m *ReqEvictMempoolTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqEvictMempoolTxs.Unmarshal(m, b)
+}
+func (m *ReqEvictMempoolTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqEvictMempoolTxs.Marshal(b, m, deterministic)
+}
+func (dst *ReqEvictMempoolTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqEvictMempoolTxs.Merge(dst, src)
+}
+func (m *ReqEvictMempoolTxs) XXX_Size() int {
+	return xxx_messageInfo_ReqEvictMempoolTxs.Size(m)
+}
+func (m *ReqEvictMempoolTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqEvictMempoolTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqEvictMempoolTxs proto.InternalMessageInfo
+
+func (m *ReqEvictMempoolTxs) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *ReqEvictMempoolTxs) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// 手续费率在[minFeeRate, maxFeeRate)之间的交易数目, maxFeeRate为0时不限制
+type MempoolFeeBucket struct {
+	MinFeeRate           int64    `protobuf:"varint,1,opt,name=minFeeRate,proto3" json:"minFeeRate,omitempty"`
+	MaxFeeRate           int64    `protobuf:"varint,2,opt,name=maxFeeRate,proto3" json:"maxFeeRate,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolFeeBucket) Reset()         { *m = MempoolFeeBucket{} }
+func (m *MempoolFeeBucket) String() string { return proto.CompactTextString(m) }
+func (*MempoolFeeBucket) ProtoMessage()    {}
+func (*MempoolFeeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{27}
+}
+func (m *MempoolFeeBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolFeeBucket.Unmarshal(m, b)
+}
+func (m *MempoolFeeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolFeeBucket.Marshal(b, m, deterministic)
+}
+func (dst *MempoolFeeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolFeeBucket.Merge(dst, src)
+}
+func (m *MempoolFeeBucket) XXX_Size() int {
+	return xxx_messageInfo_MempoolFeeBucket.Size(m)
+}
+func (m *MempoolFeeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolFeeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolFeeBucket proto.InternalMessageInfo
+
+func (m *MempoolFeeBucket) GetMinFeeRate() int64 {
+	if m != nil {
+		return m.MinFeeRate
+	}
+	return 0
+}
+
+func (m *MempoolFeeBucket) GetMaxFeeRate() int64 {
+	if m != nil {
+		return m.MaxFeeRate
+	}
+	return 0
+}
+
+func (m *MempoolFeeBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type MempoolExecStat struct {
+	Execer               string   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Bytes                int64    `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolExecStat) Reset()         { *m = MempoolExecStat{} }
+func (m *MempoolExecStat) String() string { return proto.CompactTextString(m) }
+func (*MempoolExecStat) ProtoMessage()    {}
+func (*MempoolExecStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{28}
+}
+func (m *MempoolExecStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolExecStat.Unmarshal(m, b)
+}
+func (m *MempoolExecStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolExecStat.Marshal(b, m, deterministic)
+}
+func (dst *MempoolExecStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolExecStat.Merge(dst, src)
+}
+func (m *MempoolExecStat) XXX_Size() int {
+	return xxx_messageInfo_MempoolExecStat.Size(m)
+}
+func (m *MempoolExecStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolExecStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolExecStat proto.InternalMessageInfo
+
+func (m *MempoolExecStat) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MempoolExecStat) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MempoolExecStat) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type MempoolStats struct {
+	TxCount      int64               `protobuf:"varint,1,opt,name=txCount,proto3" json:"txCount,omitempty"`
+	TotalBytes   int64               `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	FeeHistogram []*MempoolFeeBucket `protobuf:"bytes,3,rep,name=feeHistogram,proto3" json:"feeHistogram,omitempty"`
+	Execs        []*MempoolExecStat  `protobuf:"bytes,4,rep,name=execs,proto3" json:"execs,omitempty"`
+	// 最早进入mempool的交易已经等待的时间(秒)
+	OldestAge            int64    `protobuf:"varint,5,opt,name=oldestAge,proto3" json:"oldestAge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolStats) Reset()         { *m = MempoolStats{} }
+func (m *MempoolStats) String() string { return proto.CompactTextString(m) }
+func (*MempoolStats) ProtoMessage()    {}
+func (*MempoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{29}
+}
+func (m *MempoolStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolStats.Unmarshal(m, b)
+}
+func (m *MempoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolStats.Marshal(b, m, deterministic)
+}
+func (dst *MempoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolStats.Merge(dst, src)
+}
+func (m *MempoolStats) XXX_Size() int {
+	return xxx_messageInfo_MempoolStats.Size(m)
+}
+func (m *MempoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolStats proto.InternalMessageInfo
+
+func (m *MempoolStats) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MempoolStats) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *MempoolStats) GetFeeHistogram() []*MempoolFeeBucket {
+	if m != nil {
+		return m.FeeHistogram
+	}
+	return nil
+}
+
+func (m *MempoolStats) GetExecs() []*MempoolExecStat {
+	if m != nil {
+		return m.Execs
+	}
+	return nil
+}
+
+func (m *MempoolStats) GetOldestAge() int64 {
+	if m != nil {
+		return m.OldestAge
+	}
+	return 0
+}
+
+type ReqProperFee struct {
+	TxCount              int32    `protobuf:"varint,1,opt,name=txCount,proto3" json:"txCount,omitempty"`
+	TxSize               int32    `protobuf:"varint,2,opt,name=txSize,proto3" json:"txSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqProperFee) Reset()         { *m = ReqProperFee{} }
+func (m *ReqProperFee) String() string { return proto.CompactTextString(m) }
+func (*ReqProperFee) ProtoMessage()    {}
+func (*ReqProperFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{30}
+}
+func (m *ReqProperFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqProperFee.Unmarshal(m, b)
+}
+func (m *ReqProperFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqProperFee.Marshal(b, m, deterministic)
+}
+func (dst *ReqProperFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqProperFee.Merge(dst, src)
+}
+func (m *ReqProperFee) XXX_Size() int {
+	return xxx_messageInfo_ReqProperFee.Size(m)
+}
+func (m *ReqProperFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqProperFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqProperFee proto.InternalMessageInfo
+
+func (m *ReqProperFee) GetTxCount() int32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *ReqProperFee) GetTxSize() int32 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+type ReplyProperFee struct {
+	ProperFee            int64    `protobuf:"varint,1,opt,name=properFee,proto3" json:"properFee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyProperFee) Reset()         { *m = ReplyProperFee{} }
+func (m *ReplyProperFee) String() string { return proto.CompactTextString(m) }
+func (*ReplyProperFee) ProtoMessage()    {}
+func (*ReplyProperFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{31}
+}
+func (m *ReplyProperFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyProperFee.Unmarshal(m, b)
+}
+func (m *ReplyProperFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyProperFee.Marshal(b, m, deterministic)
+}
+func (dst *ReplyProperFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyProperFee.Merge(dst, src)
+}
+func (m *ReplyProperFee) XXX_Size() int {
+	return xxx_messageInfo_ReplyProperFee.Size(m)
+}
+func (m *ReplyProperFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyProperFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyProperFee proto.InternalMessageInfo
+
+func (m *ReplyProperFee) GetProperFee() int64 {
+	if m != nil {
+		return m.ProperFee
+	}
+	return 0
+}
+
+// mempool中交易的状态变化事件
+type TxLifecycleEvent struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Ty                   int32    `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	ReplacedBy           []byte   `protobuf:"bytes,6,opt,name=replacedBy,proto3" json:"replacedBy,omitempty"`
+	Time                 int64    `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxLifecycleEvent) Reset()         { *m = TxLifecycleEvent{} }
+func (m *TxLifecycleEvent) String() string { return proto.CompactTextString(m) }
+func (*TxLifecycleEvent) ProtoMessage()    {}
+func (*TxLifecycleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{32}
+}
+func (m *TxLifecycleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxLifecycleEvent.Unmarshal(m, b)
+}
+func (m *TxLifecycleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxLifecycleEvent.Marshal(b, m, deterministic)
+}
+func (dst *TxLifecycleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxLifecycleEvent.Merge(dst, src)
+}
+func (m *TxLifecycleEvent) XXX_Size() int {
+	return xxx_messageInfo_TxLifecycleEvent.Size(m)
+}
+func (m *TxLifecycleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxLifecycleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxLifecycleEvent proto.InternalMessageInfo
+
+func (m *TxLifecycleEvent) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxLifecycleEvent) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TxLifecycleEvent) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *TxLifecycleEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TxLifecycleEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxLifecycleEvent) GetReplacedBy() []byte {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return nil
+}
+
+func (m *TxLifecycleEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type TxLifecycleEvents struct {
+	Events               []*TxLifecycleEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TxLifecycleEvents) Reset()         { *m = TxLifecycleEvents{} }
+func (m *TxLifecycleEvents) String() string { return proto.CompactTextString(m) }
+func (*TxLifecycleEvents) ProtoMessage()    {}
+func (*TxLifecycleEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{33}
+}
+func (m *TxLifecycleEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxLifecycleEvents.Unmarshal(m, b)
+}
+func (m *TxLifecycleEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxLifecycleEvents.Marshal(b, m, deterministic)
+}
+func (dst *TxLifecycleEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxLifecycleEvents.Merge(dst, src)
+}
+func (m *TxLifecycleEvents) XXX_Size() int {
+	return xxx_messageInfo_TxLifecycleEvents.Size(m)
+}
+func (m *TxLifecycleEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxLifecycleEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxLifecycleEvents proto.InternalMessageInfo
+
+func (m *TxLifecycleEvents) GetEvents() []*TxLifecycleEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// 按照交易哈希或者地址(发送方或者接收方)订阅交易的状态变化事件, 都为空时订阅所有交易
+type ReqSubTxEvents struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hashes               [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Addrs                []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSubTxEvents) Reset()         { *m = ReqSubTxEvents{} }
+func (m *ReqSubTxEvents) String() string { return proto.CompactTextString(m) }
+func (*ReqSubTxEvents) ProtoMessage()    {}
+func (*ReqSubTxEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{34}
+}
+func (m *ReqSubTxEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSubTxEvents.Unmarshal(m, b)
+}
+func (m *ReqSubTxEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSubTxEvents.Marshal(b, m, deterministic)
+}
+func (dst *ReqSubTxEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSubTxEvents.Merge(dst, src)
+}
+func (m *ReqSubTxEvents) XXX_Size() int {
+	return xxx_messageInfo_ReqSubTxEvents.Size(m)
+}
+func (m *ReqSubTxEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSubTxEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSubTxEvents proto.InternalMessageInfo
+
+func (m *ReqSubTxEvents) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReqSubTxEvents) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *ReqSubTxEvents) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+// mempool准入检查的一个阶段, error为空表示检查通过
+type TxCheckStage struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxCheckStage) Reset()         { *m = TxCheckStage{} }
+func (m *TxCheckStage) String() string { return proto.CompactTextString(m) }
+func (*TxCheckStage) ProtoMessage()    {}
+func (*TxCheckStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{35}
+}
+func (m *TxCheckStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxCheckStage.Unmarshal(m, b)
+}
+func (m *TxCheckStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxCheckStage.Marshal(b, m, deterministic)
+}
+func (dst *TxCheckStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxCheckStage.Merge(dst, src)
+}
+func (m *TxCheckStage) XXX_Size() int {
+	return xxx_messageInfo_TxCheckStage.Size(m)
+}
+func (m *TxCheckStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxCheckStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxCheckStage proto.InternalMessageInfo
+
+func (m *TxCheckStage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TxCheckStage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// 模拟交易进入mempool的检查结果, 交易不会加入mempool, properFee为当前建议的手续费
+type ReplyCheckTx struct {
+	Ok                   bool            `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Stages               []*TxCheckStage `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	ProperFee            int64           `protobuf:"varint,3,opt,name=properFee,proto3" json:"properFee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyCheckTx) Reset()         { *m = ReplyCheckTx{} }
+func (m *ReplyCheckTx) String() string { return proto.CompactTextString(m) }
+func (*ReplyCheckTx) ProtoMessage()    {}
+func (*ReplyCheckTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{36}
+}
+func (m *ReplyCheckTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCheckTx.Unmarshal(m, b)
 }
-func (dst *ReplyTxInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTxInfo.Merge(dst, src)
+func (m *ReplyCheckTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCheckTx.Marshal(b, m, deterministic)
 }
-func (m *ReplyTxInfo) XXX_Size() int {
-	return xxx_messageInfo_ReplyTxInfo.Size(m)
+func (dst *ReplyCheckTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCheckTx.Merge(dst, src)
 }
-func (m *ReplyTxInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTxInfo.DiscardUnknown(m)
+func (m *ReplyCheckTx) XXX_Size() int {
+	return xxx_messageInfo_ReplyCheckTx.Size(m)
+}
+func (m *ReplyCheckTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCheckTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTxInfo proto.InternalMessageInfo
+var xxx_messageInfo_ReplyCheckTx proto.InternalMessageInfo
 
-func (m *ReplyTxInfo) GetHash() []byte {
+func (m *ReplyCheckTx) GetOk() bool {
 	if m != nil {
-		return m.Hash
+		return m.Ok
 	}
-	return nil
+	return false
 }
 
-func (m *ReplyTxInfo) GetHeight() int64 {
+func (m *ReplyCheckTx) GetStages() []*TxCheckStage {
 	if m != nil {
-		return m.Height
+		return m.Stages
 	}
-	return 0
+	return nil
 }
 
-func (m *ReplyTxInfo) GetIndex() int64 {
+func (m *ReplyCheckTx) GetProperFee() int64 {
 	if m != nil {
-		return m.Index
+		return m.ProperFee
 	}
 	return 0
 }
 
-func (m *ReplyTxInfo) GetAssets() []*Asset {
-	if m != nil {
-		return m.Assets
-	}
-	return nil
+// 模拟执行交易的请求, stateHash为空时在最新区块的状态上执行
+type ReqSimulateTx struct {
+	Tx                   *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	StateHash            []byte       `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height               int64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-type ReqTxList struct {
-	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReqSimulateTx) Reset()         { *m = ReqSimulateTx{} }
+func (m *ReqSimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReqSimulateTx) ProtoMessage()    {}
+func (*ReqSimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{37}
 }
-
-func (m *ReqTxList) Reset()         { *m = ReqTxList{} }
-func (m *ReqTxList) String() string { return proto.CompactTextString(m) }
-func (*ReqTxList) ProtoMessage()    {}
-func (*ReqTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{20}
+func (m *ReqSimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSimulateTx.Unmarshal(m, b)
 }
-func (m *ReqTxList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqTxList.Unmarshal(m, b)
+func (m *ReqSimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSimulateTx.Marshal(b, m, deterministic)
 }
-func (m *ReqTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqTxList.Marshal(b, m, deterministic)
+func (dst *ReqSimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSimulateTx.Merge(dst, src)
 }
-func (dst *ReqTxList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTxList.Merge(dst, src)
+func (m *ReqSimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReqSimulateTx.Size(m)
 }
-func (m *ReqTxList) XXX_Size() int {
-	return xxx_messageInfo_ReqTxList.Size(m)
+func (m *ReqSimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSimulateTx.DiscardUnknown(m)
 }
-func (m *ReqTxList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqTxList.DiscardUnknown(m)
+
+var xxx_messageInfo_ReqSimulateTx proto.InternalMessageInfo
+
+func (m *ReqSimulateTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-var xxx_messageInfo_ReqTxList proto.InternalMessageInfo
+func (m *ReqSimulateTx) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
 
-func (m *ReqTxList) GetCount() int64 {
+func (m *ReqSimulateTx) GetHeight() int64 {
 	if m != nil {
-		return m.Count
+		return m.Height
 	}
 	return 0
 }
 
-type ReplyTxList struct {
-	Txs                  []*Transaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// 模拟执行交易的结果, 不会修改状态数据库以及本地数据库
+// receipt包含交易的日志以及状态数据库的修改, localKV为ExecLocal对本地数据库的修改
+type ReplySimulateTx struct {
+	Receipt              *Receipt    `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	LocalKV              []*KeyValue `protobuf:"bytes,2,rep,name=localKV,proto3" json:"localKV,omitempty"`
+	Fee                  int64       `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Error                string      `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ReplyTxList) Reset()         { *m = ReplyTxList{} }
-func (m *ReplyTxList) String() string { return proto.CompactTextString(m) }
-func (*ReplyTxList) ProtoMessage()    {}
-func (*ReplyTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{21}
+func (m *ReplySimulateTx) Reset()         { *m = ReplySimulateTx{} }
+func (m *ReplySimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReplySimulateTx) ProtoMessage()    {}
+func (*ReplySimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{38}
 }
-func (m *ReplyTxList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyTxList.Unmarshal(m, b)
+func (m *ReplySimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySimulateTx.Unmarshal(m, b)
 }
-func (m *ReplyTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTxList.Marshal(b, m, deterministic)
+func (m *ReplySimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySimulateTx.Marshal(b, m, deterministic)
 }
-func (dst *ReplyTxList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTxList.Merge(dst, src)
+func (dst *ReplySimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySimulateTx.Merge(dst, src)
 }
-func (m *ReplyTxList) XXX_Size() int {
-	return xxx_messageInfo_ReplyTxList.Size(m)
+func (m *ReplySimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReplySimulateTx.Size(m)
 }
-func (m *ReplyTxList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTxList.DiscardUnknown(m)
+func (m *ReplySimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySimulateTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTxList proto.InternalMessageInfo
+var xxx_messageInfo_ReplySimulateTx proto.InternalMessageInfo
 
-func (m *ReplyTxList) GetTxs() []*Transaction {
+func (m *ReplySimulateTx) GetReceipt() *Receipt {
 	if m != nil {
-		return m.Txs
+		return m.Receipt
 	}
 	return nil
 }
 
-type ReqGetMempool struct {
-	IsAll                bool     `protobuf:"varint,1,opt,name=isAll,proto3" json:"isAll,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReplySimulateTx) GetLocalKV() []*KeyValue {
+	if m != nil {
+		return m.LocalKV
+	}
+	return nil
 }
 
-func (m *ReqGetMempool) Reset()         { *m = ReqGetMempool{} }
-func (m *ReqGetMempool) String() string { return proto.CompactTextString(m) }
-func (*ReqGetMempool) ProtoMessage()    {}
-func (*ReqGetMempool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{22}
-}
-func (m *ReqGetMempool) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqGetMempool.Unmarshal(m, b)
-}
-func (m *ReqGetMempool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqGetMempool.Marshal(b, m, deterministic)
-}
-func (dst *ReqGetMempool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqGetMempool.Merge(dst, src)
-}
-func (m *ReqGetMempool) XXX_Size() int {
-	return xxx_messageInfo_ReqGetMempool.Size(m)
-}
-func (m *ReqGetMempool) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqGetMempool.DiscardUnknown(m)
+func (m *ReplySimulateTx) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
 }
 
-var xxx_messageInfo_ReqGetMempool proto.InternalMessageInfo
-
-func (m *ReqGetMempool) GetIsAll() bool {
+func (m *ReplySimulateTx) GetError() string {
 	if m != nil {
-		return m.IsAll
+		return m.Error
 	}
-	return false
+	return ""
 }
 
-type ReqProperFee struct {
-	TxCount              int32    `protobuf:"varint,1,opt,name=txCount,proto3" json:"txCount,omitempty"`
-	TxSize               int32    `protobuf:"varint,2,opt,name=txSize,proto3" json:"txSize,omitempty"`
+// 交易执行过程中的一次操作
+// ty为操作类型: stateGet, stateSet, localGet, localSet, localList, checkTx, exec, allow, fee, error, commit, rollback
+type TraceOp struct {
+	Ty                   string   `protobuf:"bytes,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Info                 string   `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqProperFee) Reset()         { *m = ReqProperFee{} }
-func (m *ReqProperFee) String() string { return proto.CompactTextString(m) }
-func (*ReqProperFee) ProtoMessage()    {}
-func (*ReqProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{23}
+func (m *TraceOp) Reset()         { *m = TraceOp{} }
+func (m *TraceOp) String() string { return proto.CompactTextString(m) }
+func (*TraceOp) ProtoMessage()    {}
+func (*TraceOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{39}
 }
-func (m *ReqProperFee) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqProperFee.Unmarshal(m, b)
+func (m *TraceOp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceOp.Unmarshal(m, b)
 }
-func (m *ReqProperFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqProperFee.Marshal(b, m, deterministic)
+func (m *TraceOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceOp.Marshal(b, m, deterministic)
 }
-func (dst *ReqProperFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqProperFee.Merge(dst, src)
+func (dst *TraceOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceOp.Merge(dst, src)
 }
-func (m *ReqProperFee) XXX_Size() int {
-	return xxx_messageInfo_ReqProperFee.Size(m)
+func (m *TraceOp) XXX_Size() int {
+	return xxx_messageInfo_TraceOp.Size(m)
 }
-func (m *ReqProperFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqProperFee.DiscardUnknown(m)
+func (m *TraceOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqProperFee proto.InternalMessageInfo
+var xxx_messageInfo_TraceOp proto.InternalMessageInfo
 
-func (m *ReqProperFee) GetTxCount() int32 {
+func (m *TraceOp) GetTy() string {
 	if m != nil {
-		return m.TxCount
+		return m.Ty
 	}
-	return 0
+	return ""
 }
 
-func (m *ReqProperFee) GetTxSize() int32 {
+func (m *TraceOp) GetKey() []byte {
 	if m != nil {
-		return m.TxSize
+		return m.Key
 	}
-	return 0
+	return nil
 }
 
-type ReplyProperFee struct {
-	ProperFee            int64    `protobuf:"varint,1,opt,name=properFee,proto3" json:"properFee,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TraceOp) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *ReplyProperFee) Reset()         { *m = ReplyProperFee{} }
-func (m *ReplyProperFee) String() string { return proto.CompactTextString(m) }
-func (*ReplyProperFee) ProtoMessage()    {}
-func (*ReplyProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{24}
+func (m *TraceOp) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
 }
-func (m *ReplyProperFee) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyProperFee.Unmarshal(m, b)
+
+// 在区块中重新执行交易的跟踪结果, 交易在交易组中时跟踪整个交易组的执行
+type ReplyTraceTx struct {
+	Receipt              *Receipt     `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Ops                  []*TraceOp   `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *ReplyProperFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyProperFee.Marshal(b, m, deterministic)
+
+func (m *ReplyTraceTx) Reset()         { *m = ReplyTraceTx{} }
+func (m *ReplyTraceTx) String() string { return proto.CompactTextString(m) }
+func (*ReplyTraceTx) ProtoMessage()    {}
+func (*ReplyTraceTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{40}
 }
-func (dst *ReplyProperFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyProperFee.Merge(dst, src)
+func (m *ReplyTraceTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTraceTx.Unmarshal(m, b)
 }
-func (m *ReplyProperFee) XXX_Size() int {
-	return xxx_messageInfo_ReplyProperFee.Size(m)
+func (m *ReplyTraceTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTraceTx.Marshal(b, m, deterministic)
 }
-func (m *ReplyProperFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyProperFee.DiscardUnknown(m)
+func (dst *ReplyTraceTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTraceTx.Merge(dst, src)
+}
+func (m *ReplyTraceTx) XXX_Size() int {
+	return xxx_messageInfo_ReplyTraceTx.Size(m)
+}
+func (m *ReplyTraceTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTraceTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyProperFee proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTraceTx proto.InternalMessageInfo
 
-func (m *ReplyProperFee) GetProperFee() int64 {
+func (m *ReplyTraceTx) GetReceipt() *Receipt {
 	if m != nil {
-		return m.ProperFee
+		return m.Receipt
 	}
-	return 0
+	return nil
+}
+
+func (m *ReplyTraceTx) GetOps() []*TraceOp {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+func (m *ReplyTraceTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
 type TxHashList struct {
@@ -1445,7 +2400,7 @@ func (m *TxHashList) Reset()         { *m = TxHashList{} }
 func (m *TxHashList) String() string { return proto.CompactTextString(m) }
 func (*TxHashList) ProtoMessage()    {}
 func (*TxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{25}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{41}
 }
 func (m *TxHashList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxHashList.Unmarshal(m, b)
@@ -1497,7 +2452,7 @@ func (m *ReplyTxInfos) Reset()         { *m = ReplyTxInfos{} }
 func (m *ReplyTxInfos) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfos) ProtoMessage()    {}
 func (*ReplyTxInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{26}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{42}
 }
 func (m *ReplyTxInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTxInfos.Unmarshal(m, b)
@@ -1536,7 +2491,7 @@ func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
 func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
 func (*ReceiptLog) ProtoMessage()    {}
 func (*ReceiptLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{27}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{43}
 }
 func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptLog.Unmarshal(m, b)
@@ -1574,19 +2529,21 @@ func (m *ReceiptLog) GetLog() []byte {
 // ty = 1 -> CutFee //cut fee ,bug exec not ok
 // ty = 2 -> exec ok
 type Receipt struct {
-	Ty                   int32         `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
-	KV                   []*KeyValue   `protobuf:"bytes,2,rep,name=KV,proto3" json:"KV,omitempty"`
-	Logs                 []*ReceiptLog `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Ty   int32         `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	KV   []*KeyValue   `protobuf:"bytes,2,rep,name=KV,proto3" json:"KV,omitempty"`
+	Logs []*ReceiptLog `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 开启ForkTxGas之后交易执行消耗的gas
+	GasUsed              int64    `protobuf:"varint,4,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{28}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{44}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Receipt.Unmarshal(m, b)
@@ -1627,19 +2584,28 @@ func (m *Receipt) GetLogs() []*ReceiptLog {
 	return nil
 }
 
+func (m *Receipt) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 type ReceiptData struct {
-	Ty                   int32         `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
-	Logs                 []*ReceiptLog `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Ty   int32         `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Logs []*ReceiptLog `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 开启ForkTxGas之后交易执行消耗的gas
+	GasUsed              int64    `protobuf:"varint,4,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ReceiptData) Reset()         { *m = ReceiptData{} }
 func (m *ReceiptData) String() string { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()    {}
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{29}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{45}
 }
 func (m *ReceiptData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptData.Unmarshal(m, b)
@@ -1673,6 +2639,13 @@ func (m *ReceiptData) GetLogs() []*ReceiptLog {
 	return nil
 }
 
+func (m *ReceiptData) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 type TxResult struct {
 	Height               int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Index                int32        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -1689,7 +2662,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{30}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{46}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxResult.Unmarshal(m, b)
@@ -1773,7 +2746,7 @@ func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()    {}
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{31}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{47}
 }
 func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetail.Unmarshal(m, b)
@@ -1888,7 +2861,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{32}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{48}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -1926,7 +2899,7 @@ func (m *ReqAddrs) Reset()         { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()    {}
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{33}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{49}
 }
 func (m *ReqAddrs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAddrs.Unmarshal(m, b)
@@ -1964,7 +2937,7 @@ func (m *ReqDecodeRawTransaction) Reset()         { *m = ReqDecodeRawTransaction
 func (m *ReqDecodeRawTransaction) String() string { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()    {}
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{34}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{50}
 }
 func (m *ReqDecodeRawTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqDecodeRawTransaction.Unmarshal(m, b)
@@ -2003,7 +2976,7 @@ func (m *UserWrite) Reset()         { *m = UserWrite{} }
 func (m *UserWrite) String() string { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()    {}
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{35}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{51}
 }
 func (m *UserWrite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserWrite.Unmarshal(m, b)
@@ -2038,19 +3011,21 @@ func (m *UserWrite) GetContent() string {
 }
 
 type UpgradeMeta struct {
-	Starting             bool     `protobuf:"varint,1,opt,name=starting,proto3" json:"starting,omitempty"`
-	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Starting bool   `protobuf:"varint,1,opt,name=starting,proto3" json:"starting,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Height   int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// 并行重建索引时每个分片的进度
+	Shards               []*ReindexShard `protobuf:"bytes,4,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpgradeMeta) Reset()         { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()    {}
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{36}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{52}
 }
 func (m *UpgradeMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeMeta.Unmarshal(m, b)
@@ -2091,6 +3066,68 @@ func (m *UpgradeMeta) GetHeight() int64 {
 	return 0
 }
 
+func (m *UpgradeMeta) GetShards() []*ReindexShard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// 重建索引的高度分片，height为下一个需要处理的高度
+type ReindexShard struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReindexShard) Reset()         { *m = ReindexShard{} }
+func (m *ReindexShard) String() string { return proto.CompactTextString(m) }
+func (*ReindexShard) ProtoMessage()    {}
+func (*ReindexShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{53}
+}
+func (m *ReindexShard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReindexShard.Unmarshal(m, b)
+}
+func (m *ReindexShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReindexShard.Marshal(b, m, deterministic)
+}
+func (dst *ReindexShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReindexShard.Merge(dst, src)
+}
+func (m *ReindexShard) XXX_Size() int {
+	return xxx_messageInfo_ReindexShard.Size(m)
+}
+func (m *ReindexShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReindexShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReindexShard proto.InternalMessageInfo
+
+func (m *ReindexShard) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ReindexShard) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *ReindexShard) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // 通过交易hash获取交易列表，需要区分是短hash还是全hash值
 type ReqTxHashList struct {
 	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
@@ -2104,7 +3141,7 @@ func (m *ReqTxHashList) Reset()         { *m = ReqTxHashList{} }
 func (m *ReqTxHashList) String() string { return proto.CompactTextString(m) }
 func (*ReqTxHashList) ProtoMessage()    {}
 func (*ReqTxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{37}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{54}
 }
 func (m *ReqTxHashList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTxHashList.Unmarshal(m, b)
@@ -2152,7 +3189,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_e4d5119807bcbf4c, []int{38}
+	return fileDescriptor_transaction_e59e4c7633c9de14, []int{55}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxProof.Unmarshal(m, b)
@@ -2217,8 +3254,24 @@ func init() {
 	proto.RegisterType((*ReqTxList)(nil), "types.ReqTxList")
 	proto.RegisterType((*ReplyTxList)(nil), "types.ReplyTxList")
 	proto.RegisterType((*ReqGetMempool)(nil), "types.ReqGetMempool")
+	proto.RegisterType((*ReqMempoolTxs)(nil), "types.ReqMempoolTxs")
+	proto.RegisterType((*MempoolTx)(nil), "types.MempoolTx")
+	proto.RegisterType((*ReplyMempoolTxs)(nil), "types.ReplyMempoolTxs")
+	proto.RegisterType((*ReqEvictMempoolTxs)(nil), "types.ReqEvictMempoolTxs")
+	proto.RegisterType((*MempoolFeeBucket)(nil), "types.MempoolFeeBucket")
+	proto.RegisterType((*MempoolExecStat)(nil), "types.MempoolExecStat")
+	proto.RegisterType((*MempoolStats)(nil), "types.MempoolStats")
 	proto.RegisterType((*ReqProperFee)(nil), "types.ReqProperFee")
 	proto.RegisterType((*ReplyProperFee)(nil), "types.ReplyProperFee")
+	proto.RegisterType((*TxLifecycleEvent)(nil), "types.TxLifecycleEvent")
+	proto.RegisterType((*TxLifecycleEvents)(nil), "types.TxLifecycleEvents")
+	proto.RegisterType((*ReqSubTxEvents)(nil), "types.ReqSubTxEvents")
+	proto.RegisterType((*TxCheckStage)(nil), "types.TxCheckStage")
+	proto.RegisterType((*ReplyCheckTx)(nil), "types.ReplyCheckTx")
+	proto.RegisterType((*ReqSimulateTx)(nil), "types.ReqSimulateTx")
+	proto.RegisterType((*ReplySimulateTx)(nil), "types.ReplySimulateTx")
+	proto.RegisterType((*TraceOp)(nil), "types.TraceOp")
+	proto.RegisterType((*ReplyTraceTx)(nil), "types.ReplyTraceTx")
 	proto.RegisterType((*TxHashList)(nil), "types.TxHashList")
 	proto.RegisterType((*ReplyTxInfos)(nil), "types.ReplyTxInfos")
 	proto.RegisterType((*ReceiptLog)(nil), "types.ReceiptLog")
@@ -2231,103 +3284,144 @@ func init() {
 	proto.RegisterType((*ReqDecodeRawTransaction)(nil), "types.ReqDecodeRawTransaction")
 	proto.RegisterType((*UserWrite)(nil), "types.UserWrite")
 	proto.RegisterType((*UpgradeMeta)(nil), "types.UpgradeMeta")
+	proto.RegisterType((*ReindexShard)(nil), "types.ReindexShard")
 	proto.RegisterType((*ReqTxHashList)(nil), "types.ReqTxHashList")
 	proto.RegisterType((*TxProof)(nil), "types.TxProof")
 }
 
-func init() { proto.RegisterFile("transaction.proto", fileDescriptor_transaction_e4d5119807bcbf4c) }
-
-var fileDescriptor_transaction_e4d5119807bcbf4c = []byte{
-	// 1441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x6e, 0x13, 0x49,
-	0x16, 0x96, 0xdd, 0x76, 0x62, 0x1f, 0x3b, 0x59, 0xd2, 0x42, 0x60, 0x45, 0x2c, 0x64, 0x4b, 0x20,
-	0x21, 0x84, 0x1c, 0x29, 0xe1, 0x6e, 0x57, 0x5a, 0x7e, 0xb2, 0x0b, 0x51, 0x80, 0x65, 0x2b, 0x06,
-	0xa4, 0x99, 0xb9, 0xa9, 0xb4, 0x4f, 0xec, 0x1e, 0xda, 0x5d, 0x4e, 0x75, 0x39, 0xb4, 0xe7, 0x01,
-	0xe6, 0x86, 0xb9, 0x9b, 0x17, 0x9b, 0xc7, 0x98, 0xc7, 0x18, 0xd5, 0xa9, 0xaa, 0xee, 0x72, 0x7e,
-	0x46, 0x5c, 0x20, 0xcd, 0x5d, 0x7d, 0xa7, 0x8e, 0xcf, 0xff, 0xf9, 0xba, 0x0c, 0x5b, 0x5a, 0x89,
-	0xbc, 0x10, 0x89, 0x4e, 0x65, 0x3e, 0x9c, 0x2b, 0xa9, 0x65, 0xdc, 0xd6, 0xcb, 0x39, 0x16, 0xdb,
-	0xfd, 0x44, 0xce, 0x66, 0x5e, 0xc8, 0xde, 0xc0, 0xc6, 0xb3, 0xa2, 0x40, 0x5d, 0xbc, 0xc4, 0x1c,
-	0x8b, 0xb4, 0x88, 0x6f, 0xc1, 0x9a, 0x98, 0xc9, 0x45, 0xae, 0x07, 0xcd, 0x9d, 0xc6, 0xc3, 0x88,
-	0x3b, 0x14, 0xdf, 0x87, 0x0d, 0x85, 0x7a, 0xa1, 0xf2, 0x67, 0xe3, 0xb1, 0xc2, 0xa2, 0x18, 0x44,
-	0x3b, 0x8d, 0x87, 0x5d, 0xbe, 0x2a, 0x64, 0xbf, 0x34, 0xe0, 0xa6, 0xb5, 0x37, 0x32, 0xfe, 0x4f,
-	0x51, 0x8d, 0xe4, 0x7f, 0x4a, 0x4c, 0xe2, 0x3b, 0xd0, 0x4d, 0x64, 0x9a, 0x6b, 0xf9, 0x09, 0xf3,
-	0x41, 0x83, 0x7e, 0x5a, 0x0b, 0xae, 0x75, 0x1a, 0x43, 0x2b, 0x97, 0x1a, 0xc9, 0x57, 0x9f, 0xd3,
-	0x39, 0xde, 0x86, 0x0e, 0x96, 0x98, 0xbc, 0x15, 0x33, 0x1c, 0xb4, 0xc8, 0x50, 0x85, 0xe3, 0x4d,
-	0x68, 0x6a, 0x39, 0x68, 0x93, 0xb4, 0xa9, 0x25, 0xfb, 0xb9, 0x01, 0x9b, 0x36, 0x9c, 0x8f, 0xa9,
-	0x9e, 0x8e, 0x95, 0xf8, 0xfc, 0x17, 0x05, 0xf2, 0xa3, 0x8f, 0xc3, 0x97, 0xe5, 0x1b, 0xc6, 0x61,
-	0x7d, 0xb5, 0x2a, 0x5f, 0x47, 0xd0, 0x26, 0x5f, 0x46, 0xd9, 0x04, 0xe4, 0xac, 0xd3, 0xd9, 0x18,
-	0x2e, 0x96, 0xb3, 0x13, 0x99, 0x91, 0xe1, 0x2e, 0x77, 0x28, 0x70, 0x18, 0x85, 0x0e, 0xd9, 0xef,
-	0x0d, 0xe8, 0xbc, 0x50, 0x28, 0x34, 0x8e, 0x4a, 0xe7, 0xa9, 0xe1, 0x3d, 0x5d, 0x1b, 0xe5, 0x0d,
-	0x88, 0x4e, 0x11, 0x9d, 0x25, 0x73, 0xac, 0xe2, 0x6e, 0x05, 0x71, 0xdf, 0x05, 0x48, 0xab, 0xbe,
-	0x50, 0xad, 0x3a, 0x3c, 0x90, 0xc4, 0x03, 0x58, 0x4f, 0x8b, 0x11, 0xd5, 0x67, 0x8d, 0x2e, 0x3d,
-	0x8c, 0x77, 0xa0, 0x47, 0x65, 0x3a, 0xb6, 0x99, 0xac, 0x53, 0x40, 0xa1, 0x68, 0xa5, 0x37, 0x9d,
-	0x0b, 0xbd, 0xb9, 0x05, 0x6b, 0xe6, 0x8c, 0x6a, 0xd0, 0xb5, 0x25, 0xb0, 0x88, 0xe5, 0xd0, 0xe7,
-	0xf8, 0x51, 0xa5, 0x1a, 0xb9, 0xf8, 0xec, 0xb2, 0x2d, 0xab, 0x6c, 0x7d, 0xf6, 0x51, 0x98, 0x3d,
-	0x96, 0xf3, 0x54, 0xf9, 0xee, 0x3b, 0xe4, 0xb3, 0x6f, 0xd7, 0xd9, 0xdf, 0x84, 0x76, 0x9a, 0x8f,
-	0xb1, 0xa4, 0x3c, 0xda, 0xdc, 0x02, 0xf6, 0x08, 0x6e, 0xb9, 0xca, 0xd6, 0xab, 0xfa, 0x52, 0xc9,
-	0xc5, 0xdc, 0x58, 0xd0, 0x65, 0x31, 0x68, 0xec, 0x44, 0x0f, 0xbb, 0xdc, 0x1c, 0xd9, 0x5d, 0xe8,
-	0xbc, 0xcf, 0x8b, 0x74, 0x92, 0x8f, 0x4a, 0x53, 0xcb, 0xb1, 0xd0, 0x82, 0x22, 0xeb, 0x73, 0x3a,
-	0x33, 0x05, 0xfd, 0xb7, 0xf2, 0xb9, 0xc8, 0x44, 0x9e, 0xe0, 0xa8, 0xa4, 0x2d, 0xd6, 0xe5, 0x2b,
-	0xac, 0x8c, 0x38, 0x64, 0x6a, 0x3a, 0x17, 0x4b, 0xb3, 0xad, 0xae, 0xff, 0x1e, 0xd2, 0x8d, 0x4a,
-	0xcf, 0x3f, 0xe1, 0xd2, 0xa5, 0xe8, 0xe1, 0x75, 0x79, 0x32, 0x09, 0xbd, 0xc0, 0xa7, 0x49, 0x92,
-	0x9c, 0xb8, 0x8a, 0x59, 0xf0, 0x4d, 0x1d, 0x7e, 0x69, 0x42, 0x2f, 0xa8, 0x55, 0xd0, 0x48, 0x5b,
-	0x0a, 0x87, 0x9c, 0xcf, 0x4c, 0x8a, 0x31, 0xf9, 0xec, 0x73, 0x0f, 0xe3, 0x21, 0x74, 0x4d, 0x11,
-	0x85, 0x5e, 0x28, 0x3b, 0x9e, 0xbd, 0xbd, 0x1b, 0x43, 0xa2, 0xc5, 0xe1, 0xb1, 0x97, 0xf3, 0x5a,
-	0xc5, 0xb7, 0xb2, 0x55, 0xb7, 0xb2, 0x8e, 0xcd, 0xf6, 0xd7, 0x37, 0xfd, 0x26, 0xb4, 0x73, 0x99,
-	0x27, 0x48, 0x2d, 0x8e, 0xb8, 0x05, 0x6e, 0x64, 0xd6, 0xab, 0x91, 0xb9, 0x0b, 0x30, 0x31, 0x1d,
-	0x7e, 0x41, 0x4b, 0xd3, 0xa1, 0x69, 0x08, 0x24, 0xc6, 0xfa, 0x14, 0xc5, 0xd8, 0x8d, 0x66, 0x9f,
-	0x3b, 0x44, 0xeb, 0x83, 0xa5, 0x1e, 0x80, 0x5b, 0x1f, 0x2c, 0x35, 0x7b, 0x02, 0xfd, 0xa0, 0x18,
-	0x45, 0x7c, 0xbf, 0x1e, 0x9a, 0xde, 0x5e, 0xec, 0xb2, 0x0a, 0x34, 0xec, 0x20, 0xfd, 0x1b, 0x36,
-	0x78, 0x9a, 0x4f, 0xaa, 0x6c, 0xe3, 0x21, 0xb4, 0x53, 0x8d, 0x33, 0xff, 0xc3, 0x81, 0xfb, 0xe1,
-	0x8a, 0xd2, 0xa1, 0xc6, 0x19, 0xb7, 0x6a, 0xec, 0x10, 0xb6, 0x2e, 0xdd, 0x99, 0xb8, 0xe7, 0x8b,
-	0x13, 0xd3, 0x4a, 0x63, 0xa5, 0xcf, 0x1d, 0x32, 0x24, 0x57, 0xd7, 0xbb, 0x49, 0x57, 0xb5, 0x80,
-	0xfd, 0x1f, 0xba, 0x75, 0x1c, 0xa6, 0x54, 0x4b, 0x6a, 0x64, 0x9b, 0x37, 0xf5, 0x32, 0x30, 0x69,
-	0x7b, 0x78, 0xa5, 0x49, 0x4b, 0x83, 0x81, 0xc9, 0x1f, 0xa0, 0x6f, 0x86, 0xeb, 0x7f, 0xe7, 0xa8,
-	0xce, 0x53, 0x24, 0x0e, 0x51, 0x98, 0xa4, 0xe7, 0x6e, 0x46, 0x22, 0xee, 0xa1, 0xb9, 0x39, 0xb1,
-	0xb3, 0xeb, 0xc8, 0xcb, 0x43, 0x73, 0xa3, 0xcb, 0x17, 0x01, 0x17, 0x7a, 0xc8, 0x7e, 0x6d, 0xc0,
-	0x3a, 0xc7, 0x33, 0x1a, 0xdf, 0x18, 0x5a, 0xc2, 0x4c, 0xb5, 0x23, 0x57, 0xe1, 0x64, 0xa7, 0x99,
-	0x98, 0x90, 0xc1, 0x36, 0xa7, 0xb3, 0x19, 0x8c, 0xa4, 0xb2, 0xd5, 0xe6, 0x16, 0x98, 0x2c, 0xc6,
-	0xa9, 0x42, 0x6a, 0x0c, 0x8d, 0x57, 0x9b, 0xd7, 0x02, 0x3b, 0x06, 0xe9, 0x64, 0xaa, 0xfd, 0x90,
-	0x59, 0xb4, 0xca, 0x23, 0x91, 0xe7, 0x91, 0xdb, 0xd0, 0x7e, 0x85, 0xe5, 0x65, 0xc2, 0x62, 0x0b,
-	0xe8, 0x71, 0x9c, 0x67, 0xcb, 0x51, 0x79, 0x98, 0x9f, 0x4a, 0x13, 0xdd, 0x54, 0x14, 0x53, 0xcf,
-	0x1b, 0xe6, 0x1c, 0x78, 0x6a, 0x5e, 0xed, 0x29, 0x0a, 0x3c, 0xc5, 0xf7, 0x61, 0x4d, 0xd0, 0x57,
-	0x6c, 0xd0, 0xa2, 0x61, 0xe9, 0xbb, 0x61, 0xa1, 0xcf, 0x0d, 0x77, 0x77, 0xec, 0x1f, 0xd0, 0xe5,
-	0x78, 0x36, 0x2a, 0x5f, 0xa7, 0x85, 0xae, 0xd3, 0xb7, 0xe5, 0xb7, 0x80, 0xed, 0x57, 0x91, 0x91,
-	0xd2, 0xd7, 0x8d, 0xee, 0x03, 0xd8, 0xe0, 0x78, 0xf6, 0x12, 0xf5, 0x1b, 0x9c, 0xcd, 0xa5, 0xcc,
-	0x28, 0xc8, 0xe2, 0x59, 0x96, 0x91, 0xed, 0x0e, 0xb7, 0x80, 0x3d, 0x35, 0x34, 0x7e, 0xf6, 0x4e,
-	0xc9, 0x39, 0xaa, 0xff, 0xe2, 0x4a, 0x3b, 0xed, 0x74, 0x79, 0x68, 0x49, 0xf2, 0x38, 0xfd, 0x09,
-	0x5d, 0xc3, 0x1c, 0x62, 0x43, 0xd8, 0xa4, 0xe8, 0x6a, 0x1b, 0x77, 0xa0, 0x3b, 0xf7, 0xc0, 0x65,
-	0x52, 0x0b, 0x18, 0x07, 0x18, 0x95, 0xaf, 0x44, 0x31, 0xa5, 0x64, 0x4c, 0x49, 0x45, 0x31, 0xc5,
-	0xc2, 0xef, 0x82, 0x45, 0x75, 0x25, 0x9a, 0x41, 0x25, 0x02, 0x3e, 0x89, 0x76, 0xa2, 0x9a, 0x4f,
-	0xd8, 0xbf, 0x4c, 0x16, 0x55, 0xef, 0x8a, 0xf8, 0xb1, 0xc9, 0x82, 0x8e, 0x17, 0xca, 0x14, 0x68,
-	0x71, 0xaf, 0xc2, 0x86, 0x00, 0x1c, 0x13, 0x4c, 0xe7, 0xfa, 0xb5, 0x9c, 0x5c, 0x5a, 0xad, 0x1b,
-	0x10, 0x65, 0x72, 0xe2, 0xf6, 0xca, 0x1c, 0x99, 0x30, 0x73, 0x4d, 0xfa, 0x97, 0x94, 0xef, 0x41,
-	0xf3, 0xe8, 0x03, 0xed, 0x6e, 0x6f, 0xef, 0x6f, 0xce, 0xe7, 0x11, 0x2e, 0x3f, 0x88, 0x6c, 0x81,
-	0xbc, 0x79, 0xf4, 0x21, 0x7e, 0x00, 0xad, 0x4c, 0x4e, 0x0a, 0x8a, 0xbf, 0xb7, 0xb7, 0x55, 0x85,
-	0xe5, 0xdd, 0x73, 0xba, 0x66, 0x07, 0xa6, 0xe5, 0x24, 0x3b, 0x10, 0x5a, 0x5c, 0x72, 0xf3, 0x95,
-	0x56, 0x7e, 0x6b, 0x40, 0x67, 0x54, 0x72, 0x2c, 0x16, 0x99, 0x0e, 0x86, 0xb7, 0x71, 0xf5, 0xf0,
-	0x36, 0x83, 0xcf, 0x6d, 0xcc, 0x68, 0x3b, 0x2c, 0xe9, 0x5f, 0x35, 0x63, 0xe6, 0x13, 0xff, 0x04,
-	0x7a, 0xca, 0xba, 0x1c, 0x0b, 0xf7, 0x5a, 0x09, 0x2b, 0x5d, 0x85, 0xcf, 0x43, 0x35, 0x33, 0x1d,
-	0x27, 0x99, 0x4c, 0x3e, 0xe9, 0x74, 0xe6, 0x3f, 0x0b, 0xb5, 0xc0, 0x70, 0xbe, 0xf5, 0x40, 0x8f,
-	0x91, 0x35, 0xda, 0xce, 0x40, 0xc2, 0xbe, 0x44, 0xb0, 0x15, 0xc4, 0x71, 0x80, 0x5a, 0xa4, 0x99,
-	0x8b, 0xb6, 0xf1, 0xa7, 0xd1, 0x3e, 0x26, 0x72, 0x33, 0x61, 0x50, 0xa6, 0x57, 0x47, 0xea, 0x55,
-	0x88, 0x50, 0x95, 0x94, 0xa7, 0xb6, 0xc6, 0x86, 0x50, 0x09, 0x05, 0x55, 0x6c, 0x5d, 0x5d, 0xc5,
-	0x76, 0x48, 0x01, 0x2b, 0xb9, 0xae, 0x5d, 0xcc, 0xb5, 0x7e, 0x10, 0xae, 0xaf, 0x3c, 0x08, 0xb7,
-	0xa1, 0x73, 0xaa, 0xe4, 0x8c, 0x08, 0xd3, 0x3d, 0xc7, 0x3c, 0xbe, 0x50, 0x9f, 0xee, 0xc5, 0xfa,
-	0x04, 0xa4, 0x03, 0xd7, 0x93, 0x4e, 0xfc, 0x08, 0x3a, 0xba, 0x7c, 0x67, 0xf3, 0xeb, 0x91, 0xde,
-	0xa6, 0xaf, 0x9a, 0x15, 0xf3, 0xea, 0x9e, 0xa2, 0x59, 0x64, 0x99, 0xd9, 0xd8, 0x41, 0x9f, 0x96,
-	0xa0, 0xc2, 0xec, 0x29, 0xc4, 0x97, 0x9a, 0x61, 0xac, 0x07, 0x04, 0x35, 0xb8, 0xdc, 0x0e, 0xab,
-	0x67, 0x69, 0x6a, 0x07, 0x3a, 0xee, 0x1b, 0x41, 0x3b, 0x6f, 0x72, 0xf4, 0xaf, 0x30, 0x0b, 0xd8,
-	0x2e, 0xdc, 0xe6, 0x78, 0x76, 0x80, 0x89, 0x1c, 0xd3, 0x53, 0x33, 0x78, 0xd2, 0x5c, 0xf9, 0x88,
-	0x62, 0xff, 0x84, 0xee, 0xfb, 0x02, 0x15, 0xbd, 0x4d, 0x49, 0x45, 0xce, 0xd3, 0xa4, 0x52, 0x31,
-	0xc0, 0xb0, 0x5c, 0x22, 0x73, 0x8d, 0x8e, 0x5f, 0xba, 0xdc, 0x43, 0xf6, 0x3d, 0xf4, 0xde, 0xcf,
-	0x27, 0x4a, 0x8c, 0xf1, 0x0d, 0x6a, 0x61, 0x92, 0x2f, 0xb4, 0x50, 0x3a, 0xcd, 0x27, 0x8e, 0x37,
-	0x2b, 0x6c, 0x8c, 0x9c, 0xa3, 0x2a, 0xcc, 0x37, 0xc9, 0x19, 0x71, 0x30, 0x18, 0x92, 0x28, 0x1c,
-	0x12, 0x76, 0x48, 0x9c, 0x7c, 0x2d, 0xfb, 0x75, 0x2b, 0xf6, 0xdb, 0x81, 0x5e, 0x5a, 0x1c, 0x4f,
-	0xa5, 0xd2, 0x54, 0xf6, 0x26, 0x79, 0x0e, 0x45, 0xec, 0x18, 0xd6, 0x5d, 0xab, 0x82, 0x51, 0x6d,
-	0xac, 0x8c, 0xea, 0xca, 0x62, 0x6f, 0xf8, 0x91, 0xdc, 0x86, 0x8e, 0x92, 0xd2, 0xda, 0xb5, 0x0f,
-	0x82, 0x0a, 0x3f, 0xbf, 0xf7, 0xdd, 0xdf, 0x27, 0xa9, 0x9e, 0x2e, 0x4e, 0x86, 0x89, 0x9c, 0xed,
-	0xee, 0xef, 0x27, 0xf9, 0x6e, 0x32, 0x15, 0x69, 0xbe, 0xbf, 0xbf, 0x4b, 0x4d, 0x3c, 0x59, 0xa3,
-	0xbf, 0xc1, 0xfb, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xfa, 0x67, 0xb6, 0x30, 0x0f, 0x00,
-	0x00,
+func init() { proto.RegisterFile("transaction.proto", fileDescriptor_transaction_e59e4c7633c9de14) }
+
+var fileDescriptor_transaction_e59e4c7633c9de14 = []byte{
+	// 2091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x18, 0x5d, 0x6b, 0x1c, 0xc9,
+	0x91, 0xfd, 0x92, 0x76, 0x6b, 0xd7, 0xb2, 0x3c, 0x67, 0xec, 0xc5, 0x38, 0x3e, 0x65, 0xf0, 0x81,
+	0x73, 0x31, 0x32, 0x58, 0xf7, 0x10, 0xb8, 0x40, 0xce, 0xf2, 0x37, 0xfe, 0xb8, 0x4b, 0x6b, 0xe5,
+	0x83, 0x10, 0x02, 0xad, 0xd9, 0xda, 0xdd, 0x89, 0x66, 0xa7, 0x57, 0x33, 0xbd, 0xba, 0xd9, 0x04,
+	0xf2, 0x18, 0x02, 0x09, 0x79, 0xc9, 0xff, 0xc8, 0xff, 0x08, 0x79, 0x49, 0xfe, 0x45, 0x7e, 0x46,
+	0xa8, 0xea, 0x9a, 0x99, 0x5e, 0x49, 0x1b, 0x1c, 0x72, 0x90, 0xb7, 0xae, 0xea, 0x9a, 0xfa, 0xfe,
+	0x9a, 0x86, 0x1b, 0x36, 0xd3, 0x69, 0xae, 0x23, 0x1b, 0x9b, 0x74, 0x7f, 0x91, 0x19, 0x6b, 0x82,
+	0x8e, 0x5d, 0x2d, 0x30, 0xbf, 0x33, 0x88, 0xcc, 0x7c, 0x5e, 0x22, 0xc3, 0x77, 0x70, 0xed, 0x49,
+	0x9e, 0xa3, 0xcd, 0x5f, 0x62, 0x8a, 0x79, 0x9c, 0x07, 0xb7, 0x60, 0x4b, 0xcf, 0xcd, 0x32, 0xb5,
+	0xc3, 0xe6, 0x5e, 0xe3, 0x41, 0x4b, 0x09, 0x14, 0xdc, 0x87, 0x6b, 0x19, 0xda, 0x65, 0x96, 0x3e,
+	0x19, 0x8f, 0x33, 0xcc, 0xf3, 0x61, 0x6b, 0xaf, 0xf1, 0xa0, 0xa7, 0xd6, 0x91, 0xe1, 0x9f, 0x1a,
+	0x70, 0xd3, 0xf1, 0x1b, 0x91, 0xfc, 0x09, 0x66, 0x23, 0xf3, 0xbc, 0xc0, 0x28, 0xb8, 0x0b, 0xbd,
+	0xc8, 0xc4, 0xa9, 0x35, 0xa7, 0x98, 0x0e, 0x1b, 0xfc, 0x69, 0x8d, 0xd8, 0x28, 0x34, 0x80, 0x76,
+	0x6a, 0x2c, 0xb2, 0xac, 0x81, 0xe2, 0x73, 0x70, 0x07, 0xba, 0x58, 0x60, 0xf4, 0x5e, 0xcf, 0x71,
+	0xd8, 0x66, 0x46, 0x15, 0x1c, 0xec, 0x40, 0xd3, 0x9a, 0x61, 0x87, 0xb1, 0x4d, 0x6b, 0xc2, 0xdf,
+	0x37, 0x60, 0xc7, 0xa9, 0xf3, 0x6d, 0x6c, 0x67, 0xe3, 0x4c, 0x7f, 0xf7, 0x7f, 0x52, 0xe4, 0xd7,
+	0xb0, 0xb3, 0xee, 0x96, 0xef, 0x51, 0x0f, 0x27, 0xab, 0x5d, 0xc9, 0x7a, 0x03, 0x1d, 0x96, 0x45,
+	0xc4, 0xa4, 0x90, 0x70, 0xe7, 0x33, 0x31, 0xce, 0x57, 0xf3, 0x13, 0x93, 0x30, 0xe3, 0x9e, 0x12,
+	0xc8, 0x13, 0xd8, 0xf2, 0x05, 0x86, 0xff, 0x6a, 0x40, 0xf7, 0x69, 0x86, 0xda, 0xe2, 0xa8, 0x10,
+	0x49, 0x8d, 0x52, 0xd2, 0x46, 0x2d, 0x77, 0xa1, 0x35, 0x41, 0x14, 0x4e, 0x74, 0xac, 0xf4, 0x6e,
+	0x7b, 0x7a, 0xdf, 0x03, 0x88, 0xab, 0xb8, 0xb0, 0xaf, 0xba, 0xca, 0xc3, 0x04, 0x43, 0xd8, 0x8e,
+	0xf3, 0x11, 0xfb, 0x67, 0x8b, 0x2f, 0x4b, 0x30, 0xd8, 0x83, 0x3e, 0xbb, 0xe9, 0xc8, 0x59, 0xb2,
+	0xcd, 0x0a, 0xf9, 0xa8, 0xb5, 0xd8, 0x74, 0x2f, 0xc4, 0xe6, 0x16, 0x6c, 0xd1, 0x19, 0xb3, 0x61,
+	0xcf, 0xb9, 0xc0, 0x41, 0x61, 0x0a, 0x03, 0x85, 0xdf, 0x66, 0xb1, 0x45, 0xa5, 0xbf, 0x13, 0x6b,
+	0x8b, 0xca, 0xda, 0xd2, 0xfa, 0x96, 0x6f, 0x3d, 0x16, 0x8b, 0x38, 0x2b, 0xa3, 0x2f, 0x50, 0x69,
+	0x7d, 0xa7, 0xb6, 0xfe, 0x26, 0x74, 0xe2, 0x74, 0x8c, 0x05, 0xdb, 0xd1, 0x51, 0x0e, 0x08, 0x3f,
+	0x87, 0x5b, 0xe2, 0xd9, 0xba, 0x54, 0x5f, 0x66, 0x66, 0xb9, 0x20, 0x0e, 0xb6, 0xc8, 0x87, 0x8d,
+	0xbd, 0xd6, 0x83, 0x9e, 0xa2, 0x63, 0x78, 0x0f, 0xba, 0xc7, 0x69, 0x1e, 0x4f, 0xd3, 0x51, 0x41,
+	0xbe, 0x1c, 0x6b, 0xab, 0x59, 0xb3, 0x81, 0xe2, 0x73, 0x98, 0xc1, 0xe0, 0xbd, 0x39, 0xd4, 0x89,
+	0x4e, 0x23, 0x1c, 0x15, 0x5c, 0xc5, 0xb6, 0x78, 0x85, 0x15, 0x13, 0x81, 0xc8, 0xa7, 0x0b, 0xbd,
+	0xa2, 0x6a, 0x95, 0xf8, 0x97, 0x20, 0xdf, 0x64, 0xf1, 0xf9, 0x29, 0xae, 0xc4, 0xc4, 0x12, 0xdc,
+	0x64, 0x67, 0x68, 0xa0, 0xef, 0xc9, 0x24, 0x23, 0x59, 0x88, 0x78, 0xcc, 0x01, 0xdf, 0xab, 0xc0,
+	0xbf, 0x37, 0xa1, 0xef, 0xf9, 0xca, 0x0b, 0xa4, 0x73, 0x85, 0x40, 0x22, 0x33, 0x31, 0x7a, 0xcc,
+	0x32, 0x07, 0xaa, 0x04, 0x83, 0x7d, 0xe8, 0x91, 0x13, 0xb5, 0x5d, 0x66, 0x2e, 0x3d, 0xfb, 0x8f,
+	0x77, 0xf7, 0xb9, 0x2d, 0xee, 0x1f, 0x95, 0x78, 0x55, 0x93, 0x94, 0xa1, 0x6c, 0xd7, 0xa1, 0xac,
+	0x75, 0x73, 0xf1, 0x15, 0x88, 0xac, 0x4f, 0x4d, 0x1a, 0x21, 0x87, 0xb8, 0xa5, 0x1c, 0x20, 0x29,
+	0xb3, 0x5d, 0xa5, 0xcc, 0x3d, 0x80, 0x29, 0x45, 0xf8, 0x29, 0x17, 0x4d, 0x97, 0xb3, 0xc1, 0xc3,
+	0x10, 0xf7, 0x19, 0xea, 0xb1, 0xa4, 0xe6, 0x40, 0x09, 0xc4, 0xe5, 0x83, 0x85, 0x1d, 0x82, 0x94,
+	0x0f, 0x16, 0x96, 0x8a, 0x20, 0xc3, 0x45, 0xa2, 0x23, 0x7c, 0xa5, 0xf3, 0xd9, 0xb0, 0xcf, 0x57,
+	0x3e, 0x8a, 0x5a, 0x4c, 0x6a, 0xec, 0x21, 0x4e, 0x4c, 0x86, 0xc3, 0x01, 0xeb, 0x55, 0x23, 0xc2,
+	0x2f, 0x60, 0xe0, 0x39, 0x33, 0x0f, 0xee, 0xd7, 0x49, 0xd7, 0x7f, 0x1c, 0x88, 0x57, 0x3c, 0x0a,
+	0x97, 0x88, 0x3f, 0x83, 0x6b, 0x2a, 0x4e, 0xa7, 0x95, 0xb7, 0x82, 0x7d, 0xe8, 0xc4, 0x16, 0xe7,
+	0xe5, 0x87, 0x43, 0xf9, 0x70, 0x8d, 0xe8, 0xb5, 0xc5, 0xb9, 0x72, 0x64, 0xe1, 0x6b, 0xb8, 0x71,
+	0xe9, 0x8e, 0xec, 0x5e, 0x2c, 0x4f, 0x28, 0x15, 0x88, 0xcb, 0x40, 0x09, 0x44, 0x16, 0xd4, 0xf1,
+	0x6a, 0xf2, 0x55, 0x8d, 0x08, 0x7f, 0x0e, 0xbd, 0x5a, 0x0f, 0x72, 0xf5, 0x8a, 0x13, 0xa1, 0xa3,
+	0x9a, 0x76, 0xe5, 0xb1, 0x74, 0x39, 0x70, 0x25, 0x4b, 0xd7, 0x46, 0x3d, 0x96, 0xbf, 0x84, 0x01,
+	0x25, 0xe7, 0xd7, 0xe7, 0x98, 0x9d, 0xc7, 0xc8, 0x3d, 0x28, 0xc3, 0x28, 0x3e, 0x97, 0x1c, 0x6b,
+	0xa9, 0x12, 0xa4, 0x9b, 0x13, 0x97, 0xfb, 0xd2, 0xfc, 0x4a, 0x90, 0x6e, 0x6c, 0xf1, 0xd4, 0xeb,
+	0xa5, 0x25, 0x18, 0xfe, 0xa5, 0x01, 0xdb, 0x0a, 0xcf, 0x38, 0xfd, 0x03, 0x68, 0xeb, 0xf1, 0xd8,
+	0xb1, 0xed, 0xa9, 0xb6, 0x16, 0xdc, 0x24, 0xd1, 0x53, 0x66, 0xd8, 0x51, 0x7c, 0xa6, 0xc4, 0x8a,
+	0x2a, 0x5e, 0x1d, 0xe5, 0x00, 0xb2, 0x62, 0x1c, 0x67, 0xc8, 0x81, 0xe1, 0xf4, 0xec, 0xa8, 0x1a,
+	0xe1, 0xd2, 0x28, 0x9e, 0xce, 0x6c, 0x99, 0xa4, 0x0e, 0x5a, 0xef, 0x43, 0xad, 0xb2, 0x0f, 0xdd,
+	0x86, 0xce, 0x2b, 0x2c, 0x2e, 0x37, 0xbc, 0x70, 0x09, 0x7d, 0x85, 0x8b, 0x64, 0x35, 0x2a, 0x5e,
+	0xa7, 0x13, 0x43, 0xda, 0xcd, 0x28, 0xd3, 0xa4, 0xef, 0xd0, 0xd9, 0x93, 0xd4, 0xbc, 0x5a, 0x52,
+	0xcb, 0x93, 0x14, 0xdc, 0x87, 0x2d, 0xcd, 0x53, 0x70, 0xd8, 0xe6, 0x64, 0x19, 0x48, 0xb2, 0xf0,
+	0xb8, 0x52, 0x72, 0x17, 0xfe, 0x10, 0x7a, 0x0a, 0xcf, 0x46, 0xc5, 0xdb, 0x38, 0xb7, 0xb5, 0xf9,
+	0xce, 0xfd, 0x0e, 0x08, 0x0f, 0x2a, 0xcd, 0x98, 0xe8, 0xe3, 0x52, 0xf7, 0x33, 0xb8, 0xa6, 0xf0,
+	0xec, 0x25, 0xda, 0x77, 0x38, 0x5f, 0x18, 0x93, 0xb0, 0x92, 0xf9, 0x93, 0x24, 0x61, 0xde, 0x5d,
+	0xe5, 0x80, 0xf0, 0x6f, 0x0d, 0xa6, 0x13, 0x22, 0x6a, 0xa6, 0x14, 0x96, 0xcc, 0xcc, 0xcb, 0x50,
+	0xd1, 0xd9, 0xeb, 0x3d, 0x4d, 0x7f, 0x88, 0x10, 0x7e, 0x1e, 0xa7, 0x2f, 0xaa, 0xe9, 0x27, 0x10,
+	0xe3, 0x75, 0xf1, 0xa2, 0x6a, 0x26, 0x02, 0x09, 0xfd, 0x93, 0x69, 0xd5, 0x4f, 0x1c, 0x24, 0xf4,
+	0x84, 0xdf, 0xaa, 0xe8, 0x05, 0x6f, 0x26, 0x93, 0x1c, 0x2d, 0x77, 0x95, 0x96, 0x12, 0xa8, 0xf6,
+	0x53, 0xd7, 0xf7, 0xd3, 0x3b, 0xe8, 0x55, 0x76, 0x04, 0x61, 0x15, 0xde, 0xab, 0x9d, 0x44, 0x33,
+	0xee, 0x2e, 0xf4, 0x30, 0xb5, 0x98, 0x8d, 0xe2, 0x79, 0x99, 0xd7, 0x35, 0x22, 0x7c, 0x03, 0xd7,
+	0xd9, 0xed, 0x9e, 0x6f, 0x42, 0xdf, 0xf5, 0x65, 0x2f, 0xad, 0xee, 0xd9, 0xf1, 0x3c, 0x19, 0x8c,
+	0xd5, 0x89, 0x30, 0x74, 0x40, 0xf8, 0x15, 0x04, 0x0a, 0xcf, 0x9e, 0x9f, 0xc7, 0x91, 0xf5, 0xf8,
+	0x51, 0x42, 0xe9, 0x7c, 0x86, 0x79, 0xd9, 0x09, 0x1c, 0x54, 0x95, 0x4b, 0xb3, 0x2e, 0x97, 0x70,
+	0x06, 0xbb, 0xf2, 0xe5, 0x0b, 0xc4, 0xc3, 0x65, 0x74, 0x8a, 0x96, 0x3a, 0xac, 0xf3, 0xb8, 0xd2,
+	0x16, 0x25, 0x69, 0x3c, 0x0c, 0xdf, 0xeb, 0x42, 0x20, 0x51, 0xc8, 0xc3, 0xac, 0x97, 0x5b, 0xe5,
+	0xc7, 0x63, 0xb8, 0x2e, 0x92, 0x68, 0x99, 0x3d, 0xb2, 0xda, 0x5e, 0x18, 0x3e, 0x75, 0x02, 0x54,
+	0x0c, 0x9a, 0x1e, 0x03, 0xc2, 0x9e, 0xac, 0x2c, 0xe6, 0x25, 0x5b, 0x06, 0xc2, 0x7f, 0x36, 0x60,
+	0x20, 0x7c, 0x89, 0x67, 0xee, 0xb7, 0x8e, 0xc6, 0x5a, 0xeb, 0x20, 0xbd, 0xd9, 0x6d, 0x87, 0xcc,
+	0x45, 0xf4, 0xae, 0x31, 0xc1, 0x97, 0x30, 0x98, 0x20, 0xbe, 0x8a, 0x73, 0x6b, 0xa6, 0x99, 0x9e,
+	0x0f, 0x5b, 0x1c, 0x90, 0xdb, 0xeb, 0x01, 0xa9, 0xdc, 0xa4, 0xd6, 0x88, 0x83, 0x87, 0xd0, 0x21,
+	0xed, 0xcb, 0xb2, 0xbc, 0xb5, 0xfe, 0x55, 0x69, 0xb2, 0x72, 0x44, 0x94, 0x23, 0x26, 0x19, 0x63,
+	0x6e, 0xeb, 0xac, 0xad, 0x11, 0xe1, 0x57, 0xb4, 0x45, 0x9d, 0x7d, 0x93, 0x99, 0x05, 0x66, 0x94,
+	0xe0, 0x17, 0x4c, 0xea, 0xd4, 0x26, 0xf1, 0x8e, 0x72, 0x14, 0xff, 0x06, 0xa5, 0xdf, 0x09, 0x14,
+	0xee, 0xc3, 0x0e, 0x67, 0x59, 0xcd, 0xe3, 0x2e, 0xf4, 0x16, 0x25, 0x20, 0x8e, 0xa9, 0x11, 0xe1,
+	0x5f, 0x1b, 0xb0, 0x4b, 0x8d, 0x60, 0x82, 0xd1, 0x2a, 0x4a, 0xf0, 0xf9, 0x39, 0xba, 0x45, 0xf9,
+	0x52, 0xb3, 0x2a, 0xeb, 0xb8, 0xe9, 0xd5, 0xb1, 0x1b, 0x1b, 0x2d, 0x7f, 0x6c, 0x64, 0xa8, 0x73,
+	0xe9, 0xaa, 0x3d, 0x25, 0xd0, 0xc6, 0x96, 0x7a, 0x0f, 0x40, 0x46, 0xee, 0xf8, 0x70, 0xc5, 0xb5,
+	0x3a, 0x50, 0x1e, 0x86, 0x64, 0x5a, 0xaa, 0x25, 0x57, 0xad, 0x7c, 0x0e, 0x9f, 0xc1, 0x8d, 0x8b,
+	0xfa, 0xe6, 0xc1, 0x23, 0xd8, 0x42, 0x3e, 0x0d, 0x1b, 0x6b, 0xa1, 0xbb, 0x48, 0xa9, 0x84, 0x2c,
+	0x54, 0xe4, 0xa6, 0xb3, 0xa3, 0xe5, 0xc9, 0xa8, 0x10, 0x16, 0xb4, 0x25, 0xd0, 0xc2, 0x2b, 0x7d,
+	0x2a, 0x95, 0x65, 0x57, 0xea, 0xa9, 0xb9, 0x56, 0x4f, 0x37, 0xa1, 0x43, 0x35, 0x94, 0x73, 0xa2,
+	0xf4, 0x94, 0x03, 0xc2, 0x9f, 0xc0, 0x60, 0x54, 0x3c, 0x9d, 0x61, 0x74, 0x7a, 0x64, 0xf5, 0x14,
+	0xaf, 0xe4, 0x78, 0x13, 0x3a, 0x98, 0x65, 0xa6, 0x2c, 0x45, 0x07, 0x84, 0x31, 0x85, 0x7d, 0x91,
+	0xac, 0xf8, 0x63, 0x37, 0x4b, 0xcc, 0xa9, 0x34, 0xd6, 0xa6, 0x39, 0x0d, 0x7e, 0x0c, 0x5b, 0x39,
+	0xb1, 0x74, 0x7a, 0xf4, 0x1f, 0x7f, 0x52, 0x99, 0x57, 0x8b, 0x53, 0x42, 0xb2, 0x1e, 0xef, 0xd6,
+	0xc5, 0x78, 0xc7, 0xdc, 0x9f, 0x8f, 0xe2, 0xf9, 0x32, 0x71, 0xbf, 0x25, 0x1f, 0xd9, 0xd8, 0x72,
+	0xab, 0xad, 0xdb, 0x95, 0x9a, 0x32, 0xf6, 0x4b, 0x84, 0x17, 0xdd, 0x96, 0x1f, 0xdd, 0xf0, 0xcf,
+	0x0d, 0xe9, 0x78, 0x9e, 0xb4, 0x07, 0xbc, 0x12, 0x60, 0xbc, 0xb0, 0x22, 0x72, 0x47, 0x44, 0x2a,
+	0x87, 0x55, 0xe5, 0x75, 0xf0, 0x23, 0xd8, 0x4e, 0x4c, 0xa4, 0x93, 0x37, 0x1f, 0xc4, 0xe8, 0xeb,
+	0x42, 0xf9, 0x06, 0x57, 0x1f, 0x74, 0xb2, 0x44, 0x55, 0xde, 0x5f, 0xf1, 0xc7, 0x54, 0xb9, 0xb9,
+	0xed, 0xbb, 0xf9, 0x18, 0xb6, 0x47, 0x99, 0x8e, 0xf0, 0xeb, 0x85, 0xb7, 0xf0, 0xf4, 0x38, 0x73,
+	0x77, 0xa1, 0x55, 0x6f, 0x3b, 0x74, 0x24, 0x16, 0xe7, 0x24, 0x46, 0xd6, 0x1c, 0x07, 0x50, 0x4c,
+	0xe3, 0x74, 0x52, 0xfe, 0x30, 0xf2, 0x39, 0xfc, 0x9d, 0x44, 0x8f, 0x79, 0xff, 0x57, 0x36, 0xee,
+	0x41, 0xcb, 0x2c, 0xca, 0xa0, 0xee, 0xd4, 0xce, 0x27, 0x15, 0x15, 0x5d, 0x49, 0x74, 0x5a, 0xff,
+	0x29, 0x3a, 0xa1, 0x02, 0x18, 0x15, 0x14, 0x09, 0x1e, 0xe7, 0x9b, 0x66, 0xc0, 0xd5, 0xad, 0xb5,
+	0xde, 0xc8, 0x29, 0x95, 0xab, 0x8d, 0x3c, 0xfc, 0x29, 0x0c, 0xbc, 0xed, 0x25, 0x0f, 0x1e, 0x52,
+	0x23, 0xe2, 0xe3, 0x85, 0x45, 0xc1, 0xa3, 0x52, 0x25, 0x49, 0xb8, 0x0f, 0x20, 0xb6, 0xbe, 0x35,
+	0xd3, 0x4b, 0xcb, 0xe5, 0x2e, 0xb4, 0x12, 0x33, 0x2d, 0x7d, 0x9d, 0x98, 0x69, 0xf8, 0x5b, 0xd8,
+	0x16, 0xfa, 0x4b, 0xc4, 0x9f, 0x42, 0x73, 0x73, 0x06, 0x34, 0xdf, 0x7c, 0x08, 0x3e, 0x83, 0x76,
+	0x62, 0xa6, 0xb9, 0xf4, 0xec, 0x1b, 0xeb, 0xae, 0x7e, 0x6b, 0xa6, 0x8a, 0xaf, 0xa9, 0x93, 0x4e,
+	0x75, 0x7e, 0x9c, 0xe3, 0x58, 0x76, 0x88, 0x12, 0x0c, 0x7f, 0x05, 0x7d, 0xa1, 0x7e, 0xa6, 0xad,
+	0xbe, 0xa4, 0xc0, 0xff, 0xcc, 0xff, 0x1f, 0x0d, 0xe8, 0x8e, 0x0a, 0x85, 0xf9, 0x32, 0xb1, 0x5e,
+	0xad, 0x34, 0xae, 0x5e, 0xf9, 0x9a, 0xde, 0x4f, 0xee, 0xc7, 0x44, 0x3f, 0xf8, 0x82, 0xfe, 0x64,
+	0x58, 0x99, 0xb1, 0x96, 0x37, 0x02, 0x3f, 0x3a, 0x95, 0x61, 0xca, 0x27, 0xa3, 0x8a, 0x3e, 0x49,
+	0x4c, 0x74, 0xca, 0xed, 0x55, 0xc6, 0x50, 0x85, 0xa0, 0xbe, 0xec, 0x24, 0xf0, 0x13, 0xc0, 0x16,
+	0xe7, 0xba, 0x87, 0x09, 0xff, 0xd8, 0x82, 0x1b, 0x9e, 0x1e, 0xcf, 0xd0, 0xea, 0x38, 0xf9, 0xa8,
+	0x4e, 0xf2, 0xb0, 0xae, 0x8d, 0xe6, 0x46, 0x4d, 0xab, 0xfa, 0xa0, 0xdf, 0x90, 0xcc, 0x98, 0x89,
+	0xf3, 0xfe, 0x40, 0x09, 0xe4, 0x79, 0xb1, 0x7d, 0xb5, 0x17, 0x3b, 0xfe, 0xe2, 0xbc, 0x66, 0xeb,
+	0xd6, 0x45, 0x5b, 0xeb, 0x67, 0x98, 0xed, 0xb5, 0x67, 0x98, 0x3b, 0xd0, 0xa5, 0x19, 0xc7, 0x7b,
+	0x93, 0x3c, 0x82, 0x94, 0xf0, 0x05, 0xff, 0xf4, 0x2e, 0xfa, 0xc7, 0x5b, 0xd5, 0x61, 0xf3, 0xaa,
+	0x1e, 0x7c, 0x0e, 0x5d, 0x5b, 0x7c, 0xe3, 0xec, 0xeb, 0xaf, 0xb7, 0x00, 0x87, 0x56, 0xd5, 0x3d,
+	0x6b, 0xb3, 0x4c, 0x12, 0x6e, 0xc0, 0x03, 0x2e, 0x9c, 0x0a, 0xa6, 0x5d, 0xf0, 0x52, 0x30, 0x88,
+	0xbb, 0xb7, 0x5b, 0x0e, 0x2f, 0x87, 0xc3, 0xd1, 0xb9, 0xe5, 0x7e, 0x0f, 0xba, 0xf2, 0x67, 0xe5,
+	0xcd, 0xb6, 0x86, 0x3f, 0xdb, 0x1e, 0xc1, 0x6d, 0x85, 0x67, 0xcf, 0x30, 0x32, 0x63, 0x7e, 0xe0,
+	0xa9, 0xf9, 0x5c, 0xfd, 0x74, 0x11, 0x7e, 0x09, 0xbd, 0xe3, 0x1c, 0x33, 0x7e, 0x11, 0x62, 0x12,
+	0xb3, 0x88, 0xa3, 0x8a, 0x84, 0x00, 0x2a, 0x99, 0xc8, 0xa4, 0x16, 0xa5, 0x27, 0xf5, 0x54, 0x09,
+	0x86, 0x7f, 0x68, 0x40, 0xff, 0x78, 0x31, 0xcd, 0xf4, 0x18, 0xdf, 0xa1, 0xd5, 0x64, 0x7d, 0x6e,
+	0x75, 0x66, 0xe3, 0x74, 0x2a, 0x53, 0xb1, 0x82, 0x89, 0xcb, 0x39, 0x66, 0x39, 0xfd, 0xca, 0x09,
+	0x17, 0x01, 0x37, 0xcd, 0x25, 0x9e, 0xa6, 0x33, 0x9d, 0x8d, 0xcb, 0x8d, 0xed, 0x93, 0x2a, 0x05,
+	0x39, 0x5f, 0x8e, 0xe8, 0x4e, 0x09, 0x49, 0xf8, 0x1e, 0x06, 0x3e, 0x9e, 0x4c, 0x61, 0xd1, 0xe5,
+	0x2f, 0x15, 0x03, 0xd4, 0xd2, 0x30, 0x1d, 0x4b, 0x6b, 0xa5, 0xe3, 0xc6, 0xa1, 0xf8, 0x9a, 0xe7,
+	0xef, 0xc6, 0x7e, 0xdd, 0xab, 0xfa, 0xf5, 0x1e, 0xf4, 0xe3, 0xfc, 0x68, 0x66, 0x32, 0x5b, 0x4d,
+	0xdd, 0xae, 0xf2, 0x51, 0xe1, 0x11, 0x6c, 0x4b, 0xa2, 0x78, 0x85, 0xd2, 0x58, 0x2b, 0x94, 0xb5,
+	0xb6, 0x72, 0xad, 0x2c, 0x88, 0x3b, 0xd0, 0xcd, 0x8c, 0x71, 0x7c, 0xdd, 0x74, 0xab, 0xe0, 0xc3,
+	0x4f, 0x7f, 0xf1, 0x83, 0x69, 0x6c, 0x67, 0xcb, 0x93, 0xfd, 0xc8, 0xcc, 0x1f, 0x1d, 0x1c, 0x44,
+	0xe9, 0xa3, 0x68, 0xa6, 0xe3, 0xf4, 0xe0, 0xe0, 0x11, 0x7b, 0xe9, 0x64, 0x8b, 0x9f, 0xbe, 0x0f,
+	0xfe, 0x3d, 0x00, 0xfc, 0xfe, 0x18, 0xdb, 0x24, 0x17, 0x00, 0x00,
 }
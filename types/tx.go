@@ -173,6 +173,16 @@ func (txgroup *Transactions) IsExpire(cfg *Chain33Config, height, blocktime int6
 	return false
 }
 
+//IsMature 交易组中所有的交易都已经生效
+func (txgroup *Transactions) IsMature(height, blocktime int64) bool {
+	for _, tx := range txgroup.Txs {
+		if !tx.isMature(height, blocktime) {
+			return false
+		}
+	}
+	return true
+}
+
 //CheckWithFork 和fork 无关的有个检查函数
 func (txgroup *Transactions) CheckWithFork(cfg *Chain33Config, checkFork, paraFork bool, height, minfee, maxFee int64) error {
 	txs := txgroup.Txs
@@ -515,6 +525,12 @@ func (tx *Transaction) check(cfg *Chain33Config, height, minfee, maxFee int64) e
 	if txSize > int(MaxTxSize) {
 		return ErrTxMsgSizeTooBig
 	}
+	if tx.NotBefore != 0 && !cfg.IsFork(height, "ForkTxNotBefore") {
+		return ErrTxNotBeforeNotSupport
+	}
+	if len(tx.ReplaceHash) != 0 && !cfg.IsFork(height, "ForkTxReplace") {
+		return ErrTxReplaceNotSupport
+	}
 	if minfee == 0 {
 		return nil
 	}
@@ -587,6 +603,26 @@ func (tx *Transaction) IsExpire(cfg *Chain33Config, height, blocktime int64) boo
 	return group.IsExpire(cfg, height, blocktime)
 }
 
+//IsMature 交易是否已经到达生效的高度或者时间，未生效的交易不能被打包
+func (tx *Transaction) IsMature(height, blocktime int64) bool {
+	group, _ := tx.GetTxGroup()
+	if group == nil {
+		return tx.isMature(height, blocktime)
+	}
+	return group.IsMature(height, blocktime)
+}
+
+//NotBefore 为0表示没有限制，小于等于ExpireBound比较高度，否则比较区块时间
+func (tx *Transaction) isMature(height, blocktime int64) bool {
+	if tx.NotBefore == 0 {
+		return true
+	}
+	if tx.NotBefore <= ExpireBound {
+		return height >= tx.NotBefore
+	}
+	return blocktime >= tx.NotBefore
+}
+
 //GetTxFee 获取交易的费用，区分单笔交易和交易组
 func (tx *Transaction) GetTxFee() int64 {
 	group, _ := tx.GetTxGroup()
@@ -486,6 +486,36 @@ func TestSortTxList(t *testing.T) {
 	}
 }
 
+func TestTxNotBefore(t *testing.T) {
+	tx := &Transaction{Execer: []byte("coins"), Fee: 1e6, NotBefore: 10}
+	assert.False(t, tx.IsMature(9, 0))
+	assert.True(t, tx.IsMature(10, 0))
+	tx.NotBefore = ExpireBound + 100
+	assert.False(t, tx.IsMature(100, ExpireBound+99))
+	assert.True(t, tx.IsMature(1, ExpireBound+100))
+
+	//交易组中所有的交易都生效才可以打包
+	tx1 := &Transaction{Execer: []byte("coins"), Fee: 1e6, Nonce: 1}
+	tx2 := &Transaction{Execer: []byte("coins"), Fee: 0, Nonce: 2, NotBefore: 5}
+	group, err := CreateTxGroup([]*Transaction{tx1, tx2}, 1e5)
+	assert.Nil(t, err)
+	assert.False(t, group.Tx().IsMature(4, 0))
+	assert.True(t, group.Tx().IsMature(5, 0))
+
+	//fork之前不允许设置NotBefore
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	assert.Nil(t, tx.Check(cfg, 1, 1e5, 1e9))
+	str := strings.Replace(GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1)
+	cfg = NewChain33Config(str)
+	assert.Equal(t, ErrTxNotBeforeNotSupport, tx.Check(cfg, 1, 1e5, 1e9))
+
+	//fork之前不允许设置ReplaceHash
+	tx = &Transaction{Execer: []byte("none"), Fee: 1e6, ReplaceHash: []byte("hash")}
+	assert.Equal(t, ErrTxReplaceNotSupport, tx.Check(cfg, 1, 1e5, 1e9))
+	cfg = NewChain33Config(GetDefaultCfgstring())
+	assert.Nil(t, tx.Check(cfg, 1, 1e5, 1e9))
+}
+
 func modifyTxExec(tx1, tx2, tx3 Transaction, tx1exec, tx2exec, tx3exec string) (Transaction, Transaction, Transaction) {
 	tx11 := tx1
 	tx12 := tx2
@@ -548,8 +548,9 @@ func (r *ReceiptData) Clone() *ReceiptData {
 		return nil
 	}
 	return &ReceiptData{
-		Ty:   r.Ty,
-		Logs: cloneReceiptLogs(r.Logs),
+		Ty:      r.Ty,
+		Logs:    cloneReceiptLogs(r.Logs),
+		GasUsed: r.GasUsed,
 	}
 }
 
@@ -53,7 +53,7 @@ func (m *WalletTxDetail) Reset()         { *m = WalletTxDetail{} }
 func (m *WalletTxDetail) String() string { return proto.CompactTextString(m) }
 func (*WalletTxDetail) ProtoMessage()    {}
 func (*WalletTxDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{0}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{0}
 }
 func (m *WalletTxDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletTxDetail.Unmarshal(m, b)
@@ -154,7 +154,7 @@ func (m *WalletTxDetails) Reset()         { *m = WalletTxDetails{} }
 func (m *WalletTxDetails) String() string { return proto.CompactTextString(m) }
 func (*WalletTxDetails) ProtoMessage()    {}
 func (*WalletTxDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{1}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{1}
 }
 func (m *WalletTxDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletTxDetails.Unmarshal(m, b)
@@ -200,7 +200,7 @@ func (m *WalletAccountStore) Reset()         { *m = WalletAccountStore{} }
 func (m *WalletAccountStore) String() string { return proto.CompactTextString(m) }
 func (*WalletAccountStore) ProtoMessage()    {}
 func (*WalletAccountStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{2}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{2}
 }
 func (m *WalletAccountStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletAccountStore.Unmarshal(m, b)
@@ -263,7 +263,7 @@ func (m *WalletPwHash) Reset()         { *m = WalletPwHash{} }
 func (m *WalletPwHash) String() string { return proto.CompactTextString(m) }
 func (*WalletPwHash) ProtoMessage()    {}
 func (*WalletPwHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{3}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{3}
 }
 func (m *WalletPwHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletPwHash.Unmarshal(m, b)
@@ -316,7 +316,7 @@ func (m *WalletStatus) Reset()         { *m = WalletStatus{} }
 func (m *WalletStatus) String() string { return proto.CompactTextString(m) }
 func (*WalletStatus) ProtoMessage()    {}
 func (*WalletStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{4}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{4}
 }
 func (m *WalletStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletStatus.Unmarshal(m, b)
@@ -375,7 +375,7 @@ func (m *WalletAccounts) Reset()         { *m = WalletAccounts{} }
 func (m *WalletAccounts) String() string { return proto.CompactTextString(m) }
 func (*WalletAccounts) ProtoMessage()    {}
 func (*WalletAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{5}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{5}
 }
 func (m *WalletAccounts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletAccounts.Unmarshal(m, b)
@@ -414,7 +414,7 @@ func (m *WalletAccount) Reset()         { *m = WalletAccount{} }
 func (m *WalletAccount) String() string { return proto.CompactTextString(m) }
 func (*WalletAccount) ProtoMessage()    {}
 func (*WalletAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{6}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{6}
 }
 func (m *WalletAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletAccount.Unmarshal(m, b)
@@ -465,7 +465,7 @@ func (m *WalletUnLock) Reset()         { *m = WalletUnLock{} }
 func (m *WalletUnLock) String() string { return proto.CompactTextString(m) }
 func (*WalletUnLock) ProtoMessage()    {}
 func (*WalletUnLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{7}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{7}
 }
 func (m *WalletUnLock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletUnLock.Unmarshal(m, b)
@@ -517,7 +517,7 @@ func (m *GenSeedLang) Reset()         { *m = GenSeedLang{} }
 func (m *GenSeedLang) String() string { return proto.CompactTextString(m) }
 func (*GenSeedLang) ProtoMessage()    {}
 func (*GenSeedLang) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{8}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{8}
 }
 func (m *GenSeedLang) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedLang.Unmarshal(m, b)
@@ -555,7 +555,7 @@ func (m *GetSeedByPw) Reset()         { *m = GetSeedByPw{} }
 func (m *GetSeedByPw) String() string { return proto.CompactTextString(m) }
 func (*GetSeedByPw) ProtoMessage()    {}
 func (*GetSeedByPw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{9}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{9}
 }
 func (m *GetSeedByPw) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSeedByPw.Unmarshal(m, b)
@@ -597,7 +597,7 @@ func (m *SaveSeedByPw) Reset()         { *m = SaveSeedByPw{} }
 func (m *SaveSeedByPw) String() string { return proto.CompactTextString(m) }
 func (*SaveSeedByPw) ProtoMessage()    {}
 func (*SaveSeedByPw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{10}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{10}
 }
 func (m *SaveSeedByPw) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SaveSeedByPw.Unmarshal(m, b)
@@ -642,7 +642,7 @@ func (m *ReplySeed) Reset()         { *m = ReplySeed{} }
 func (m *ReplySeed) String() string { return proto.CompactTextString(m) }
 func (*ReplySeed) ProtoMessage()    {}
 func (*ReplySeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{11}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{11}
 }
 func (m *ReplySeed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplySeed.Unmarshal(m, b)
@@ -681,7 +681,7 @@ func (m *ReqWalletSetPasswd) Reset()         { *m = ReqWalletSetPasswd{} }
 func (m *ReqWalletSetPasswd) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetPasswd) ProtoMessage()    {}
 func (*ReqWalletSetPasswd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{12}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{12}
 }
 func (m *ReqWalletSetPasswd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletSetPasswd.Unmarshal(m, b)
@@ -726,7 +726,7 @@ func (m *ReqNewAccount) Reset()         { *m = ReqNewAccount{} }
 func (m *ReqNewAccount) String() string { return proto.CompactTextString(m) }
 func (*ReqNewAccount) ProtoMessage()    {}
 func (*ReqNewAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{13}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{13}
 }
 func (m *ReqNewAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqNewAccount.Unmarshal(m, b)
@@ -772,7 +772,7 @@ func (m *ReqWalletTransactionList) Reset()         { *m = ReqWalletTransactionLi
 func (m *ReqWalletTransactionList) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletTransactionList) ProtoMessage()    {}
 func (*ReqWalletTransactionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{14}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{14}
 }
 func (m *ReqWalletTransactionList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletTransactionList.Unmarshal(m, b)
@@ -826,7 +826,7 @@ func (m *ReqWalletImportPrivkey) Reset()         { *m = ReqWalletImportPrivkey{}
 func (m *ReqWalletImportPrivkey) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletImportPrivkey) ProtoMessage()    {}
 func (*ReqWalletImportPrivkey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{15}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{15}
 }
 func (m *ReqWalletImportPrivkey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletImportPrivkey.Unmarshal(m, b)
@@ -881,7 +881,7 @@ func (m *ReqWalletSendToAddress) Reset()         { *m = ReqWalletSendToAddress{}
 func (m *ReqWalletSendToAddress) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSendToAddress) ProtoMessage()    {}
 func (*ReqWalletSendToAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{16}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{16}
 }
 func (m *ReqWalletSendToAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletSendToAddress.Unmarshal(m, b)
@@ -954,7 +954,7 @@ func (m *ReqWalletSetFee) Reset()         { *m = ReqWalletSetFee{} }
 func (m *ReqWalletSetFee) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetFee) ProtoMessage()    {}
 func (*ReqWalletSetFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{17}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{17}
 }
 func (m *ReqWalletSetFee) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletSetFee.Unmarshal(m, b)
@@ -993,7 +993,7 @@ func (m *ReqWalletSetLabel) Reset()         { *m = ReqWalletSetLabel{} }
 func (m *ReqWalletSetLabel) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetLabel) ProtoMessage()    {}
 func (*ReqWalletSetLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{18}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{18}
 }
 func (m *ReqWalletSetLabel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletSetLabel.Unmarshal(m, b)
@@ -1038,7 +1038,7 @@ func (m *ReqWalletMergeBalance) Reset()         { *m = ReqWalletMergeBalance{} }
 func (m *ReqWalletMergeBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletMergeBalance) ProtoMessage()    {}
 func (*ReqWalletMergeBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{19}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{19}
 }
 func (m *ReqWalletMergeBalance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqWalletMergeBalance.Unmarshal(m, b)
@@ -1082,7 +1082,7 @@ func (m *ReqTokenPreCreate) Reset()         { *m = ReqTokenPreCreate{} }
 func (m *ReqTokenPreCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenPreCreate) ProtoMessage()    {}
 func (*ReqTokenPreCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{20}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{20}
 }
 func (m *ReqTokenPreCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenPreCreate.Unmarshal(m, b)
@@ -1164,7 +1164,7 @@ func (m *ReqTokenFinishCreate) Reset()         { *m = ReqTokenFinishCreate{} }
 func (m *ReqTokenFinishCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenFinishCreate) ProtoMessage()    {}
 func (*ReqTokenFinishCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{21}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{21}
 }
 func (m *ReqTokenFinishCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenFinishCreate.Unmarshal(m, b)
@@ -1218,7 +1218,7 @@ func (m *ReqTokenRevokeCreate) Reset()         { *m = ReqTokenRevokeCreate{} }
 func (m *ReqTokenRevokeCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenRevokeCreate) ProtoMessage()    {}
 func (*ReqTokenRevokeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{22}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{22}
 }
 func (m *ReqTokenRevokeCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenRevokeCreate.Unmarshal(m, b)
@@ -1273,7 +1273,7 @@ func (m *ReqModifyConfig) Reset()         { *m = ReqModifyConfig{} }
 func (m *ReqModifyConfig) String() string { return proto.CompactTextString(m) }
 func (*ReqModifyConfig) ProtoMessage()    {}
 func (*ReqModifyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{23}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{23}
 }
 func (m *ReqModifyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqModifyConfig.Unmarshal(m, b)
@@ -1334,7 +1334,11 @@ type ReqSignRawTx struct {
 	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
 	Fee   int64  `protobuf:"varint,8,opt,name=fee,proto3" json:"fee,omitempty"`
 	// bytes  newExecer = 9;
-	NewToAddr            string   `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	NewToAddr string `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	// 替换mempool中未打包的交易
+	ReplaceHash string `protobuf:"bytes,11,opt,name=replaceHash,proto3" json:"replaceHash,omitempty"`
+	// 交易生效的区块高度或者区块时间，之前不能被打包
+	NotBefore            int64    `protobuf:"varint,12,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1344,7 +1348,7 @@ func (m *ReqSignRawTx) Reset()         { *m = ReqSignRawTx{} }
 func (m *ReqSignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReqSignRawTx) ProtoMessage()    {}
 func (*ReqSignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{24}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{24}
 }
 func (m *ReqSignRawTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqSignRawTx.Unmarshal(m, b)
@@ -1420,6 +1424,20 @@ func (m *ReqSignRawTx) GetNewToAddr() string {
 	return ""
 }
 
+func (m *ReqSignRawTx) GetReplaceHash() string {
+	if m != nil {
+		return m.ReplaceHash
+	}
+	return ""
+}
+
+func (m *ReqSignRawTx) GetNotBefore() int64 {
+	if m != nil {
+		return m.NotBefore
+	}
+	return 0
+}
+
 type ReplySignRawTx struct {
 	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1431,7 +1449,7 @@ func (m *ReplySignRawTx) Reset()         { *m = ReplySignRawTx{} }
 func (m *ReplySignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReplySignRawTx) ProtoMessage()    {}
 func (*ReplySignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{25}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{25}
 }
 func (m *ReplySignRawTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplySignRawTx.Unmarshal(m, b)
@@ -1471,7 +1489,7 @@ func (m *ReportErrEvent) Reset()         { *m = ReportErrEvent{} }
 func (m *ReportErrEvent) String() string { return proto.CompactTextString(m) }
 func (*ReportErrEvent) ProtoMessage()    {}
 func (*ReportErrEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{26}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{26}
 }
 func (m *ReportErrEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportErrEvent.Unmarshal(m, b)
@@ -1523,7 +1541,7 @@ func (m *Int32) Reset()         { *m = Int32{} }
 func (m *Int32) String() string { return proto.CompactTextString(m) }
 func (*Int32) ProtoMessage()    {}
 func (*Int32) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{27}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{27}
 }
 func (m *Int32) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Int32.Unmarshal(m, b)
@@ -1561,7 +1579,7 @@ func (m *ReqAccountList) Reset()         { *m = ReqAccountList{} }
 func (m *ReqAccountList) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountList) ProtoMessage()    {}
 func (*ReqAccountList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{28}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{28}
 }
 func (m *ReqAccountList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAccountList.Unmarshal(m, b)
@@ -1600,7 +1618,7 @@ func (m *ReqPrivkeysFile) Reset()         { *m = ReqPrivkeysFile{} }
 func (m *ReqPrivkeysFile) String() string { return proto.CompactTextString(m) }
 func (*ReqPrivkeysFile) ProtoMessage()    {}
 func (*ReqPrivkeysFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_b6f36e079cc30afe, []int{29}
+	return fileDescriptor_wallet_5b0d1d2512f72cac, []int{29}
 }
 func (m *ReqPrivkeysFile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqPrivkeysFile.Unmarshal(m, b)
@@ -1667,86 +1685,87 @@ func init() {
 	proto.RegisterType((*ReqPrivkeysFile)(nil), "types.ReqPrivkeysFile")
 }
 
-func init() { proto.RegisterFile("wallet.proto", fileDescriptor_wallet_b6f36e079cc30afe) }
-
-var fileDescriptor_wallet_b6f36e079cc30afe = []byte{
-	// 1242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6e, 0x1b, 0x37,
-	0x10, 0xc6, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x10, 0x49, 0xb0, 0x70, 0x9b, 0x46, 0x61, 0x91,
-	0xd4, 0x05, 0x0a, 0x07, 0x88, 0x5e, 0x8a, 0x02, 0x05, 0xe2, 0xfc, 0x38, 0x0e, 0xe0, 0xa4, 0x06,
-	0xa5, 0xa2, 0x40, 0x5f, 0x0a, 0x7a, 0x77, 0x24, 0x11, 0x5e, 0x91, 0x6b, 0x2e, 0x65, 0x49, 0x37,
-	0xe9, 0x01, 0x7a, 0x84, 0x9e, 0xa1, 0xef, 0xbd, 0x51, 0xc1, 0x21, 0x29, 0xed, 0xa6, 0xce, 0x43,
-	0xd1, 0x37, 0x7e, 0xc3, 0xe1, 0x0c, 0xe7, 0x9b, 0x1f, 0x92, 0xf4, 0x16, 0xa2, 0x28, 0xc0, 0x1e,
-	0x97, 0x46, 0x5b, 0x4d, 0x3b, 0x76, 0x55, 0x42, 0x75, 0x78, 0xcf, 0x1a, 0xa1, 0x2a, 0x91, 0x59,
-	0xa9, 0x95, 0xdf, 0x39, 0xbc, 0x7b, 0x59, 0xe8, 0xec, 0x2a, 0x9b, 0x0a, 0x19, 0x25, 0xfb, 0x22,
-	0xcb, 0xf4, 0x5c, 0x85, 0xa3, 0x87, 0x07, 0xb0, 0x84, 0x6c, 0x6e, 0xb5, 0xf1, 0x98, 0xfd, 0xd9,
-	0x22, 0x07, 0xbf, 0xa0, 0xed, 0xd1, 0xf2, 0x0d, 0x58, 0x21, 0x0b, 0xca, 0x48, 0xcb, 0x2e, 0xd3,
-	0xa4, 0x9f, 0x1c, 0xed, 0xbd, 0xa0, 0xc7, 0xe8, 0xea, 0x78, 0xb4, 0xf1, 0xc4, 0x5b, 0x76, 0x49,
-	0xbf, 0x23, 0x3b, 0x06, 0x32, 0x90, 0xa5, 0x4d, 0x5b, 0x0d, 0x45, 0xee, 0xa5, 0x6f, 0x84, 0x15,
-	0x3c, 0xaa, 0xd0, 0x87, 0x64, 0x7b, 0x0a, 0x72, 0x32, 0xb5, 0x69, 0xbb, 0x9f, 0x1c, 0xb5, 0x79,
-	0x40, 0xf4, 0x3e, 0xe9, 0x48, 0x95, 0xc3, 0x32, 0xdd, 0x42, 0xb1, 0x07, 0xf4, 0x4b, 0xd2, 0xc5,
-	0x28, 0xac, 0x9c, 0x41, 0xda, 0xc1, 0x9d, 0x8d, 0xc0, 0xd9, 0x12, 0x33, 0x17, 0x50, 0xba, 0xed,
-	0x6d, 0x79, 0x44, 0x0f, 0xc9, 0xee, 0xd8, 0xe8, 0x99, 0xc8, 0x73, 0x93, 0xee, 0xf4, 0x93, 0xa3,
-	0x2e, 0x5f, 0x63, 0x77, 0xc6, 0x2e, 0xa7, 0xa2, 0x9a, 0xa6, 0xbb, 0xfd, 0xe4, 0xa8, 0xc7, 0x03,
-	0xa2, 0x5f, 0x11, 0xe2, 0x63, 0xfa, 0x28, 0x66, 0x90, 0x76, 0xf1, 0x54, 0x4d, 0x42, 0x53, 0xb2,
-	0x53, 0x8a, 0x55, 0xa1, 0x45, 0x9e, 0x12, 0x3c, 0x18, 0x21, 0x3b, 0x25, 0x77, 0x9a, 0xac, 0x55,
-	0x74, 0x40, 0xba, 0x36, 0x82, 0x34, 0xe9, 0xb7, 0x8f, 0xf6, 0x5e, 0x3c, 0x08, 0xa4, 0x34, 0x55,
-	0xf9, 0x46, 0x8f, 0xdd, 0x10, 0xea, 0x37, 0x4f, 0x7c, 0x96, 0x86, 0x56, 0x1b, 0xef, 0xd7, 0xc8,
-	0x9b, 0x2b, 0x58, 0x61, 0x1a, 0xba, 0x3c, 0x42, 0xc7, 0x58, 0x21, 0x2e, 0xa1, 0x40, 0xd6, 0xbb,
-	0xdc, 0x03, 0x4a, 0xc9, 0x16, 0xc6, 0xdd, 0x46, 0x21, 0xae, 0x1d, 0x8b, 0x8e, 0xaf, 0xa1, 0x15,
-	0xb3, 0x12, 0xf9, 0xed, 0xf2, 0x8d, 0x80, 0xbd, 0x24, 0x3d, 0xef, 0xf7, 0x62, 0x71, 0xe6, 0x98,
-	0x78, 0x48, 0xb6, 0x4b, 0x5c, 0xa1, 0xc3, 0x1e, 0x0f, 0xc8, 0xdd, 0xc4, 0x08, 0x95, 0x57, 0xd6,
-	0x04, 0x8f, 0x11, 0xb2, 0xdf, 0x93, 0x68, 0x62, 0x68, 0x85, 0x9d, 0x57, 0x94, 0x91, 0x9e, 0xac,
-	0xbc, 0xe4, 0x5c, 0x67, 0x57, 0x68, 0x68, 0x97, 0x37, 0x64, 0x5e, 0xe7, 0x64, 0x6e, 0xf5, 0x07,
-	0xa9, 0xa4, 0x9a, 0xa0, 0x4d, 0xd4, 0xd9, 0xc8, 0xdc, 0xc5, 0x65, 0x75, 0x26, 0xaa, 0x21, 0x40,
-	0x8e, 0x11, 0xed, 0xf2, 0x8d, 0xc0, 0x5b, 0x18, 0xc9, 0xec, 0x2a, 0x78, 0xd9, 0x8a, 0x16, 0x36,
-	0x32, 0xf6, 0x32, 0x96, 0x74, 0x20, 0xb5, 0xa2, 0xc7, 0x64, 0xc7, 0x37, 0x50, 0xcc, 0xcc, 0xfd,
-	0x46, 0x66, 0x82, 0x1e, 0x8f, 0x4a, 0xec, 0x1d, 0xd9, 0x6f, 0xec, 0xd0, 0x3e, 0x69, 0x8b, 0x2c,
-	0x0b, 0x4d, 0x71, 0x10, 0x0e, 0xc7, 0x63, 0x6e, 0xeb, 0xf6, 0xcc, 0xb0, 0x69, 0x24, 0xe9, 0x67,
-	0x85, 0x04, 0x38, 0x9e, 0x45, 0x55, 0x2d, 0xf2, 0x90, 0xd8, 0x80, 0x1c, 0xcf, 0x2e, 0x39, 0x7a,
-	0xee, 0xfb, 0xa9, 0xcd, 0x23, 0xa4, 0xcf, 0xc8, 0x81, 0xbf, 0xd5, 0x4f, 0xc6, 0x87, 0x18, 0x38,
-	0xf9, 0x44, 0xca, 0x9e, 0x90, 0xbd, 0x77, 0xa0, 0x1c, 0x47, 0xe7, 0x42, 0x4d, 0x5c, 0x49, 0x14,
-	0x42, 0x4d, 0xd0, 0x4d, 0x87, 0xe3, 0x9a, 0x3d, 0x75, 0x2a, 0xd6, 0xa9, 0xbc, 0x5a, 0x5d, 0x2c,
-	0x3e, 0x77, 0x17, 0xf6, 0x03, 0xe9, 0x0d, 0xc5, 0x0d, 0xac, 0xf5, 0x28, 0xd9, 0xaa, 0x5c, 0x2e,
-	0xbc, 0x16, 0xae, 0x6b, 0x67, 0x5b, 0x8d, 0xb3, 0x8f, 0x49, 0x97, 0x43, 0x59, 0xac, 0x30, 0x57,
-	0xb7, 0x1c, 0x64, 0x67, 0x84, 0x72, 0xb8, 0x0e, 0x85, 0x03, 0xf6, 0x62, 0x1d, 0xbe, 0x2e, 0x72,
-	0x07, 0x62, 0xc1, 0x07, 0xe8, 0x76, 0x14, 0x2c, 0x70, 0x27, 0x14, 0x60, 0x80, 0xec, 0x29, 0xd9,
-	0xe7, 0x70, 0xfd, 0x11, 0x16, 0x31, 0x47, 0xeb, 0x0c, 0x24, 0xf5, 0x0c, 0x8c, 0x49, 0xba, 0x76,
-	0x58, 0x9b, 0x62, 0xe7, 0xb2, 0xc2, 0xb9, 0xe4, 0x66, 0xc4, 0x68, 0x19, 0xab, 0xde, 0x23, 0x67,
-	0x09, 0x4d, 0xa2, 0xcb, 0x0e, 0xf7, 0xc0, 0x15, 0x66, 0x2e, 0x0d, 0xe0, 0x71, 0x4c, 0x42, 0x87,
-	0x6f, 0x04, 0xec, 0x8c, 0x3c, 0x5c, 0xfb, 0x79, 0x3f, 0x2b, 0xb5, 0xb1, 0x17, 0xa1, 0x67, 0xff,
-	0x63, 0x37, 0xb3, 0x3f, 0x92, 0x9a, 0xa9, 0x21, 0xa8, 0x7c, 0xa4, 0x4f, 0xf2, 0xdc, 0x40, 0x55,
-	0x39, 0x46, 0xdd, 0x15, 0x23, 0xa3, 0x6e, 0x4d, 0x0f, 0x48, 0xcb, 0xea, 0x60, 0xa1, 0x65, 0x75,
-	0x6d, 0x40, 0xb6, 0x1b, 0x03, 0x92, 0x92, 0x2d, 0xa5, 0x2d, 0x84, 0x59, 0x80, 0x6b, 0x77, 0x35,
-	0x59, 0x8d, 0xf4, 0x15, 0x28, 0x1c, 0xb4, 0xbb, 0x3c, 0x42, 0xda, 0x27, 0x7b, 0xd6, 0x2d, 0x86,
-	0xab, 0xd9, 0xa5, 0x2e, 0x70, 0xd6, 0x76, 0x79, 0x5d, 0xc4, 0xbe, 0x25, 0x77, 0xea, 0x99, 0x3c,
-	0x85, 0xfa, 0x6c, 0x4e, 0xea, 0xae, 0xd9, 0x8f, 0xe4, 0x5e, 0x5d, 0xf5, 0xbc, 0x31, 0xb4, 0x92,
-	0xda, 0xd0, 0xba, 0x9d, 0x90, 0x6f, 0xc8, 0x83, 0xf5, 0xf1, 0x0f, 0x60, 0x26, 0xf0, 0x4a, 0x14,
-	0x42, 0x65, 0x10, 0x42, 0x4f, 0x62, 0xe8, 0xec, 0xef, 0x04, 0x1d, 0x61, 0x04, 0x17, 0x06, 0x5e,
-	0x1b, 0x10, 0x16, 0xe8, 0x13, 0xd2, 0xcb, 0xdc, 0x4a, 0x9b, 0xdf, 0x6a, 0x0e, 0xf7, 0x82, 0xcc,
-	0x51, 0x8b, 0xdc, 0xb8, 0x27, 0xa0, 0x15, 0xb8, 0x11, 0xfe, 0xa1, 0xa9, 0x7c, 0xf0, 0x7e, 0xac,
-	0x06, 0x84, 0x13, 0x48, 0x59, 0xa3, 0xf3, 0xb9, 0xaf, 0x04, 0xcf, 0x67, 0x43, 0x46, 0x1f, 0x11,
-	0xa2, 0x17, 0x0a, 0x82, 0xc3, 0x8e, 0x9f, 0xbe, 0x28, 0x39, 0x09, 0x61, 0x5a, 0x6d, 0x45, 0x11,
-	0x9e, 0x30, 0x0f, 0x9c, 0xb4, 0x34, 0x32, 0x03, 0x7c, 0xbe, 0xda, 0xdc, 0x03, 0x66, 0xc8, 0xfd,
-	0x18, 0xd2, 0xa9, 0x54, 0xb2, 0x9a, 0x86, 0xa8, 0xbe, 0x26, 0xfb, 0x63, 0xc4, 0xd0, 0x08, 0xab,
-	0x17, 0x85, 0x27, 0xe1, 0xe1, 0x0b, 0x31, 0xb4, 0x1a, 0x31, 0x34, 0xef, 0xd7, 0xfe, 0xe4, 0x7e,
-	0xac, 0xdc, 0xf8, 0xe4, 0x70, 0xa3, 0xaf, 0x6a, 0x4c, 0x1a, 0xc4, 0x4d, 0x26, 0x83, 0xec, 0xff,
-	0x78, 0x04, 0x2c, 0xa6, 0x0f, 0x3a, 0x97, 0xe3, 0xd5, 0x6b, 0xad, 0xc6, 0x72, 0x42, 0xef, 0x92,
-	0xf6, 0xa6, 0x65, 0xdc, 0xd2, 0xa5, 0x5b, 0x97, 0xb1, 0xd2, 0x75, 0xe9, 0x08, 0xbb, 0x11, 0xc5,
-	0x1c, 0x82, 0x39, 0x0f, 0xdc, 0x47, 0x60, 0xe6, 0xec, 0x48, 0x30, 0x21, 0x37, 0x6b, 0xcc, 0xfe,
-	0x4a, 0x48, 0x8f, 0xc3, 0xf5, 0x50, 0x4e, 0x14, 0x17, 0x8b, 0xd1, 0xf2, 0xd6, 0x22, 0xac, 0xf5,
-	0x6b, 0xeb, 0x5f, 0xfd, 0x6a, 0x97, 0x67, 0xb0, 0x8c, 0x0e, 0x11, 0xb8, 0x90, 0x61, 0x59, 0x4a,
-	0x13, 0x5b, 0x2b, 0xa0, 0xcd, 0xef, 0xa6, 0xe3, 0xa7, 0x88, 0xff, 0xdd, 0x60, 0xee, 0x5d, 0xc3,
-	0xed, 0x04, 0x1b, 0xd8, 0x6e, 0x77, 0x49, 0x7b, 0x0c, 0x80, 0xdf, 0x93, 0x36, 0x77, 0x4b, 0x37,
-	0x6d, 0x14, 0x2c, 0x7c, 0xeb, 0xe3, 0xef, 0xa3, 0xcb, 0x37, 0x02, 0xf6, 0x8c, 0x1c, 0xf8, 0x39,
-	0xbb, 0x8e, 0x64, 0x7d, 0xb7, 0xa4, 0x76, 0x37, 0x76, 0x89, 0x7a, 0xda, 0xd8, 0xb7, 0xc6, 0xbc,
-	0xbd, 0x01, 0x65, 0xdd, 0x9f, 0xc7, 0x8d, 0x8d, 0x99, 0xce, 0xe7, 0x05, 0x04, 0xe5, 0x9a, 0xc4,
-	0xd1, 0x67, 0x75, 0xd8, 0xf5, 0xe1, 0xaf, 0xb1, 0xf3, 0x01, 0xc6, 0xe8, 0x98, 0x3f, 0x0f, 0xd8,
-	0x17, 0xa4, 0xf3, 0x5e, 0xd9, 0xc1, 0x0b, 0x47, 0x66, 0x2e, 0xac, 0x88, 0x6f, 0x8e, 0x5b, 0xb3,
-	0xef, 0xdd, 0x05, 0xae, 0xc3, 0x88, 0xc6, 0xa1, 0xeb, 0x1e, 0x34, 0x69, 0xa7, 0x7a, 0x6e, 0x43,
-	0x1b, 0x87, 0x9f, 0xc2, 0x27, 0x52, 0xf6, 0x16, 0x4b, 0x22, 0x0c, 0xd1, 0xea, 0x54, 0xfa, 0xbb,
-	0x8d, 0x65, 0x01, 0xf8, 0x5b, 0x4b, 0xc2, 0x1f, 0x2f, 0xe0, 0xcf, 0xbd, 0x48, 0xaf, 0x1e, 0xff,
-	0xfa, 0x68, 0x22, 0xed, 0x74, 0x7e, 0x79, 0x9c, 0xe9, 0xd9, 0xf3, 0xc1, 0x20, 0x53, 0xcf, 0xf1,
-	0x7b, 0x3c, 0x18, 0x3c, 0xc7, 0x57, 0xfc, 0x72, 0x1b, 0x3f, 0xc2, 0x83, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0x0e, 0x8d, 0x1c, 0x63, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("wallet.proto", fileDescriptor_wallet_5b0d1d2512f72cac) }
+
+var fileDescriptor_wallet_5b0d1d2512f72cac = []byte{
+	// 1261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6e, 0x1b, 0x37,
+	0x10, 0xc5, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x92, 0x10, 0x49, 0xb0, 0x70, 0x9b, 0x46, 0x61, 0x91,
+	0xd4, 0x05, 0x0a, 0x07, 0x88, 0x7e, 0x8a, 0x02, 0x05, 0x62, 0x27, 0x71, 0x1c, 0xc0, 0x49, 0x0d,
+	0x4a, 0x45, 0x81, 0xfe, 0x14, 0xf4, 0xee, 0x48, 0x22, 0xbc, 0x5a, 0xae, 0xb9, 0x94, 0x25, 0x5d,
+	0xa0, 0x67, 0xe8, 0x01, 0x7a, 0x84, 0x5e, 0xa4, 0x37, 0x2a, 0x38, 0x24, 0xb5, 0xbb, 0xae, 0xf3,
+	0x51, 0xf4, 0x8f, 0xef, 0x71, 0x38, 0xc3, 0x79, 0x43, 0x0e, 0x49, 0x7a, 0x4b, 0x91, 0x65, 0x60,
+	0x8e, 0x0a, 0xad, 0x8c, 0xa2, 0x1d, 0xb3, 0x2e, 0xa0, 0x3c, 0x78, 0x60, 0xb4, 0xc8, 0x4b, 0x91,
+	0x18, 0xa9, 0x72, 0x37, 0x73, 0x70, 0xff, 0x32, 0x53, 0xc9, 0x55, 0x32, 0x13, 0x32, 0x30, 0xfb,
+	0x22, 0x49, 0xd4, 0x22, 0xf7, 0x4b, 0x0f, 0xfa, 0xb0, 0x82, 0x64, 0x61, 0x94, 0x76, 0x98, 0xfd,
+	0xd5, 0x22, 0xfd, 0x5f, 0xd0, 0xf7, 0x78, 0xf5, 0x16, 0x8c, 0x90, 0x19, 0x65, 0xa4, 0x65, 0x56,
+	0x71, 0x34, 0x88, 0x0e, 0xf7, 0x5e, 0xd1, 0x23, 0x0c, 0x75, 0x34, 0xae, 0x22, 0xf1, 0x96, 0x59,
+	0xd1, 0xef, 0xc8, 0x8e, 0x86, 0x04, 0x64, 0x61, 0xe2, 0x56, 0xc3, 0x90, 0x3b, 0xf6, 0xad, 0x30,
+	0x82, 0x07, 0x13, 0xfa, 0x98, 0x6c, 0xcf, 0x40, 0x4e, 0x67, 0x26, 0x6e, 0x0f, 0xa2, 0xc3, 0x36,
+	0xf7, 0x88, 0x3e, 0x24, 0x1d, 0x99, 0xa7, 0xb0, 0x8a, 0xb7, 0x90, 0x76, 0x80, 0x7e, 0x49, 0xba,
+	0x98, 0x85, 0x91, 0x73, 0x88, 0x3b, 0x38, 0x53, 0x11, 0xd6, 0x97, 0x98, 0xdb, 0x84, 0xe2, 0x6d,
+	0xe7, 0xcb, 0x21, 0x7a, 0x40, 0x76, 0x27, 0x5a, 0xcd, 0x45, 0x9a, 0xea, 0x78, 0x67, 0x10, 0x1d,
+	0x76, 0xf9, 0x06, 0xdb, 0x35, 0x66, 0x35, 0x13, 0xe5, 0x2c, 0xde, 0x1d, 0x44, 0x87, 0x3d, 0xee,
+	0x11, 0xfd, 0x8a, 0x10, 0x97, 0xd3, 0x27, 0x31, 0x87, 0xb8, 0x8b, 0xab, 0x6a, 0x0c, 0x8d, 0xc9,
+	0x4e, 0x21, 0xd6, 0x99, 0x12, 0x69, 0x4c, 0x70, 0x61, 0x80, 0xec, 0x94, 0xdc, 0x6b, 0xaa, 0x56,
+	0xd2, 0x21, 0xe9, 0x9a, 0x00, 0xe2, 0x68, 0xd0, 0x3e, 0xdc, 0x7b, 0xf5, 0xc8, 0x8b, 0xd2, 0x34,
+	0xe5, 0x95, 0x1d, 0xbb, 0x21, 0xd4, 0x4d, 0x1e, 0xbb, 0x2a, 0x8d, 0x8c, 0xd2, 0x2e, 0xae, 0x96,
+	0x37, 0x57, 0xb0, 0xc6, 0x32, 0x74, 0x79, 0x80, 0x56, 0xb1, 0x4c, 0x5c, 0x42, 0x86, 0xaa, 0x77,
+	0xb9, 0x03, 0x94, 0x92, 0x2d, 0xcc, 0xbb, 0x8d, 0x24, 0x8e, 0xad, 0x8a, 0x56, 0xaf, 0x91, 0x11,
+	0xf3, 0x02, 0xf5, 0xed, 0xf2, 0x8a, 0x60, 0xaf, 0x49, 0xcf, 0xc5, 0xbd, 0x58, 0x9e, 0x59, 0x25,
+	0x1e, 0x93, 0xed, 0x02, 0x47, 0x18, 0xb0, 0xc7, 0x3d, 0xb2, 0x3b, 0xd1, 0x22, 0x4f, 0x4b, 0xa3,
+	0x7d, 0xc4, 0x00, 0xd9, 0x1f, 0x51, 0x70, 0x31, 0x32, 0xc2, 0x2c, 0x4a, 0xca, 0x48, 0x4f, 0x96,
+	0x8e, 0x39, 0x57, 0xc9, 0x15, 0x3a, 0xda, 0xe5, 0x0d, 0xce, 0xd9, 0x1c, 0x2f, 0x8c, 0xfa, 0x28,
+	0x73, 0x99, 0x4f, 0xe3, 0x56, 0xb0, 0xa9, 0x38, 0xbb, 0x71, 0x59, 0x9e, 0x89, 0x72, 0x04, 0x90,
+	0x62, 0x46, 0xbb, 0xbc, 0x22, 0x9c, 0x87, 0xb1, 0x4c, 0xae, 0x7c, 0x94, 0xad, 0xe0, 0xa1, 0xe2,
+	0xd8, 0x6b, 0xd2, 0x6f, 0x88, 0x5a, 0xd2, 0x23, 0xb2, 0xe3, 0x2e, 0x50, 0xa8, 0xcc, 0xc3, 0x46,
+	0x65, 0xbc, 0x1d, 0x0f, 0x46, 0xec, 0x3d, 0xd9, 0x6f, 0xcc, 0xd0, 0x01, 0x69, 0x8b, 0x24, 0xf1,
+	0x97, 0xa2, 0xef, 0x17, 0x87, 0x65, 0x76, 0xea, 0xee, 0xca, 0xb0, 0x59, 0x10, 0xe9, 0xe7, 0x1c,
+	0x05, 0xb0, 0x3a, 0x8b, 0xb2, 0x5c, 0xa6, 0xbe, 0xb0, 0x1e, 0x59, 0x9d, 0x6d, 0x71, 0xd4, 0xc2,
+	0xdd, 0xa7, 0x36, 0x0f, 0x90, 0xbe, 0x20, 0x7d, 0xb7, 0xab, 0x9f, 0xb4, 0x4b, 0xd1, 0x6b, 0x72,
+	0x8b, 0x65, 0xcf, 0xc8, 0xde, 0x7b, 0xc8, 0xad, 0x46, 0xe7, 0x22, 0x9f, 0xda, 0x23, 0x91, 0x89,
+	0x7c, 0x8a, 0x61, 0x3a, 0x1c, 0xc7, 0xec, 0xb9, 0x35, 0x31, 0xd6, 0xe4, 0x64, 0x7d, 0xb1, 0xfc,
+	0xdc, 0x5e, 0xd8, 0x0f, 0xa4, 0x37, 0x12, 0x37, 0xb0, 0xb1, 0xa3, 0x64, 0xab, 0x04, 0x08, 0x56,
+	0x38, 0xae, 0xad, 0x6d, 0x35, 0xd6, 0x3e, 0x25, 0x5d, 0x0e, 0x45, 0xb6, 0xc6, 0x5a, 0xdd, 0xb1,
+	0x90, 0x9d, 0x11, 0xca, 0xe1, 0xda, 0x1f, 0x1c, 0x30, 0x17, 0x9b, 0xf4, 0x55, 0x96, 0x5a, 0x10,
+	0x0e, 0xbc, 0x87, 0x76, 0x26, 0x87, 0x25, 0xce, 0xf8, 0x03, 0xe8, 0x21, 0x7b, 0x4e, 0xf6, 0x39,
+	0x5c, 0x7f, 0x82, 0x65, 0xa8, 0xd1, 0xa6, 0x02, 0x51, 0xbd, 0x02, 0x13, 0x12, 0x6f, 0x02, 0xd6,
+	0xba, 0xd8, 0xb9, 0x2c, 0xb1, 0x2f, 0xd9, 0x1e, 0x31, 0x5e, 0x85, 0x53, 0xef, 0x90, 0xf5, 0x84,
+	0x2e, 0x31, 0x64, 0x87, 0x3b, 0x60, 0x0f, 0x66, 0x2a, 0x35, 0xe0, 0x72, 0x2c, 0x42, 0x87, 0x57,
+	0x04, 0x3b, 0x23, 0x8f, 0x37, 0x71, 0x3e, 0xcc, 0x0b, 0xa5, 0xcd, 0x85, 0xbf, 0xb3, 0xff, 0xf1,
+	0x36, 0xb3, 0x3f, 0xa3, 0x9a, 0xab, 0x11, 0xe4, 0xe9, 0x58, 0x1d, 0xa7, 0xa9, 0x86, 0xb2, 0xb4,
+	0x8a, 0xda, 0x2d, 0x06, 0x45, 0xed, 0x98, 0xf6, 0x49, 0xcb, 0x28, 0xef, 0xa1, 0x65, 0x54, 0xad,
+	0x41, 0xb6, 0x1b, 0x0d, 0x92, 0x92, 0xad, 0x5c, 0x19, 0xf0, 0xbd, 0x00, 0xc7, 0x76, 0x6b, 0xb2,
+	0x1c, 0xab, 0x2b, 0xc8, 0xb1, 0xd1, 0xee, 0xf2, 0x00, 0xe9, 0x80, 0xec, 0x19, 0x3b, 0x18, 0xad,
+	0xe7, 0x97, 0x2a, 0xc3, 0x5e, 0xdb, 0xe5, 0x75, 0x8a, 0x7d, 0x4b, 0xee, 0xd5, 0x2b, 0x79, 0x0a,
+	0xf5, 0xde, 0x1c, 0xd5, 0x43, 0xb3, 0x1f, 0xc9, 0x83, 0xba, 0xe9, 0x79, 0xa3, 0x69, 0x45, 0xb5,
+	0xa6, 0x75, 0xb7, 0x20, 0xdf, 0x90, 0x47, 0x9b, 0xe5, 0x1f, 0x41, 0x4f, 0xe1, 0x44, 0x64, 0x22,
+	0x4f, 0xc0, 0xa7, 0x1e, 0x85, 0xd4, 0xd9, 0xdf, 0x11, 0x06, 0xc2, 0x0c, 0x2e, 0x34, 0xbc, 0xd1,
+	0x20, 0x0c, 0xd0, 0x67, 0xa4, 0x97, 0xd8, 0x91, 0xd2, 0xbf, 0xd5, 0x02, 0xee, 0x79, 0xce, 0x4a,
+	0x8b, 0xda, 0xd8, 0x27, 0xa0, 0xe5, 0xb5, 0x11, 0xee, 0xa1, 0x29, 0x5d, 0xf2, 0xae, 0xad, 0x7a,
+	0x84, 0x1d, 0x28, 0x37, 0x5a, 0xa5, 0x0b, 0x77, 0x12, 0x9c, 0x9e, 0x0d, 0x8e, 0x3e, 0x21, 0x44,
+	0x2d, 0x73, 0xf0, 0x01, 0x3b, 0x68, 0xd1, 0x45, 0xe6, 0xd8, 0xa7, 0x69, 0x94, 0x11, 0x99, 0x7f,
+	0xc2, 0x1c, 0xb0, 0x6c, 0xa1, 0x65, 0x02, 0xf8, 0x7c, 0xb5, 0xb9, 0x03, 0x4c, 0x93, 0x87, 0x21,
+	0xa5, 0x53, 0x99, 0xcb, 0x72, 0xe6, 0xb3, 0xfa, 0x9a, 0xec, 0x4f, 0x10, 0x43, 0x23, 0xad, 0x5e,
+	0x20, 0x8f, 0xfd, 0xc3, 0xe7, 0x73, 0x68, 0x35, 0x72, 0x68, 0xee, 0xaf, 0x7d, 0x6b, 0x7f, 0xac,
+	0xa8, 0x62, 0x72, 0xb8, 0x51, 0x57, 0x35, 0x25, 0x35, 0xe2, 0xa6, 0x92, 0x9e, 0xfb, 0x3f, 0x11,
+	0x01, 0x0f, 0xd3, 0x47, 0x95, 0xca, 0xc9, 0xfa, 0x8d, 0xca, 0x27, 0x72, 0x4a, 0xef, 0x93, 0x76,
+	0x75, 0x65, 0xec, 0xd0, 0x96, 0x5b, 0x15, 0xe1, 0xa4, 0xab, 0xc2, 0x0a, 0x76, 0x23, 0xb2, 0x05,
+	0x78, 0x77, 0x0e, 0xd8, 0x8f, 0xc0, 0xdc, 0xfa, 0x91, 0xa0, 0x7d, 0x6d, 0x36, 0x98, 0xfd, 0xde,
+	0x22, 0x3d, 0x0e, 0xd7, 0x23, 0x39, 0xcd, 0xb9, 0x58, 0x8e, 0x57, 0x77, 0x1e, 0xc2, 0xda, 0x7d,
+	0x6d, 0xfd, 0xeb, 0xbe, 0x9a, 0xd5, 0x19, 0xac, 0x42, 0x40, 0x04, 0x36, 0x65, 0x58, 0x15, 0x52,
+	0x87, 0xab, 0xe5, 0x51, 0xf5, 0xbb, 0xe9, 0xb8, 0x2e, 0x82, 0xc0, 0xd5, 0xde, 0x5e, 0xb8, 0x1d,
+	0xef, 0xc3, 0x02, 0x9b, 0xec, 0x04, 0x00, 0xbf, 0x27, 0x6d, 0x6e, 0x87, 0xb6, 0xdb, 0xe4, 0xb0,
+	0x74, 0x57, 0x1f, 0x7f, 0x1f, 0x5d, 0x5e, 0x11, 0xf6, 0x7a, 0x6a, 0x28, 0x32, 0x91, 0x00, 0x3e,
+	0xda, 0x7b, 0xa1, 0x10, 0x1b, 0x0a, 0xd7, 0x2b, 0x73, 0x02, 0x13, 0xa5, 0x21, 0xee, 0xa1, 0xdf,
+	0x8a, 0x60, 0x2f, 0x48, 0xdf, 0xf5, 0xe9, 0x8d, 0x12, 0x9b, 0xdc, 0xa2, 0x5a, 0x6e, 0xec, 0x12,
+	0xed, 0x94, 0x36, 0xef, 0xb4, 0x7e, 0x77, 0x03, 0xb9, 0xb1, 0x7f, 0x26, 0xdb, 0x76, 0xe6, 0x2a,
+	0x5d, 0x64, 0xe0, 0x8d, 0x6b, 0x8c, 0x95, 0xdf, 0x28, 0x3f, 0xeb, 0xe4, 0xdb, 0x60, 0x1b, 0x03,
+	0xb4, 0x56, 0xa1, 0xfe, 0x0e, 0xb0, 0x2f, 0x48, 0xe7, 0x43, 0x6e, 0x86, 0xaf, 0x6c, 0x31, 0x52,
+	0x61, 0x44, 0x78, 0xb3, 0xec, 0x98, 0x7d, 0x6f, 0x37, 0x70, 0xed, 0x5b, 0x3c, 0x36, 0x6d, 0xfb,
+	0x20, 0x4a, 0x33, 0x53, 0x0b, 0xe3, 0xdb, 0x80, 0xff, 0x69, 0xdc, 0x62, 0xd9, 0x3b, 0x3c, 0x52,
+	0xbe, 0x09, 0x97, 0xa7, 0xd2, 0xed, 0x6d, 0x22, 0x33, 0xc0, 0xdf, 0x5e, 0xe4, 0xff, 0x88, 0x1e,
+	0x7f, 0xee, 0x45, 0x3b, 0x79, 0xfa, 0xeb, 0x93, 0xa9, 0x34, 0xb3, 0xc5, 0xe5, 0x51, 0xa2, 0xe6,
+	0x2f, 0x87, 0xc3, 0x24, 0x7f, 0x89, 0xdf, 0xeb, 0xe1, 0xf0, 0x25, 0xfe, 0x02, 0x2e, 0xb7, 0xf1,
+	0x23, 0x3d, 0xfc, 0x67, 0x00, 0xc3, 0x95, 0x2a, 0x77, 0xa3, 0x0b, 0x00, 0x00,
 }
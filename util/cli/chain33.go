@@ -15,6 +15,7 @@ package cli
 import (
 	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	_ "net/http/pprof" //
 	"os"
@@ -58,6 +59,10 @@ var (
 	exportTitle = flag.String("export", "", "export block title name")
 	fileDir     = flag.String("filedir", "", "import/export block file dir,defalut current path")
 	startHeight = flag.Int64("startheight", 0, "export block start height")
+	importArch  = flag.String("importarchive", "", "import block archive file name, - for stdin")
+	exportArch  = flag.String("exportarchive", "", "export block archive file name, - for stdout")
+	compress    = flag.String("compress", "gzip", "export block archive compress type: none/gzip/zstd")
+	splitSize   = flag.Int64("splitsize", 0, "split export block archive file size(MB), 0 for no split")
 )
 
 //RunChain33 : run Chain33
@@ -67,6 +72,12 @@ func RunChain33(name, defCfg string) {
 		fmt.Println(version.GetVersion())
 		return
 	}
+	//导出归档到stdout时，日志输出到stderr，避免和归档数据混在一起
+	var logout io.Writer = os.Stdout
+	if *exportArch == "-" {
+		logout = os.Stderr
+		clog.SetConsoleWriter(logout)
+	}
 	if *configPath == "" {
 		if name == "" {
 			*configPath = "chain33.toml"
@@ -114,7 +125,7 @@ func RunChain33(name, defCfg string) {
 	//set grpc log
 	f, err := createFile(cfg.P2P.GrpcLogFile)
 	if err != nil {
-		glogv2 := grpclog.NewLoggerV2(os.Stdout, os.Stdout, os.Stdout)
+		glogv2 := grpclog.NewLoggerV2(logout, logout, logout)
 		grpclog.SetLoggerV2(glogv2)
 	} else {
 		glogv2 := grpclog.NewLoggerV2WithVerbosity(f, f, f, 10)
@@ -171,7 +182,14 @@ func RunChain33(name, defCfg string) {
 	s := store.New(chain33Cfg)
 	s.SetQueueClient(q.Client())
 
+	//升级期间只提供查询重建索引进度的jsonrpc服务，其他rpc接口在rpc模块启动之后才能使用
+	rpc.InitCfg(cfg.RPC)
+	upgradeRPC := rpc.NewUpgradeServer(q.Client(), nil)
+	if _, err := upgradeRPC.Listen(); err != nil {
+		log.Error("upgrade rpc listen", "err", err)
+	}
 	chain.Upgrade()
+	upgradeRPC.Close()
 
 	log.Info("loading consensus module")
 	cs := consensus.New(chain33Cfg)
@@ -193,6 +211,12 @@ func RunChain33(name, defCfg string) {
 	if *exportTitle != "" {
 		chain.ExportBlockProc(*exportTitle, *fileDir, *startHeight)
 	}
+	if *importArch != "" {
+		chain.ImportArchiveProc(*importArch)
+	}
+	if *exportArch != "" {
+		chain.ExportArchiveProc(*exportArch, *compress, *startHeight, *splitSize)
+	}
 	log.Info("loading p2p module")
 	var network queue.Module
 	if cfg.P2P.Enable && !chain33Cfg.IsPara() {
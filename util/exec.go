@@ -181,6 +181,12 @@ func CheckTxDup(client queue.Client, txs []*types.TransactionCache, height int64
 		dupMap[string(hash)] = true
 		log.Debug("CheckTxDup", "TxDuphash", common.ToHex(hash))
 	}
+	if cfg.IsFork(height, "ForkTxReplace") {
+		err = checkReplaceDup(client, txs, height, dupMap)
+		if err != nil {
+			return nil, err
+		}
+	}
 	for _, tx := range txs {
 		hash := tx.Hash()
 		if dupMap[string(hash)] {
@@ -191,6 +197,88 @@ func CheckTxDup(client queue.Client, txs []*types.TransactionCache, height int64
 	return transactions, nil
 }
 
+//checkReplaceDup 被替换的交易已经上链，或者和替换交易在同一批交易中时，替换交易视为重复交易
+//替换交易已经上链时，被替换的交易视为重复交易
+func checkReplaceDup(client queue.Client, txs []*types.TransactionCache, height int64, dupMap map[string]bool) error {
+	err := checkReplacedOnChain(client, txs, dupMap)
+	if err != nil {
+		return err
+	}
+	var checkHashList types.TxHashList
+	hashes := make(map[string]bool)
+	for _, tx := range txs {
+		hashes[string(tx.Hash())] = true
+	}
+	for _, tx := range txs {
+		if len(tx.ReplaceHash) == 0 {
+			continue
+		}
+		if hashes[string(tx.ReplaceHash)] {
+			dupMap[string(tx.Hash())] = true
+			continue
+		}
+		checkHashList.Hashes = append(checkHashList.Hashes, tx.ReplaceHash)
+	}
+	if len(checkHashList.Hashes) == 0 {
+		return nil
+	}
+	//被替换交易的过期时间未知，需要查询数据库
+	checkHashList.Count = height
+	hashList := client.NewMessage("blockchain", types.EventTxHashList, &checkHashList)
+	err = client.Send(hashList, true)
+	if err != nil {
+		log.Error("send", "to blockchain EventTxHashList msg err", err)
+		return err
+	}
+	dupTxList, err := client.Wait(hashList)
+	if err != nil {
+		return err
+	}
+	onChain := make(map[string]bool)
+	for _, hash := range dupTxList.GetData().(*types.TxHashList).Hashes {
+		onChain[string(hash)] = true
+	}
+	for _, tx := range txs {
+		if len(tx.ReplaceHash) != 0 && onChain[string(tx.ReplaceHash)] {
+			dupMap[string(tx.Hash())] = true
+			log.Debug("CheckTxDup", "ReplacedOnChain", common.ToHex(tx.ReplaceHash))
+		}
+	}
+	return nil
+}
+
+//checkReplacedOnChain 查询交易是否已经被上链的替换交易替换掉，替换交易上链时执行器在localdb中记录被替换的交易hash
+func checkReplacedOnChain(client queue.Client, txs []*types.TransactionCache, dupMap map[string]bool) error {
+	var keys types.LocalDBGet
+	for _, tx := range txs {
+		keys.Keys = append(keys.Keys, types.CalcTxReplacedKey(tx.Hash()))
+	}
+	if len(keys.Keys) == 0 {
+		return nil
+	}
+	msg := client.NewMessage("blockchain", types.EventLocalGet, &keys)
+	err := client.Send(msg, true)
+	if err != nil {
+		log.Error("send", "to blockchain EventLocalGet msg err", err)
+		return err
+	}
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	reply, ok := resp.GetData().(*types.LocalReplyValue)
+	if !ok {
+		return types.ErrTypeAsset
+	}
+	for i, value := range reply.Values {
+		if i < len(txs) && len(value) != 0 {
+			dupMap[string(txs[i].Hash())] = true
+			log.Debug("CheckTxDup", "ReplacedBy", common.ToHex(value))
+		}
+	}
+	return nil
+}
+
 //ReportErrEventToFront 上报指定错误信息到指定模块，目前只支持从store，blockchain，wallet写数据库失败时上报错误信息到wallet模块，
 //然后由钱包模块前端定时调用显示给客户
 func ReportErrEventToFront(logger log.Logger, client queue.Client, frommodule string, tomodule string, err error) {
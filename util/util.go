@@ -299,7 +299,7 @@ func PreExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block,
 			deltxlist[i] = true
 			continue
 		}
-		rdata = append(rdata, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs})
+		rdata = append(rdata, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs, GasUsed: receipt.GasUsed})
 		kvset = append(kvset, receipt.KV...)
 	}
 	kvset = DelDupKey(kvset)
@@ -243,6 +243,8 @@ func TestJSONPrint(t *testing.T) {
 
 type testClient struct {
 	qmocks.Client
+	//已经被上链的替换交易替换掉的交易
+	replaced map[string]bool
 }
 
 var gid int64
@@ -255,7 +257,24 @@ func (t *testClient) NewMessage(topic string, ty int64, data interface{}) *queue
 func (t *testClient) Wait(in *queue.Message) (*queue.Message, error) {
 	switch in.Ty {
 	case types.EventTxHashList:
-		return &queue.Message{Data: &types.TxHashList{}}, nil
+		//hash为onchain的交易视为已经上链
+		dup := &types.TxHashList{}
+		for _, hash := range in.Data.(*types.TxHashList).Hashes {
+			if string(hash) == "onchain" {
+				dup.Hashes = append(dup.Hashes, hash)
+			}
+		}
+		return &queue.Message{Data: dup}, nil
+	case types.EventLocalGet:
+		reply := &types.LocalReplyValue{}
+		for _, key := range in.Data.(*types.LocalDBGet).Keys {
+			var value []byte
+			if t.replaced[string(key)] {
+				value = []byte("replace")
+			}
+			reply.Values = append(reply.Values, value)
+		}
+		return &queue.Message{Data: reply}, nil
 	case types.EventExecTxList:
 		return &queue.Message{Data: &types.Receipts{Receipts: []*types.Receipt{{Ty: 2}, {Ty: types.ExecErr}}}}, nil
 	case types.EventStoreMemSet:
@@ -346,6 +365,25 @@ func TestCheckDupTx(t *testing.T) {
 	txs = append(txs, tx)
 	_, err := CheckDupTx(client, txs, 1)
 	assert.NoError(t, err)
+
+	//被替换的交易已经上链，或者和替换交易在同一批交易中
+	replace := func(hash []byte) *types.Transaction {
+		tx := CreateCoinsTx(cfg, priv, addr, types.Coin)
+		tx.ReplaceHash = hash
+		tx.Sign(types.SECP256K1, priv)
+		return tx
+	}
+	onchain, inblock, unknown := replace([]byte("onchain")), replace(tx.Hash()), replace([]byte("unknown"))
+	txs = append(txs, onchain, inblock, unknown)
+	newtxs, err := CheckDupTx(client, txs, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Transaction{tx, unknown}, newtxs)
+
+	//替换交易已经上链时，被替换的交易视为重复交易
+	client.replaced = map[string]bool{string(types.CalcTxReplacedKey(tx.Hash())): true}
+	newtxs, err = CheckDupTx(client, []*types.Transaction{tx, unknown}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Transaction{unknown}, newtxs)
 }
 
 func TestReportErrEventToFront(t *testing.T) {
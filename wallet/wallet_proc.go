@@ -120,6 +120,23 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 	if err != nil {
 		return "", err
 	}
+	if unsigned.ReplaceHash != "" {
+		//交易组不支持替换
+		if group != nil {
+			return "", types.ErrNotSupport
+		}
+		tx.ReplaceHash, err = common.FromHex(unsigned.ReplaceHash)
+		if err != nil {
+			return "", err
+		}
+	}
+	if unsigned.NotBefore != 0 {
+		//交易组中的交易哈希已经确定，不能再修改
+		if group != nil {
+			return "", types.ErrNotSupport
+		}
+		tx.NotBefore = unsigned.NotBefore
+	}
 	if group == nil {
 		tx.Sign(int32(wallet.SignType), key)
 		txHex := types.Encode(&tx)
@@ -774,6 +774,21 @@ func testSignRawTx(t *testing.T, wallet *Wallet) {
 	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
 	require.NoError(t, err)
 
+	//替换mempool中的交易
+	unsigned.ReplaceHash = "0x0102"
+	reply, err := wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	require.NoError(t, err)
+	var signed types.Transaction
+	txByte, err := common.FromHex(reply.(*types.ReplySignRawTx).TxHex)
+	require.NoError(t, err)
+	require.NoError(t, types.Decode(txByte, &signed))
+	assert.Equal(t, []byte{1, 2}, signed.ReplaceHash)
+	unsigned.TxHex = group1
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	assert.Equal(t, types.ErrNotSupport, err)
+	unsigned.ReplaceHash = ""
+	unsigned.TxHex = "0a05636f696e73120c18010a081080c2d72f1a01312080897a30c0e2a4a789d684ad443a0131"
+
 	//地址和私钥都为空
 	unsigned.Privkey = ""
 	unsigned.Addr = ""